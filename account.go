@@ -37,6 +37,7 @@ func NewAccountService(opts ...option.RequestOption) (r *AccountService) {
 // Create an Account
 func (r *AccountService) New(ctx context.Context, body AccountNewParams, opts ...option.RequestOption) (res *Account, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Accounts.New")}, opts...)
 	path := "accounts"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -45,6 +46,7 @@ func (r *AccountService) New(ctx context.Context, body AccountNewParams, opts ..
 // Retrieve an Account
 func (r *AccountService) Get(ctx context.Context, accountID string, opts ...option.RequestOption) (res *Account, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Accounts.Get")}, opts...)
 	path := fmt.Sprintf("accounts/%s", accountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -53,6 +55,7 @@ func (r *AccountService) Get(ctx context.Context, accountID string, opts ...opti
 // Update an Account
 func (r *AccountService) Update(ctx context.Context, accountID string, body AccountUpdateParams, opts ...option.RequestOption) (res *Account, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Accounts.Update")}, opts...)
 	path := fmt.Sprintf("accounts/%s", accountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -62,7 +65,7 @@ func (r *AccountService) Update(ctx context.Context, accountID string, body Acco
 func (r *AccountService) List(ctx context.Context, query AccountListParams, opts ...option.RequestOption) (res *shared.Page[Account], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Accounts.List")}, opts...)
 	path := "accounts"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -84,6 +87,7 @@ func (r *AccountService) ListAutoPaging(ctx context.Context, query AccountListPa
 // Retrieve an Account Balance
 func (r *AccountService) Balance(ctx context.Context, accountID string, query AccountBalanceParams, opts ...option.RequestOption) (res *BalanceLookup, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Accounts.Balance")}, opts...)
 	path := fmt.Sprintf("accounts/%s/balance", accountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, query, &res, opts...)
 	return
@@ -92,6 +96,7 @@ func (r *AccountService) Balance(ctx context.Context, accountID string, query Ac
 // Close an Account
 func (r *AccountService) Close(ctx context.Context, accountID string, opts ...option.RequestOption) (res *Account, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Accounts.Close")}, opts...)
 	path := fmt.Sprintf("accounts/%s/close", accountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -157,6 +162,14 @@ func (r *Account) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Account) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountJSON) RawJSON() string {
+	return r.raw
+}
+
 // The bank the Account is with.
 type AccountBank string
 
@@ -167,6 +180,14 @@ const (
 	AccountBankFirstInternetBank AccountBank = "first_internet_bank"
 )
 
+func (r AccountBank) IsKnown() bool {
+	switch r {
+	case AccountBankBlueRidgeBank, AccountBankFirstInternetBank:
+		return true
+	}
+	return false
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Account
 // currency.
 type AccountCurrency string
@@ -186,6 +207,14 @@ const (
 	AccountCurrencyUsd AccountCurrency = "USD"
 )
 
+func (r AccountCurrency) IsKnown() bool {
+	switch r {
+	case AccountCurrencyCad, AccountCurrencyChf, AccountCurrencyEur, AccountCurrencyGbp, AccountCurrencyJpy, AccountCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The status of the Account.
 type AccountStatus string
 
@@ -196,6 +225,14 @@ const (
 	AccountStatusClosed AccountStatus = "closed"
 )
 
+func (r AccountStatus) IsKnown() bool {
+	switch r {
+	case AccountStatusOpen, AccountStatusClosed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `account`.
 type AccountType string
@@ -204,6 +241,14 @@ const (
 	AccountTypeAccount AccountType = "account"
 )
 
+func (r AccountType) IsKnown() bool {
+	switch r {
+	case AccountTypeAccount:
+		return true
+	}
+	return false
+}
+
 // Represents a request to lookup the balance of an Account at a given point in
 // time.
 type BalanceLookup struct {
@@ -235,6 +280,14 @@ func (r *BalanceLookup) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BalanceLookup) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r balanceLookupJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `balance_lookup`.
 type BalanceLookupType string
@@ -243,6 +296,14 @@ const (
 	BalanceLookupTypeBalanceLookup BalanceLookupType = "balance_lookup"
 )
 
+func (r BalanceLookupType) IsKnown() bool {
+	switch r {
+	case BalanceLookupTypeBalanceLookup:
+		return true
+	}
+	return false
+}
+
 type AccountNewParams struct {
 	// The name you choose for the Account.
 	Name param.Field[string] `json:"name,required"`
@@ -326,6 +387,14 @@ const (
 	AccountListParamsStatusClosed AccountListParamsStatus = "closed"
 )
 
+func (r AccountListParamsStatus) IsKnown() bool {
+	switch r {
+	case AccountListParamsStatusOpen, AccountListParamsStatusClosed:
+		return true
+	}
+	return false
+}
+
 type AccountBalanceParams struct {
 	// The moment to query the balance at. If not set, returns the current balances.
 	AtTime param.Field[time.Time] `query:"at_time" format:"date-time"`
@@ -38,6 +38,7 @@ func NewAccountNumberService(opts ...option.RequestOption) (r *AccountNumberServ
 // Create an Account Number
 func (r *AccountNumberService) New(ctx context.Context, body AccountNumberNewParams, opts ...option.RequestOption) (res *AccountNumber, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountNumbers.New")}, opts...)
 	path := "account_numbers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *AccountNumberService) New(ctx context.Context, body AccountNumberNewPar
 // Retrieve an Account Number
 func (r *AccountNumberService) Get(ctx context.Context, accountNumberID string, opts ...option.RequestOption) (res *AccountNumber, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountNumbers.Get")}, opts...)
 	path := fmt.Sprintf("account_numbers/%s", accountNumberID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -54,6 +56,7 @@ func (r *AccountNumberService) Get(ctx context.Context, accountNumberID string,
 // Update an Account Number
 func (r *AccountNumberService) Update(ctx context.Context, accountNumberID string, body AccountNumberUpdateParams, opts ...option.RequestOption) (res *AccountNumber, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountNumbers.Update")}, opts...)
 	path := fmt.Sprintf("account_numbers/%s", accountNumberID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -63,7 +66,7 @@ func (r *AccountNumberService) Update(ctx context.Context, accountNumberID strin
 func (r *AccountNumberService) List(ctx context.Context, query AccountNumberListParams, opts ...option.RequestOption) (res *shared.Page[AccountNumber], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("AccountNumbers.List")}, opts...)
 	path := "account_numbers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -134,6 +137,14 @@ func (r *AccountNumber) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountNumber) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountNumberJSON) RawJSON() string {
+	return r.raw
+}
+
 // Properties related to how this Account Number handles inbound ACH transfers.
 type AccountNumberInboundACH struct {
 	// Whether ACH debits are allowed against this Account Number. Note that they will
@@ -154,6 +165,14 @@ func (r *AccountNumberInboundACH) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountNumberInboundACH) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountNumberInboundACHJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether ACH debits are allowed against this Account Number. Note that they will
 // still be declined if this is `allowed` if the Account Number is not active.
 type AccountNumberInboundACHDebitStatus string
@@ -165,6 +184,14 @@ const (
 	AccountNumberInboundACHDebitStatusBlocked AccountNumberInboundACHDebitStatus = "blocked"
 )
 
+func (r AccountNumberInboundACHDebitStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberInboundACHDebitStatusAllowed, AccountNumberInboundACHDebitStatusBlocked:
+		return true
+	}
+	return false
+}
+
 // Properties related to how this Account Number should handle inbound check
 // withdrawls.
 type AccountNumberInboundChecks struct {
@@ -185,6 +212,14 @@ func (r *AccountNumberInboundChecks) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountNumberInboundChecks) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountNumberInboundChecksJSON) RawJSON() string {
+	return r.raw
+}
+
 // How Increase should process checks with this account number printed on them.
 type AccountNumberInboundChecksStatus string
 
@@ -197,6 +232,14 @@ const (
 	AccountNumberInboundChecksStatusCheckTransfersOnly AccountNumberInboundChecksStatus = "check_transfers_only"
 )
 
+func (r AccountNumberInboundChecksStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberInboundChecksStatusAllowed, AccountNumberInboundChecksStatusCheckTransfersOnly:
+		return true
+	}
+	return false
+}
+
 // This indicates if payments can be made to the Account Number.
 type AccountNumberStatus string
 
@@ -209,6 +252,14 @@ const (
 	AccountNumberStatusCanceled AccountNumberStatus = "canceled"
 )
 
+func (r AccountNumberStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberStatusActive, AccountNumberStatusDisabled, AccountNumberStatusCanceled:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `account_number`.
 type AccountNumberType string
@@ -217,6 +268,14 @@ const (
 	AccountNumberTypeAccountNumber AccountNumberType = "account_number"
 )
 
+func (r AccountNumberType) IsKnown() bool {
+	switch r {
+	case AccountNumberTypeAccountNumber:
+		return true
+	}
+	return false
+}
+
 type AccountNumberNewParams struct {
 	// The Account the Account Number should belong to.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -255,6 +314,14 @@ const (
 	AccountNumberNewParamsInboundACHDebitStatusBlocked AccountNumberNewParamsInboundACHDebitStatus = "blocked"
 )
 
+func (r AccountNumberNewParamsInboundACHDebitStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberNewParamsInboundACHDebitStatusAllowed, AccountNumberNewParamsInboundACHDebitStatusBlocked:
+		return true
+	}
+	return false
+}
+
 // Options related to how this Account Number should handle inbound check
 // withdrawls.
 type AccountNumberNewParamsInboundChecks struct {
@@ -278,6 +345,14 @@ const (
 	AccountNumberNewParamsInboundChecksStatusCheckTransfersOnly AccountNumberNewParamsInboundChecksStatus = "check_transfers_only"
 )
 
+func (r AccountNumberNewParamsInboundChecksStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberNewParamsInboundChecksStatusAllowed, AccountNumberNewParamsInboundChecksStatusCheckTransfersOnly:
+		return true
+	}
+	return false
+}
+
 type AccountNumberUpdateParams struct {
 	// Options related to how this Account Number handles inbound ACH transfers.
 	InboundACH param.Field[AccountNumberUpdateParamsInboundACH] `json:"inbound_ach"`
@@ -313,6 +388,14 @@ const (
 	AccountNumberUpdateParamsInboundACHDebitStatusBlocked AccountNumberUpdateParamsInboundACHDebitStatus = "blocked"
 )
 
+func (r AccountNumberUpdateParamsInboundACHDebitStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberUpdateParamsInboundACHDebitStatusAllowed, AccountNumberUpdateParamsInboundACHDebitStatusBlocked:
+		return true
+	}
+	return false
+}
+
 // This indicates if transfers can be made to the Account Number.
 type AccountNumberUpdateParamsStatus string
 
@@ -325,6 +408,14 @@ const (
 	AccountNumberUpdateParamsStatusCanceled AccountNumberUpdateParamsStatus = "canceled"
 )
 
+func (r AccountNumberUpdateParamsStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberUpdateParamsStatusActive, AccountNumberUpdateParamsStatusDisabled, AccountNumberUpdateParamsStatusCanceled:
+		return true
+	}
+	return false
+}
+
 type AccountNumberListParams struct {
 	// Filter Account Numbers to those belonging to the specified Account.
 	AccountID param.Field[string]                           `query:"account_id"`
@@ -382,3 +473,11 @@ const (
 	// The account number is permanently disabled.
 	AccountNumberListParamsStatusCanceled AccountNumberListParamsStatus = "canceled"
 )
+
+func (r AccountNumberListParamsStatus) IsKnown() bool {
+	switch r {
+	case AccountNumberListParamsStatusActive, AccountNumberListParamsStatusDisabled, AccountNumberListParamsStatusCanceled:
+		return true
+	}
+	return false
+}
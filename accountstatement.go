@@ -38,6 +38,7 @@ func NewAccountStatementService(opts ...option.RequestOption) (r *AccountStateme
 // Retrieve an Account Statement
 func (r *AccountStatementService) Get(ctx context.Context, accountStatementID string, opts ...option.RequestOption) (res *AccountStatement, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountStatements.Get")}, opts...)
 	path := fmt.Sprintf("account_statements/%s", accountStatementID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *AccountStatementService) Get(ctx context.Context, accountStatementID st
 func (r *AccountStatementService) List(ctx context.Context, query AccountStatementListParams, opts ...option.RequestOption) (res *shared.Page[AccountStatement], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("AccountStatements.List")}, opts...)
 	path := "account_statements"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -115,6 +116,14 @@ func (r *AccountStatement) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountStatement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountStatementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `account_statement`.
 type AccountStatementType string
@@ -123,6 +132,14 @@ const (
 	AccountStatementTypeAccountStatement AccountStatementType = "account_statement"
 )
 
+func (r AccountStatementType) IsKnown() bool {
+	switch r {
+	case AccountStatementTypeAccountStatement:
+		return true
+	}
+	return false
+}
+
 type AccountStatementListParams struct {
 	// Filter Account Statements to those belonging to the specified Account.
 	AccountID param.Field[string] `query:"account_id"`
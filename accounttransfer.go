@@ -38,6 +38,7 @@ func NewAccountTransferService(opts ...option.RequestOption) (r *AccountTransfer
 // Create an Account Transfer
 func (r *AccountTransferService) New(ctx context.Context, body AccountTransferNewParams, opts ...option.RequestOption) (res *AccountTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountTransfers.New")}, opts...)
 	path := "account_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,16 +47,28 @@ func (r *AccountTransferService) New(ctx context.Context, body AccountTransferNe
 // Retrieve an Account Transfer
 func (r *AccountTransferService) Get(ctx context.Context, accountTransferID string, opts ...option.RequestOption) (res *AccountTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountTransfers.Get")}, opts...)
 	path := fmt.Sprintf("account_transfers/%s", accountTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// Create an Account Transfer, or if one was already created with the same
+// UniqueIdentifier, retrieve and return it with existed set to true.
+func (r *AccountTransferService) NewOrGet(ctx context.Context, body AccountTransferNewParams, opts ...option.RequestOption) (res *AccountTransfer, existed bool, err error) {
+	res, err = r.New(ctx, body, opts...)
+	if id, ok := existingResourceID(err); ok {
+		res, err = r.Get(ctx, id, opts...)
+		return res, err == nil, err
+	}
+	return
+}
+
 // List Account Transfers
 func (r *AccountTransferService) List(ctx context.Context, query AccountTransferListParams, opts ...option.RequestOption) (res *shared.Page[AccountTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("AccountTransfers.List")}, opts...)
 	path := "account_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +90,7 @@ func (r *AccountTransferService) ListAutoPaging(ctx context.Context, query Accou
 // Approve an Account Transfer
 func (r *AccountTransferService) Approve(ctx context.Context, accountTransferID string, opts ...option.RequestOption) (res *AccountTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountTransfers.Approve")}, opts...)
 	path := fmt.Sprintf("account_transfers/%s/approve", accountTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -85,6 +99,7 @@ func (r *AccountTransferService) Approve(ctx context.Context, accountTransferID
 // Cancel an Account Transfer
 func (r *AccountTransferService) Cancel(ctx context.Context, accountTransferID string, opts ...option.RequestOption) (res *AccountTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("AccountTransfers.Cancel")}, opts...)
 	path := fmt.Sprintf("account_transfers/%s/cancel", accountTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -162,6 +177,14 @@ func (r *AccountTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was approved,
 // this will contain details of the approval.
 type AccountTransferApproval struct {
@@ -187,6 +210,14 @@ func (r *AccountTransferApproval) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountTransferApproval) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountTransferApprovalJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was not
 // approved, this will contain details of the cancellation.
 type AccountTransferCancellation struct {
@@ -212,6 +243,14 @@ func (r *AccountTransferCancellation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r AccountTransferCancellation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r accountTransferCancellationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type AccountTransferCurrency string
@@ -231,6 +270,14 @@ const (
 	AccountTransferCurrencyUsd AccountTransferCurrency = "USD"
 )
 
+func (r AccountTransferCurrency) IsKnown() bool {
+	switch r {
+	case AccountTransferCurrencyCad, AccountTransferCurrencyChf, AccountTransferCurrencyEur, AccountTransferCurrencyGbp, AccountTransferCurrencyJpy, AccountTransferCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The transfer's network.
 type AccountTransferNetwork string
 
@@ -238,6 +285,14 @@ const (
 	AccountTransferNetworkAccount AccountTransferNetwork = "account"
 )
 
+func (r AccountTransferNetwork) IsKnown() bool {
+	switch r {
+	case AccountTransferNetworkAccount:
+		return true
+	}
+	return false
+}
+
 // The lifecycle status of the transfer.
 type AccountTransferStatus string
 
@@ -250,6 +305,14 @@ const (
 	AccountTransferStatusComplete AccountTransferStatus = "complete"
 )
 
+func (r AccountTransferStatus) IsKnown() bool {
+	switch r {
+	case AccountTransferStatusPendingApproval, AccountTransferStatusCanceled, AccountTransferStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `account_transfer`.
 type AccountTransferType string
@@ -258,6 +321,14 @@ const (
 	AccountTransferTypeAccountTransfer AccountTransferType = "account_transfer"
 )
 
+func (r AccountTransferType) IsKnown() bool {
+	switch r {
+	case AccountTransferTypeAccountTransfer:
+		return true
+	}
+	return false
+}
+
 type AccountTransferNewParams struct {
 	// The identifier for the account that will send the transfer.
 	AccountID param.Field[string] `json:"account_id,required"`
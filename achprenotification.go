@@ -38,6 +38,7 @@ func NewACHPrenotificationService(opts ...option.RequestOption) (r *ACHPrenotifi
 // Create an ACH Prenotification
 func (r *ACHPrenotificationService) New(ctx context.Context, body ACHPrenotificationNewParams, opts ...option.RequestOption) (res *ACHPrenotification, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHPrenotifications.New")}, opts...)
 	path := "ach_prenotifications"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *ACHPrenotificationService) New(ctx context.Context, body ACHPrenotifica
 // Retrieve an ACH Prenotification
 func (r *ACHPrenotificationService) Get(ctx context.Context, achPrenotificationID string, opts ...option.RequestOption) (res *ACHPrenotification, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHPrenotifications.Get")}, opts...)
 	path := fmt.Sprintf("ach_prenotifications/%s", achPrenotificationID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *ACHPrenotificationService) Get(ctx context.Context, achPrenotificationI
 func (r *ACHPrenotificationService) List(ctx context.Context, query ACHPrenotificationListParams, opts ...option.RequestOption) (res *shared.Page[ACHPrenotification], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("ACHPrenotifications.List")}, opts...)
 	path := "ach_prenotifications"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -139,6 +141,14 @@ func (r *ACHPrenotification) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHPrenotification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achPrenotificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the notification is for a future credit or debit.
 type ACHPrenotificationCreditDebitIndicator string
 
@@ -149,6 +159,14 @@ const (
 	ACHPrenotificationCreditDebitIndicatorDebit ACHPrenotificationCreditDebitIndicator = "debit"
 )
 
+func (r ACHPrenotificationCreditDebitIndicator) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationCreditDebitIndicatorCredit, ACHPrenotificationCreditDebitIndicatorDebit:
+		return true
+	}
+	return false
+}
+
 type ACHPrenotificationNotificationsOfChange struct {
 	// The required type of change that is being signaled by the receiving financial
 	// institution.
@@ -175,6 +193,14 @@ func (r *ACHPrenotificationNotificationsOfChange) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHPrenotificationNotificationsOfChange) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achPrenotificationNotificationsOfChangeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The required type of change that is being signaled by the receiving financial
 // institution.
 type ACHPrenotificationNotificationsOfChangeChangeCode string
@@ -223,6 +249,14 @@ const (
 	ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectTransactionCodeByOriginatingDepositoryFinancialInstitution ACHPrenotificationNotificationsOfChangeChangeCode = "incorrect_transaction_code_by_originating_depository_financial_institution"
 )
 
+func (r ACHPrenotificationNotificationsOfChangeChangeCode) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectAccountNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectRoutingNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectRoutingNumberAndAccountNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectTransactionCode, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectAccountNumberAndTransactionCode, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectRoutingNumberAccountNumberAndTransactionCode, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectReceivingDepositoryFinancialInstitutionIdentification, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectIndividualIdentificationNumber, ACHPrenotificationNotificationsOfChangeChangeCodeAddendaFormatError, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectStandardEntryClassCodeForOutboundInternationalPayment, ACHPrenotificationNotificationsOfChangeChangeCodeMisroutedNotificationOfChange, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectTraceNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectCompanyIdentificationNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectIdentificationNumber, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectlyFormattedCorrectedData, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectDiscretionaryData, ACHPrenotificationNotificationsOfChangeChangeCodeRoutingNumberNotFromOriginalEntryDetailRecord, ACHPrenotificationNotificationsOfChangeChangeCodeDepositoryFinancialInstitutionAccountNumberNotFromOriginalEntryDetailRecord, ACHPrenotificationNotificationsOfChangeChangeCodeIncorrectTransactionCodeByOriginatingDepositoryFinancialInstitution:
+		return true
+	}
+	return false
+}
+
 // If your prenotification is returned, this will contain details of the return.
 type ACHPrenotificationPrenotificationReturn struct {
 	// The [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) date and time at which
@@ -246,6 +280,14 @@ func (r *ACHPrenotificationPrenotificationReturn) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHPrenotificationPrenotificationReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achPrenotificationPrenotificationReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the Prenotification was returned.
 type ACHPrenotificationPrenotificationReturnReturnReasonCode string
 
@@ -437,6 +479,14 @@ const (
 	ACHPrenotificationPrenotificationReturnReturnReasonCodeUntimelyReturn ACHPrenotificationPrenotificationReturnReturnReasonCode = "untimely_return"
 )
 
+func (r ACHPrenotificationPrenotificationReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationPrenotificationReturnReturnReasonCodeInsufficientFund, ACHPrenotificationPrenotificationReturnReturnReasonCodeNoAccount, ACHPrenotificationPrenotificationReturnReturnReasonCodeAccountClosed, ACHPrenotificationPrenotificationReturnReturnReasonCodeInvalidAccountNumberStructure, ACHPrenotificationPrenotificationReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, ACHPrenotificationPrenotificationReturnReturnReasonCodeCreditEntryRefusedByReceiver, ACHPrenotificationPrenotificationReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, ACHPrenotificationPrenotificationReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, ACHPrenotificationPrenotificationReturnReturnReasonCodePaymentStopped, ACHPrenotificationPrenotificationReturnReturnReasonCodeNonTransactionAccount, ACHPrenotificationPrenotificationReturnReturnReasonCodeUncollectedFunds, ACHPrenotificationPrenotificationReturnReturnReasonCodeRoutingNumberCheckDigitError, ACHPrenotificationPrenotificationReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, ACHPrenotificationPrenotificationReturnReturnReasonCodeAmountFieldError, ACHPrenotificationPrenotificationReturnReturnReasonCodeAuthorizationRevokedByCustomer, ACHPrenotificationPrenotificationReturnReturnReasonCodeInvalidACHRoutingNumber, ACHPrenotificationPrenotificationReturnReturnReasonCodeFileRecordEditCriteria, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrInvalidIndividualName, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnedPerOdfiRequest, ACHPrenotificationPrenotificationReturnReturnReasonCodeLimitedParticipationDfi, ACHPrenotificationPrenotificationReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, ACHPrenotificationPrenotificationReturnReturnReasonCodeAccountSoldToAnotherDfi, ACHPrenotificationPrenotificationReturnReturnReasonCodeAddendaError, ACHPrenotificationPrenotificationReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, ACHPrenotificationPrenotificationReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, ACHPrenotificationPrenotificationReturnReturnReasonCodeCorrectedReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeDuplicateEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeDuplicateReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrDuplicateEnrollment, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrInvalidDfiAccountNumber, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrInvalidIndividualIDNumber, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrInvalidTransactionCode, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrReturnOfEnrEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, ACHPrenotificationPrenotificationReturnReturnReasonCodeEntryNotProcessedByGateway, ACHPrenotificationPrenotificationReturnReturnReasonCodeFieldError, ACHPrenotificationPrenotificationReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, ACHPrenotificationPrenotificationReturnReturnReasonCodeIatEntryCodingError, ACHPrenotificationPrenotificationReturnReturnReasonCodeImproperEffectiveEntryDate, ACHPrenotificationPrenotificationReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, ACHPrenotificationPrenotificationReturnReturnReasonCodeInvalidCompanyID, ACHPrenotificationPrenotificationReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, ACHPrenotificationPrenotificationReturnReturnReasonCodeInvalidIndividualIDNumber, ACHPrenotificationPrenotificationReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, ACHPrenotificationPrenotificationReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, ACHPrenotificationPrenotificationReturnReturnReasonCodeMandatoryFieldError, ACHPrenotificationPrenotificationReturnReturnReasonCodeMisroutedDishonoredReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeMisroutedReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeNoErrorsFound, ACHPrenotificationPrenotificationReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeNonParticipantInIatProgram, ACHPrenotificationPrenotificationReturnReturnReasonCodePermissibleReturnEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodePermissibleReturnEntryNotAccepted, ACHPrenotificationPrenotificationReturnReturnReasonCodeRdfiNonSettlement, ACHPrenotificationPrenotificationReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, ACHPrenotificationPrenotificationReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnNotADuplicate, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnOfImproperCreditEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnOfImproperDebitEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeReturnOfXckEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeSourceDocumentPresentedForPayment, ACHPrenotificationPrenotificationReturnReturnReasonCodeStateLawAffectingRckAcceptance, ACHPrenotificationPrenotificationReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, ACHPrenotificationPrenotificationReturnReturnReasonCodeStopPaymentOnSourceDocument, ACHPrenotificationPrenotificationReturnReturnReasonCodeTimelyOriginalReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeTraceNumberError, ACHPrenotificationPrenotificationReturnReturnReasonCodeUntimelyDishonoredReturn, ACHPrenotificationPrenotificationReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // The lifecycle status of the ACH Prenotification.
 type ACHPrenotificationStatus string
 
@@ -451,6 +501,14 @@ const (
 	ACHPrenotificationStatusSubmitted ACHPrenotificationStatus = "submitted"
 )
 
+func (r ACHPrenotificationStatus) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationStatusPendingSubmitting, ACHPrenotificationStatusRequiresAttention, ACHPrenotificationStatusReturned, ACHPrenotificationStatusSubmitted:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `ach_prenotification`.
 type ACHPrenotificationType string
@@ -459,6 +517,14 @@ const (
 	ACHPrenotificationTypeACHPrenotification ACHPrenotificationType = "ach_prenotification"
 )
 
+func (r ACHPrenotificationType) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationTypeACHPrenotification:
+		return true
+	}
+	return false
+}
+
 type ACHPrenotificationNewParams struct {
 	// The account number for the destination account.
 	AccountNumber param.Field[string] `json:"account_number,required"`
@@ -503,6 +569,14 @@ const (
 	ACHPrenotificationNewParamsCreditDebitIndicatorDebit ACHPrenotificationNewParamsCreditDebitIndicator = "debit"
 )
 
+func (r ACHPrenotificationNewParamsCreditDebitIndicator) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationNewParamsCreditDebitIndicatorCredit, ACHPrenotificationNewParamsCreditDebitIndicatorDebit:
+		return true
+	}
+	return false
+}
+
 // The Standard Entry Class (SEC) code to use for the ACH Prenotification.
 type ACHPrenotificationNewParamsStandardEntryClassCode string
 
@@ -515,6 +589,14 @@ const (
 	ACHPrenotificationNewParamsStandardEntryClassCodeInternetInitiated ACHPrenotificationNewParamsStandardEntryClassCode = "internet_initiated"
 )
 
+func (r ACHPrenotificationNewParamsStandardEntryClassCode) IsKnown() bool {
+	switch r {
+	case ACHPrenotificationNewParamsStandardEntryClassCodeCorporateCreditOrDebit, ACHPrenotificationNewParamsStandardEntryClassCodePrearrangedPaymentsAndDeposit, ACHPrenotificationNewParamsStandardEntryClassCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 type ACHPrenotificationListParams struct {
 	CreatedAt param.Field[ACHPrenotificationListParamsCreatedAt] `query:"created_at"`
 	// Return the page of entries after this one.
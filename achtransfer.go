@@ -38,6 +38,7 @@ func NewACHTransferService(opts ...option.RequestOption) (r *ACHTransferService)
 // Create an ACH Transfer
 func (r *ACHTransferService) New(ctx context.Context, body ACHTransferNewParams, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHTransfers.New")}, opts...)
 	path := "ach_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,16 +47,28 @@ func (r *ACHTransferService) New(ctx context.Context, body ACHTransferNewParams,
 // Retrieve an ACH Transfer
 func (r *ACHTransferService) Get(ctx context.Context, achTransferID string, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHTransfers.Get")}, opts...)
 	path := fmt.Sprintf("ach_transfers/%s", achTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// Create an ACH Transfer, or if one was already created with the same
+// UniqueIdentifier, retrieve and return it with existed set to true.
+func (r *ACHTransferService) NewOrGet(ctx context.Context, body ACHTransferNewParams, opts ...option.RequestOption) (res *ACHTransfer, existed bool, err error) {
+	res, err = r.New(ctx, body, opts...)
+	if id, ok := existingResourceID(err); ok {
+		res, err = r.Get(ctx, id, opts...)
+		return res, err == nil, err
+	}
+	return
+}
+
 // List ACH Transfers
 func (r *ACHTransferService) List(ctx context.Context, query ACHTransferListParams, opts ...option.RequestOption) (res *shared.Page[ACHTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("ACHTransfers.List")}, opts...)
 	path := "ach_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +90,7 @@ func (r *ACHTransferService) ListAutoPaging(ctx context.Context, query ACHTransf
 // Approves an ACH Transfer in a pending_approval state.
 func (r *ACHTransferService) Approve(ctx context.Context, achTransferID string, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHTransfers.Approve")}, opts...)
 	path := fmt.Sprintf("ach_transfers/%s/approve", achTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -85,6 +99,7 @@ func (r *ACHTransferService) Approve(ctx context.Context, achTransferID string,
 // Cancels an ACH Transfer in a pending_approval state.
 func (r *ACHTransferService) Cancel(ctx context.Context, achTransferID string, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ACHTransfers.Cancel")}, opts...)
 	path := fmt.Sprintf("ach_transfers/%s/cancel", achTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -218,6 +233,14 @@ func (r *ACHTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // After the transfer is acknowledged by FedACH, this will contain supplemental
 // details. The Federal Reserve sends an acknowledgement message for each file that
 // Increase submits.
@@ -240,6 +263,14 @@ func (r *ACHTransferAcknowledgement) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferAcknowledgement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferAcknowledgementJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was approved,
 // this will contain details of the approval.
 type ACHTransferApproval struct {
@@ -265,6 +296,14 @@ func (r *ACHTransferApproval) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferApproval) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferApprovalJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was not
 // approved, this will contain details of the cancellation.
 type ACHTransferCancellation struct {
@@ -290,6 +329,14 @@ func (r *ACHTransferCancellation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferCancellation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferCancellationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transfer's
 // currency. For ACH transfers this is always equal to `usd`.
 type ACHTransferCurrency string
@@ -309,6 +356,14 @@ const (
 	ACHTransferCurrencyUsd ACHTransferCurrency = "USD"
 )
 
+func (r ACHTransferCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferCurrencyCad, ACHTransferCurrencyChf, ACHTransferCurrencyEur, ACHTransferCurrencyGbp, ACHTransferCurrencyJpy, ACHTransferCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the account to which the transfer will be sent.
 type ACHTransferFunding string
 
@@ -319,6 +374,14 @@ const (
 	ACHTransferFundingSavings ACHTransferFunding = "savings"
 )
 
+func (r ACHTransferFunding) IsKnown() bool {
+	switch r {
+	case ACHTransferFundingChecking, ACHTransferFundingSavings:
+		return true
+	}
+	return false
+}
+
 // The transfer's network.
 type ACHTransferNetwork string
 
@@ -326,6 +389,14 @@ const (
 	ACHTransferNetworkACH ACHTransferNetwork = "ach"
 )
 
+func (r ACHTransferNetwork) IsKnown() bool {
+	switch r {
+	case ACHTransferNetworkACH:
+		return true
+	}
+	return false
+}
+
 type ACHTransferNotificationsOfChange struct {
 	// The required type of change that is being signaled by the receiving financial
 	// institution.
@@ -352,6 +423,14 @@ func (r *ACHTransferNotificationsOfChange) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferNotificationsOfChange) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferNotificationsOfChangeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The required type of change that is being signaled by the receiving financial
 // institution.
 type ACHTransferNotificationsOfChangeChangeCode string
@@ -400,6 +479,14 @@ const (
 	ACHTransferNotificationsOfChangeChangeCodeIncorrectTransactionCodeByOriginatingDepositoryFinancialInstitution ACHTransferNotificationsOfChangeChangeCode = "incorrect_transaction_code_by_originating_depository_financial_institution"
 )
 
+func (r ACHTransferNotificationsOfChangeChangeCode) IsKnown() bool {
+	switch r {
+	case ACHTransferNotificationsOfChangeChangeCodeIncorrectAccountNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectRoutingNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectRoutingNumberAndAccountNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectTransactionCode, ACHTransferNotificationsOfChangeChangeCodeIncorrectAccountNumberAndTransactionCode, ACHTransferNotificationsOfChangeChangeCodeIncorrectRoutingNumberAccountNumberAndTransactionCode, ACHTransferNotificationsOfChangeChangeCodeIncorrectReceivingDepositoryFinancialInstitutionIdentification, ACHTransferNotificationsOfChangeChangeCodeIncorrectIndividualIdentificationNumber, ACHTransferNotificationsOfChangeChangeCodeAddendaFormatError, ACHTransferNotificationsOfChangeChangeCodeIncorrectStandardEntryClassCodeForOutboundInternationalPayment, ACHTransferNotificationsOfChangeChangeCodeMisroutedNotificationOfChange, ACHTransferNotificationsOfChangeChangeCodeIncorrectTraceNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectCompanyIdentificationNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectIdentificationNumber, ACHTransferNotificationsOfChangeChangeCodeIncorrectlyFormattedCorrectedData, ACHTransferNotificationsOfChangeChangeCodeIncorrectDiscretionaryData, ACHTransferNotificationsOfChangeChangeCodeRoutingNumberNotFromOriginalEntryDetailRecord, ACHTransferNotificationsOfChangeChangeCodeDepositoryFinancialInstitutionAccountNumberNotFromOriginalEntryDetailRecord, ACHTransferNotificationsOfChangeChangeCodeIncorrectTransactionCodeByOriginatingDepositoryFinancialInstitution:
+		return true
+	}
+	return false
+}
+
 // If your transfer is returned, this will contain details of the return.
 type ACHTransferReturn struct {
 	// The [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) date and time at which
@@ -433,6 +520,14 @@ func (r *ACHTransferReturn) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH Transfer was returned. This reason code is sent by the receiving
 // bank back to Increase.
 type ACHTransferReturnReturnReasonCode string
@@ -625,6 +720,14 @@ const (
 	ACHTransferReturnReturnReasonCodeUntimelyReturn ACHTransferReturnReturnReasonCode = "untimely_return"
 )
 
+func (r ACHTransferReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case ACHTransferReturnReturnReasonCodeInsufficientFund, ACHTransferReturnReturnReasonCodeNoAccount, ACHTransferReturnReturnReasonCodeAccountClosed, ACHTransferReturnReturnReasonCodeInvalidAccountNumberStructure, ACHTransferReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, ACHTransferReturnReturnReasonCodeCreditEntryRefusedByReceiver, ACHTransferReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, ACHTransferReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, ACHTransferReturnReturnReasonCodePaymentStopped, ACHTransferReturnReturnReasonCodeNonTransactionAccount, ACHTransferReturnReturnReasonCodeUncollectedFunds, ACHTransferReturnReturnReasonCodeRoutingNumberCheckDigitError, ACHTransferReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, ACHTransferReturnReturnReasonCodeAmountFieldError, ACHTransferReturnReturnReasonCodeAuthorizationRevokedByCustomer, ACHTransferReturnReturnReasonCodeInvalidACHRoutingNumber, ACHTransferReturnReturnReasonCodeFileRecordEditCriteria, ACHTransferReturnReturnReasonCodeEnrInvalidIndividualName, ACHTransferReturnReturnReasonCodeReturnedPerOdfiRequest, ACHTransferReturnReturnReasonCodeLimitedParticipationDfi, ACHTransferReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, ACHTransferReturnReturnReasonCodeAccountSoldToAnotherDfi, ACHTransferReturnReturnReasonCodeAddendaError, ACHTransferReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, ACHTransferReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, ACHTransferReturnReturnReasonCodeCorrectedReturn, ACHTransferReturnReturnReasonCodeDuplicateEntry, ACHTransferReturnReturnReasonCodeDuplicateReturn, ACHTransferReturnReturnReasonCodeEnrDuplicateEnrollment, ACHTransferReturnReturnReasonCodeEnrInvalidDfiAccountNumber, ACHTransferReturnReturnReasonCodeEnrInvalidIndividualIDNumber, ACHTransferReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, ACHTransferReturnReturnReasonCodeEnrInvalidTransactionCode, ACHTransferReturnReturnReasonCodeEnrReturnOfEnrEntry, ACHTransferReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, ACHTransferReturnReturnReasonCodeEntryNotProcessedByGateway, ACHTransferReturnReturnReasonCodeFieldError, ACHTransferReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, ACHTransferReturnReturnReasonCodeIatEntryCodingError, ACHTransferReturnReturnReasonCodeImproperEffectiveEntryDate, ACHTransferReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, ACHTransferReturnReturnReasonCodeInvalidCompanyID, ACHTransferReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, ACHTransferReturnReturnReasonCodeInvalidIndividualIDNumber, ACHTransferReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, ACHTransferReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, ACHTransferReturnReturnReasonCodeMandatoryFieldError, ACHTransferReturnReturnReasonCodeMisroutedDishonoredReturn, ACHTransferReturnReturnReasonCodeMisroutedReturn, ACHTransferReturnReturnReasonCodeNoErrorsFound, ACHTransferReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, ACHTransferReturnReturnReasonCodeNonParticipantInIatProgram, ACHTransferReturnReturnReasonCodePermissibleReturnEntry, ACHTransferReturnReturnReasonCodePermissibleReturnEntryNotAccepted, ACHTransferReturnReturnReasonCodeRdfiNonSettlement, ACHTransferReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, ACHTransferReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, ACHTransferReturnReturnReasonCodeReturnNotADuplicate, ACHTransferReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, ACHTransferReturnReturnReasonCodeReturnOfImproperCreditEntry, ACHTransferReturnReturnReasonCodeReturnOfImproperDebitEntry, ACHTransferReturnReturnReasonCodeReturnOfXckEntry, ACHTransferReturnReturnReasonCodeSourceDocumentPresentedForPayment, ACHTransferReturnReturnReasonCodeStateLawAffectingRckAcceptance, ACHTransferReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, ACHTransferReturnReturnReasonCodeStopPaymentOnSourceDocument, ACHTransferReturnReturnReasonCodeTimelyOriginalReturn, ACHTransferReturnReturnReasonCodeTraceNumberError, ACHTransferReturnReturnReasonCodeUntimelyDishonoredReturn, ACHTransferReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // The Standard Entry Class (SEC) code to use for the transfer.
 type ACHTransferStandardEntryClassCode string
 
@@ -637,6 +740,14 @@ const (
 	ACHTransferStandardEntryClassCodeInternetInitiated ACHTransferStandardEntryClassCode = "internet_initiated"
 )
 
+func (r ACHTransferStandardEntryClassCode) IsKnown() bool {
+	switch r {
+	case ACHTransferStandardEntryClassCodeCorporateCreditOrDebit, ACHTransferStandardEntryClassCodePrearrangedPaymentsAndDeposit, ACHTransferStandardEntryClassCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // The lifecycle status of the transfer.
 type ACHTransferStatus string
 
@@ -659,6 +770,14 @@ const (
 	ACHTransferStatusRejected ACHTransferStatus = "rejected"
 )
 
+func (r ACHTransferStatus) IsKnown() bool {
+	switch r {
+	case ACHTransferStatusPendingApproval, ACHTransferStatusCanceled, ACHTransferStatusPendingReviewing, ACHTransferStatusPendingSubmission, ACHTransferStatusSubmitted, ACHTransferStatusReturned, ACHTransferStatusRequiresAttention, ACHTransferStatusRejected:
+		return true
+	}
+	return false
+}
+
 // After the transfer is submitted to FedACH, this will contain supplemental
 // details. Increase batches transfers and submits a file to the Federal Reserve
 // roughly every 30 minutes. The Federal Reserve processes ACH transfers during
@@ -701,6 +820,14 @@ func (r *ACHTransferSubmission) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSubmission) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSubmissionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `ach_transfer`.
 type ACHTransferType string
@@ -709,6 +836,14 @@ const (
 	ACHTransferTypeACHTransfer ACHTransferType = "ach_transfer"
 )
 
+func (r ACHTransferType) IsKnown() bool {
+	switch r {
+	case ACHTransferTypeACHTransfer:
+		return true
+	}
+	return false
+}
+
 type ACHTransferNewParams struct {
 	// The Increase identifier for the account that will send the transfer.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -780,6 +915,14 @@ const (
 	ACHTransferNewParamsFundingSavings ACHTransferNewParamsFunding = "savings"
 )
 
+func (r ACHTransferNewParamsFunding) IsKnown() bool {
+	switch r {
+	case ACHTransferNewParamsFundingChecking, ACHTransferNewParamsFundingSavings:
+		return true
+	}
+	return false
+}
+
 // The Standard Entry Class (SEC) code to use for the transfer.
 type ACHTransferNewParamsStandardEntryClassCode string
 
@@ -792,6 +935,14 @@ const (
 	ACHTransferNewParamsStandardEntryClassCodeInternetInitiated ACHTransferNewParamsStandardEntryClassCode = "internet_initiated"
 )
 
+func (r ACHTransferNewParamsStandardEntryClassCode) IsKnown() bool {
+	switch r {
+	case ACHTransferNewParamsStandardEntryClassCodeCorporateCreditOrDebit, ACHTransferNewParamsStandardEntryClassCodePrearrangedPaymentsAndDeposit, ACHTransferNewParamsStandardEntryClassCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 type ACHTransferListParams struct {
 	// Filter ACH Transfers to those that originated from the specified Account.
 	AccountID param.Field[string]                         `query:"account_id"`
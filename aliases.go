@@ -4,6 +4,60 @@ package increase
 
 import (
 	"github.com/increase/increase-go/internal/apierror"
+	"github.com/increase/increase-go/internal/requestconfig"
 )
 
 type Error = apierror.Error
+
+// FieldError describes a single request parameter that could not be parsed, as
+// listed in the Errors of an invalid_parameters_error.
+type FieldError = apierror.FieldError
+
+type ErrorStatus = apierror.ErrorStatus
+
+const ErrorStatus400 = apierror.ErrorStatus400
+const ErrorStatus401 = apierror.ErrorStatus401
+const ErrorStatus403 = apierror.ErrorStatus403
+const ErrorStatus404 = apierror.ErrorStatus404
+const ErrorStatus409 = apierror.ErrorStatus409
+const ErrorStatus422 = apierror.ErrorStatus422
+const ErrorStatus429 = apierror.ErrorStatus429
+const ErrorStatus500 = apierror.ErrorStatus500
+
+type ErrorType = apierror.ErrorType
+
+const ErrorTypeInvalidParametersError = apierror.ErrorTypeInvalidParametersError
+const ErrorTypeMalformedRequestError = apierror.ErrorTypeMalformedRequestError
+const ErrorTypeInvalidAPIKeyError = apierror.ErrorTypeInvalidAPIKeyError
+const ErrorTypeEnvironmentMismatchError = apierror.ErrorTypeEnvironmentMismatchError
+const ErrorTypeInsufficientPermissionsError = apierror.ErrorTypeInsufficientPermissionsError
+const ErrorTypePrivateFeatureError = apierror.ErrorTypePrivateFeatureError
+const ErrorTypeAPIMethodNotFoundError = apierror.ErrorTypeAPIMethodNotFoundError
+const ErrorTypeObjectNotFoundError = apierror.ErrorTypeObjectNotFoundError
+const ErrorTypeIdempotencyKeyAlreadyUsedError = apierror.ErrorTypeIdempotencyKeyAlreadyUsedError
+const ErrorTypeInvalidOperationError = apierror.ErrorTypeInvalidOperationError
+const ErrorTypeUniqueIdentifierAlreadyExistsError = apierror.ErrorTypeUniqueIdentifierAlreadyExistsError
+const ErrorTypeIdempotencyUnprocessableError = apierror.ErrorTypeIdempotencyUnprocessableError
+const ErrorTypeRateLimitedError = apierror.ErrorTypeRateLimitedError
+const ErrorTypeInternalServerError = apierror.ErrorTypeInternalServerError
+
+// Sentinel errors for each [ErrorType]. An [*Error] of the corresponding type
+// matches these with [errors.Is].
+var (
+	ErrInvalidParameters             = apierror.ErrInvalidParameters
+	ErrMalformedRequest              = apierror.ErrMalformedRequest
+	ErrInvalidAPIKey                 = apierror.ErrInvalidAPIKey
+	ErrEnvironmentMismatch           = apierror.ErrEnvironmentMismatch
+	ErrInsufficientPermissions       = apierror.ErrInsufficientPermissions
+	ErrPrivateFeature                = apierror.ErrPrivateFeature
+	ErrAPIMethodNotFound             = apierror.ErrAPIMethodNotFound
+	ErrObjectNotFound                = apierror.ErrObjectNotFound
+	ErrIdempotencyKeyAlreadyUsed     = apierror.ErrIdempotencyKeyAlreadyUsed
+	ErrInvalidOperation              = apierror.ErrInvalidOperation
+	ErrUniqueIdentifierAlreadyExists = apierror.ErrUniqueIdentifierAlreadyExists
+	ErrIdempotencyUnprocessable      = apierror.ErrIdempotencyUnprocessable
+	ErrRateLimited                   = apierror.ErrRateLimited
+	ErrInternalServer                = apierror.ErrInternalServer
+)
+
+type ResponseMeta = requestconfig.ResponseMeta
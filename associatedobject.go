@@ -0,0 +1,167 @@
+package increase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/increase/increase-go/option"
+)
+
+// AssociatedObject is the object that an Event is about, as returned by
+// [EventService.GetAssociatedObject]. Its dynamic type is one of
+// *[Account], *[AccountNumber], *[AccountStatement], *[AccountTransfer],
+// *[ACHPrenotification], *[ACHTransfer], *[BookkeepingEntry],
+// *[BookkeepingEntrySet], *[Card], *[CardDispute], *[CardPayment],
+// *[CardProfile], *[CardPurchaseSupplement], *[CheckDeposit], *[CheckTransfer],
+// *[DeclinedTransaction], *[DigitalWalletToken], *[Document], *[Entity],
+// *[EventSubscription], *[Export], *[ExternalAccount], *[File], *[Group],
+// *[InboundACHTransfer], *[InboundWireDrawdownRequest], *[OauthConnection],
+// *[PendingTransaction], *[PhysicalCard], *[Program], *[RealTimeDecision],
+// *[RealTimePaymentsTransfer], *[Transaction], *[WireDrawdownRequest],
+// or *[WireTransfer].
+//
+// More types may be added as the API grows, so a type switch over it should
+// have a default case.
+type AssociatedObject interface {
+	implementsAssociatedObject()
+}
+
+func (*Account) implementsAssociatedObject()                    {}
+func (*AccountNumber) implementsAssociatedObject()              {}
+func (*AccountStatement) implementsAssociatedObject()           {}
+func (*AccountTransfer) implementsAssociatedObject()            {}
+func (*ACHPrenotification) implementsAssociatedObject()         {}
+func (*ACHTransfer) implementsAssociatedObject()                {}
+func (*BookkeepingEntry) implementsAssociatedObject()           {}
+func (*BookkeepingEntrySet) implementsAssociatedObject()        {}
+func (*Card) implementsAssociatedObject()                       {}
+func (*CardDispute) implementsAssociatedObject()                {}
+func (*CardPayment) implementsAssociatedObject()                {}
+func (*CardProfile) implementsAssociatedObject()                {}
+func (*CardPurchaseSupplement) implementsAssociatedObject()     {}
+func (*CheckDeposit) implementsAssociatedObject()               {}
+func (*CheckTransfer) implementsAssociatedObject()              {}
+func (*DeclinedTransaction) implementsAssociatedObject()        {}
+func (*DigitalWalletToken) implementsAssociatedObject()         {}
+func (*Document) implementsAssociatedObject()                   {}
+func (*Entity) implementsAssociatedObject()                     {}
+func (*EventSubscription) implementsAssociatedObject()          {}
+func (*Export) implementsAssociatedObject()                     {}
+func (*ExternalAccount) implementsAssociatedObject()            {}
+func (*File) implementsAssociatedObject()                       {}
+func (*Group) implementsAssociatedObject()                      {}
+func (*InboundACHTransfer) implementsAssociatedObject()         {}
+func (*InboundWireDrawdownRequest) implementsAssociatedObject() {}
+func (*OauthConnection) implementsAssociatedObject()            {}
+func (*PendingTransaction) implementsAssociatedObject()         {}
+func (*PhysicalCard) implementsAssociatedObject()               {}
+func (*Program) implementsAssociatedObject()                    {}
+func (*RealTimeDecision) implementsAssociatedObject()           {}
+func (*RealTimePaymentsTransfer) implementsAssociatedObject()   {}
+func (*Transaction) implementsAssociatedObject()                {}
+func (*WireDrawdownRequest) implementsAssociatedObject()        {}
+func (*WireTransfer) implementsAssociatedObject()               {}
+
+// Retrieve the object that an Event is about, using its AssociatedObjectType to
+// choose the service to retrieve it from. It returns an error wrapping
+// [ErrUnknownAssociatedObjectType] for types of object which this SDK cannot
+// retrieve.
+//
+//	object, err := client.Events.GetAssociatedObject(ctx, event)
+//	if err != nil {
+//		return err
+//	}
+//	switch object := object.(type) {
+//	case *increase.ACHTransfer:
+//		fmt.Println(object.Status)
+//	case *increase.CardPayment:
+//		fmt.Println(object.State.SettledAmount)
+//	}
+func (r *EventService) GetAssociatedObject(ctx context.Context, event *Event, opts ...option.RequestOption) (res AssociatedObject, err error) {
+	opts = append(r.Options[:], opts...)
+	id := event.AssociatedObjectID
+	switch event.AssociatedObjectType {
+	case "account":
+		return associatedObject(NewAccountService(opts...).Get(ctx, id))
+	case "account_number":
+		return associatedObject(NewAccountNumberService(opts...).Get(ctx, id))
+	case "account_statement":
+		return associatedObject(NewAccountStatementService(opts...).Get(ctx, id))
+	case "account_transfer":
+		return associatedObject(NewAccountTransferService(opts...).Get(ctx, id))
+	case "ach_prenotification":
+		return associatedObject(NewACHPrenotificationService(opts...).Get(ctx, id))
+	case "ach_transfer":
+		return associatedObject(NewACHTransferService(opts...).Get(ctx, id))
+	case "bookkeeping_entry":
+		return associatedObject(NewBookkeepingEntryService(opts...).Get(ctx, id))
+	case "bookkeeping_entry_set":
+		return associatedObject(NewBookkeepingEntrySetService(opts...).Get(ctx, id))
+	case "card":
+		return associatedObject(NewCardService(opts...).Get(ctx, id))
+	case "card_dispute":
+		return associatedObject(NewCardDisputeService(opts...).Get(ctx, id))
+	case "card_payment":
+		return associatedObject(NewCardPaymentService(opts...).Get(ctx, id))
+	case "card_profile":
+		return associatedObject(NewCardProfileService(opts...).Get(ctx, id))
+	case "card_purchase_supplement":
+		return associatedObject(NewCardPurchaseSupplementService(opts...).Get(ctx, id))
+	case "check_deposit":
+		return associatedObject(NewCheckDepositService(opts...).Get(ctx, id))
+	case "check_transfer":
+		return associatedObject(NewCheckTransferService(opts...).Get(ctx, id))
+	case "declined_transaction":
+		return associatedObject(NewDeclinedTransactionService(opts...).Get(ctx, id))
+	case "digital_wallet_token":
+		return associatedObject(NewDigitalWalletTokenService(opts...).Get(ctx, id))
+	case "document":
+		return associatedObject(NewDocumentService(opts...).Get(ctx, id))
+	case "entity":
+		return associatedObject(NewEntityService(opts...).Get(ctx, id))
+	case "event_subscription":
+		return associatedObject(NewEventSubscriptionService(opts...).Get(ctx, id))
+	case "export":
+		return associatedObject(NewExportService(opts...).Get(ctx, id))
+	case "external_account":
+		return associatedObject(NewExternalAccountService(opts...).Get(ctx, id))
+	case "file":
+		return associatedObject(NewFileService(opts...).Get(ctx, id))
+	case "group":
+		return associatedObject(NewGroupService(opts...).GetDetails(ctx))
+	case "inbound_ach_transfer":
+		return associatedObject(NewInboundACHTransferService(opts...).Get(ctx, id))
+	case "inbound_wire_drawdown_request":
+		return associatedObject(NewInboundWireDrawdownRequestService(opts...).Get(ctx, id))
+	case "oauth_connection":
+		return associatedObject(NewOauthConnectionService(opts...).Get(ctx, id))
+	case "pending_transaction":
+		return associatedObject(NewPendingTransactionService(opts...).Get(ctx, id))
+	case "physical_card":
+		return associatedObject(NewPhysicalCardService(opts...).Get(ctx, id))
+	case "program":
+		return associatedObject(NewProgramService(opts...).Get(ctx, id))
+	case "real_time_decision":
+		return associatedObject(NewRealTimeDecisionService(opts...).Get(ctx, id))
+	case "real_time_payments_transfer":
+		return associatedObject(NewRealTimePaymentsTransferService(opts...).Get(ctx, id))
+	case "transaction":
+		return associatedObject(NewTransactionService(opts...).Get(ctx, id))
+	case "wire_drawdown_request":
+		return associatedObject(NewWireDrawdownRequestService(opts...).Get(ctx, id))
+	case "wire_transfer":
+		return associatedObject(NewWireTransferService(opts...).Get(ctx, id))
+	default:
+		return nil, fmt.Errorf("%w %q of event %s", ErrUnknownAssociatedObjectType, event.AssociatedObjectType, event.ID)
+	}
+}
+
+// associatedObject returns the result of a retrieve method as an
+// AssociatedObject, which is nil rather than a nil pointer if there is an
+// error.
+func associatedObject[T AssociatedObject](res T, err error) (AssociatedObject, error) {
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
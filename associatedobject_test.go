@@ -0,0 +1,54 @@
+package increase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+func TestGetAssociatedObject(t *testing.T) {
+	var paths []string
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		return jsonResponse(req, 200, `{"id":"ach_transfer_uoxatyh3lt5evrsdvo7q","status":"submitted","type":"ach_transfer"}`), nil
+	})
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+
+	event := &increase.Event{
+		ID:                   "event_001dzz0r20rzr4zrhrr1364hy80",
+		AssociatedObjectID:   "ach_transfer_uoxatyh3lt5evrsdvo7q",
+		AssociatedObjectType: "ach_transfer",
+		Category:             increase.EventCategoryACHTransferUpdated,
+	}
+	object, err := client.Events.GetAssociatedObject(context.Background(), event)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	transfer, ok := object.(*increase.ACHTransfer)
+	if !ok {
+		t.Fatalf("expected an *ACHTransfer, got %T", object)
+	}
+	if transfer.Status != increase.ACHTransferStatusSubmitted {
+		t.Errorf("expected the transfer to be decoded, got %+v", transfer)
+	}
+	if len(paths) != 1 || paths[0] != "/ach_transfers/ach_transfer_uoxatyh3lt5evrsdvo7q" {
+		t.Errorf("expected the transfer to be retrieved, got %v", paths)
+	}
+
+	event.AssociatedObjectType = "intrafi_exclusion"
+	object, err = client.Events.GetAssociatedObject(context.Background(), event)
+	if !errors.Is(err, increase.ErrUnknownAssociatedObjectType) {
+		t.Errorf("expected ErrUnknownAssociatedObjectType, got %v", err)
+	}
+	if object != nil {
+		t.Errorf("expected no object, got %v", object)
+	}
+}
@@ -38,6 +38,7 @@ func NewBookkeepingAccountService(opts ...option.RequestOption) (r *BookkeepingA
 // Create a Bookkeeping Account
 func (r *BookkeepingAccountService) New(ctx context.Context, body BookkeepingAccountNewParams, opts ...option.RequestOption) (res *BookkeepingAccount, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingAccounts.New")}, opts...)
 	path := "bookkeeping_accounts"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *BookkeepingAccountService) New(ctx context.Context, body BookkeepingAcc
 // Update a Bookkeeping Account
 func (r *BookkeepingAccountService) Update(ctx context.Context, bookkeepingAccountID string, body BookkeepingAccountUpdateParams, opts ...option.RequestOption) (res *BookkeepingAccount, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingAccounts.Update")}, opts...)
 	path := fmt.Sprintf("bookkeeping_accounts/%s", bookkeepingAccountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *BookkeepingAccountService) Update(ctx context.Context, bookkeepingAccou
 func (r *BookkeepingAccountService) List(ctx context.Context, query BookkeepingAccountListParams, opts ...option.RequestOption) (res *shared.Page[BookkeepingAccount], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("BookkeepingAccounts.List")}, opts...)
 	path := "bookkeeping_accounts"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +79,7 @@ func (r *BookkeepingAccountService) ListAutoPaging(ctx context.Context, query Bo
 // Retrieve a Bookkeeping Account Balance
 func (r *BookkeepingAccountService) Balance(ctx context.Context, bookkeepingAccountID string, query BookkeepingAccountBalanceParams, opts ...option.RequestOption) (res *BookkeepingBalanceLookup, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingAccounts.Balance")}, opts...)
 	path := fmt.Sprintf("bookkeeping_accounts/%s/balance", bookkeepingAccountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, query, &res, opts...)
 	return
@@ -119,6 +122,14 @@ func (r *BookkeepingAccount) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BookkeepingAccount) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r bookkeepingAccountJSON) RawJSON() string {
+	return r.raw
+}
+
 // The compliance category of the account.
 type BookkeepingAccountComplianceCategory string
 
@@ -129,6 +140,14 @@ const (
 	BookkeepingAccountComplianceCategoryCustomerBalance BookkeepingAccountComplianceCategory = "customer_balance"
 )
 
+func (r BookkeepingAccountComplianceCategory) IsKnown() bool {
+	switch r {
+	case BookkeepingAccountComplianceCategoryCommingledCash, BookkeepingAccountComplianceCategoryCustomerBalance:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `bookkeeping_account`.
 type BookkeepingAccountType string
@@ -137,6 +156,14 @@ const (
 	BookkeepingAccountTypeBookkeepingAccount BookkeepingAccountType = "bookkeeping_account"
 )
 
+func (r BookkeepingAccountType) IsKnown() bool {
+	switch r {
+	case BookkeepingAccountTypeBookkeepingAccount:
+		return true
+	}
+	return false
+}
+
 // Represents a request to lookup the balance of an Bookkeeping Account at a given
 // point in time.
 type BookkeepingBalanceLookup struct {
@@ -165,6 +192,14 @@ func (r *BookkeepingBalanceLookup) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BookkeepingBalanceLookup) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r bookkeepingBalanceLookupJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `bookkeeping_balance_lookup`.
 type BookkeepingBalanceLookupType string
@@ -173,6 +208,14 @@ const (
 	BookkeepingBalanceLookupTypeBookkeepingBalanceLookup BookkeepingBalanceLookupType = "bookkeeping_balance_lookup"
 )
 
+func (r BookkeepingBalanceLookupType) IsKnown() bool {
+	switch r {
+	case BookkeepingBalanceLookupTypeBookkeepingBalanceLookup:
+		return true
+	}
+	return false
+}
+
 type BookkeepingAccountNewParams struct {
 	// The name you choose for the account.
 	Name param.Field[string] `json:"name,required"`
@@ -198,6 +241,14 @@ const (
 	BookkeepingAccountNewParamsComplianceCategoryCustomerBalance BookkeepingAccountNewParamsComplianceCategory = "customer_balance"
 )
 
+func (r BookkeepingAccountNewParamsComplianceCategory) IsKnown() bool {
+	switch r {
+	case BookkeepingAccountNewParamsComplianceCategoryCommingledCash, BookkeepingAccountNewParamsComplianceCategoryCustomerBalance:
+		return true
+	}
+	return false
+}
+
 type BookkeepingAccountUpdateParams struct {
 	// The name you choose for the account.
 	Name param.Field[string] `json:"name,required"`
@@ -38,6 +38,7 @@ func NewBookkeepingEntryService(opts ...option.RequestOption) (r *BookkeepingEnt
 // Retrieve a Bookkeeping Entry
 func (r *BookkeepingEntryService) Get(ctx context.Context, bookkeepingEntryID string, opts ...option.RequestOption) (res *BookkeepingEntry, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingEntries.Get")}, opts...)
 	path := fmt.Sprintf("bookkeeping_entries/%s", bookkeepingEntryID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *BookkeepingEntryService) Get(ctx context.Context, bookkeepingEntryID st
 func (r *BookkeepingEntryService) List(ctx context.Context, query BookkeepingEntryListParams, opts ...option.RequestOption) (res *shared.Page[BookkeepingEntry], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("BookkeepingEntries.List")}, opts...)
 	path := "bookkeeping_entries"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -104,6 +105,14 @@ func (r *BookkeepingEntry) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BookkeepingEntry) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r bookkeepingEntryJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `bookkeeping_entry`.
 type BookkeepingEntryType string
@@ -112,6 +121,14 @@ const (
 	BookkeepingEntryTypeBookkeepingEntry BookkeepingEntryType = "bookkeeping_entry"
 )
 
+func (r BookkeepingEntryType) IsKnown() bool {
+	switch r {
+	case BookkeepingEntryTypeBookkeepingEntry:
+		return true
+	}
+	return false
+}
+
 type BookkeepingEntryListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
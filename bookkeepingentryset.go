@@ -38,6 +38,7 @@ func NewBookkeepingEntrySetService(opts ...option.RequestOption) (r *Bookkeeping
 // Create a Bookkeeping Entry Set
 func (r *BookkeepingEntrySetService) New(ctx context.Context, body BookkeepingEntrySetNewParams, opts ...option.RequestOption) (res *BookkeepingEntrySet, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingEntrySets.New")}, opts...)
 	path := "bookkeeping_entry_sets"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *BookkeepingEntrySetService) New(ctx context.Context, body BookkeepingEn
 // Retrieve a Bookkeeping Entry Set
 func (r *BookkeepingEntrySetService) Get(ctx context.Context, bookkeepingEntrySetID string, opts ...option.RequestOption) (res *BookkeepingEntrySet, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("BookkeepingEntrySets.Get")}, opts...)
 	path := fmt.Sprintf("bookkeeping_entry_sets/%s", bookkeepingEntrySetID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *BookkeepingEntrySetService) Get(ctx context.Context, bookkeepingEntrySe
 func (r *BookkeepingEntrySetService) List(ctx context.Context, query BookkeepingEntrySetListParams, opts ...option.RequestOption) (res *shared.Page[BookkeepingEntrySet], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("BookkeepingEntrySets.List")}, opts...)
 	path := "bookkeeping_entry_sets"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -112,6 +114,14 @@ func (r *BookkeepingEntrySet) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BookkeepingEntrySet) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r bookkeepingEntrySetJSON) RawJSON() string {
+	return r.raw
+}
+
 type BookkeepingEntrySetEntry struct {
 	// The entry identifier.
 	ID string `json:"id,required"`
@@ -136,6 +146,14 @@ func (r *BookkeepingEntrySetEntry) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r BookkeepingEntrySetEntry) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r bookkeepingEntrySetEntryJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `bookkeeping_entry_set`.
 type BookkeepingEntrySetType string
@@ -144,6 +162,14 @@ const (
 	BookkeepingEntrySetTypeBookkeepingEntrySet BookkeepingEntrySetType = "bookkeeping_entry_set"
 )
 
+func (r BookkeepingEntrySetType) IsKnown() bool {
+	switch r {
+	case BookkeepingEntrySetTypeBookkeepingEntrySet:
+		return true
+	}
+	return false
+}
+
 type BookkeepingEntrySetNewParams struct {
 	// The bookkeeping entries.
 	Entries param.Field[[]BookkeepingEntrySetNewParamsEntry] `json:"entries,required"`
@@ -37,6 +37,7 @@ func NewCardService(opts ...option.RequestOption) (r *CardService) {
 // Create a Card
 func (r *CardService) New(ctx context.Context, body CardNewParams, opts ...option.RequestOption) (res *Card, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Cards.New")}, opts...)
 	path := "cards"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -45,6 +46,7 @@ func (r *CardService) New(ctx context.Context, body CardNewParams, opts ...optio
 // Retrieve a Card
 func (r *CardService) Get(ctx context.Context, cardID string, opts ...option.RequestOption) (res *Card, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Cards.Get")}, opts...)
 	path := fmt.Sprintf("cards/%s", cardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -53,6 +55,7 @@ func (r *CardService) Get(ctx context.Context, cardID string, opts ...option.Req
 // Update a Card
 func (r *CardService) Update(ctx context.Context, cardID string, body CardUpdateParams, opts ...option.RequestOption) (res *Card, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Cards.Update")}, opts...)
 	path := fmt.Sprintf("cards/%s", cardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -62,7 +65,7 @@ func (r *CardService) Update(ctx context.Context, cardID string, body CardUpdate
 func (r *CardService) List(ctx context.Context, query CardListParams, opts ...option.RequestOption) (res *shared.Page[Card], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Cards.List")}, opts...)
 	path := "cards"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -84,6 +87,7 @@ func (r *CardService) ListAutoPaging(ctx context.Context, query CardListParams,
 // Retrieve sensitive details for a Card
 func (r *CardService) GetSensitiveDetails(ctx context.Context, cardID string, opts ...option.RequestOption) (res *CardDetails, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Cards.GetSensitiveDetails")}, opts...)
 	path := fmt.Sprintf("cards/%s/details", cardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -147,6 +151,14 @@ func (r *Card) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Card) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardJSON) RawJSON() string {
+	return r.raw
+}
+
 // The Card's billing address.
 type CardBillingAddress struct {
 	// The city of the billing address.
@@ -178,6 +190,14 @@ func (r *CardBillingAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardBillingAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardBillingAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The contact information used in the two-factor steps for digital wallet card
 // creation. At least one field must be present to complete the digital wallet
 // steps.
@@ -208,6 +228,14 @@ func (r *CardDigitalWallet) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardDigitalWallet) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardDigitalWalletJSON) RawJSON() string {
+	return r.raw
+}
+
 // This indicates if payments can be made with the card.
 type CardStatus string
 
@@ -220,6 +248,14 @@ const (
 	CardStatusCanceled CardStatus = "canceled"
 )
 
+func (r CardStatus) IsKnown() bool {
+	switch r {
+	case CardStatusActive, CardStatusDisabled, CardStatusCanceled:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card`.
 type CardType string
@@ -228,6 +264,14 @@ const (
 	CardTypeCard CardType = "card"
 )
 
+func (r CardType) IsKnown() bool {
+	switch r {
+	case CardTypeCard:
+		return true
+	}
+	return false
+}
+
 // An object containing the sensitive details (card number, cvc, etc) for a Card.
 type CardDetails struct {
 	// The identifier for the Card for which sensitive details have been returned.
@@ -264,6 +308,14 @@ func (r *CardDetails) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_details`.
 type CardDetailsType string
@@ -272,6 +324,14 @@ const (
 	CardDetailsTypeCardDetails CardDetailsType = "card_details"
 )
 
+func (r CardDetailsType) IsKnown() bool {
+	switch r {
+	case CardDetailsTypeCardDetails:
+		return true
+	}
+	return false
+}
+
 type CardNewParams struct {
 	// The Account the card should belong to.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -402,6 +462,14 @@ const (
 	CardUpdateParamsStatusCanceled CardUpdateParamsStatus = "canceled"
 )
 
+func (r CardUpdateParamsStatus) IsKnown() bool {
+	switch r {
+	case CardUpdateParamsStatusActive, CardUpdateParamsStatusDisabled, CardUpdateParamsStatusCanceled:
+		return true
+	}
+	return false
+}
+
 type CardListParams struct {
 	// Filter Cards to ones belonging to the specified Account.
 	AccountID param.Field[string]                  `query:"account_id"`
@@ -38,6 +38,7 @@ func NewCardDisputeService(opts ...option.RequestOption) (r *CardDisputeService)
 // Create a Card Dispute
 func (r *CardDisputeService) New(ctx context.Context, body CardDisputeNewParams, opts ...option.RequestOption) (res *CardDispute, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardDisputes.New")}, opts...)
 	path := "card_disputes"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *CardDisputeService) New(ctx context.Context, body CardDisputeNewParams,
 // Retrieve a Card Dispute
 func (r *CardDisputeService) Get(ctx context.Context, cardDisputeID string, opts ...option.RequestOption) (res *CardDispute, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardDisputes.Get")}, opts...)
 	path := fmt.Sprintf("card_disputes/%s", cardDisputeID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *CardDisputeService) Get(ctx context.Context, cardDisputeID string, opts
 func (r *CardDisputeService) List(ctx context.Context, query CardDisputeListParams, opts ...option.RequestOption) (res *shared.Page[CardDispute], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CardDisputes.List")}, opts...)
 	path := "card_disputes"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -118,6 +120,14 @@ func (r *CardDispute) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardDispute) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardDisputeJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the Card Dispute's status is `accepted`, this will contain details of the
 // successful dispute.
 type CardDisputeAcceptance struct {
@@ -146,6 +156,14 @@ func (r *CardDisputeAcceptance) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardDisputeAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardDisputeAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the Card Dispute's status is `rejected`, this will contain details of the
 // unsuccessful dispute.
 type CardDisputeRejection struct {
@@ -173,6 +191,14 @@ func (r *CardDisputeRejection) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardDisputeRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardDisputeRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The results of the Dispute investigation.
 type CardDisputeStatus string
 
@@ -185,6 +211,14 @@ const (
 	CardDisputeStatusRejected CardDisputeStatus = "rejected"
 )
 
+func (r CardDisputeStatus) IsKnown() bool {
+	switch r {
+	case CardDisputeStatusPendingReviewing, CardDisputeStatusAccepted, CardDisputeStatusRejected:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_dispute`.
 type CardDisputeType string
@@ -193,6 +227,14 @@ const (
 	CardDisputeTypeCardDispute CardDisputeType = "card_dispute"
 )
 
+func (r CardDisputeType) IsKnown() bool {
+	switch r {
+	case CardDisputeTypeCardDispute:
+		return true
+	}
+	return false
+}
+
 type CardDisputeNewParams struct {
 	// The Transaction you wish to dispute. This Transaction must have a `source_type`
 	// of `card_settlement`.
@@ -273,3 +315,11 @@ const (
 	// The Card Dispute has been rejected.
 	CardDisputeListParamsStatusInRejected CardDisputeListParamsStatusIn = "rejected"
 )
+
+func (r CardDisputeListParamsStatusIn) IsKnown() bool {
+	switch r {
+	case CardDisputeListParamsStatusInPendingReviewing, CardDisputeListParamsStatusInAccepted, CardDisputeListParamsStatusInRejected:
+		return true
+	}
+	return false
+}
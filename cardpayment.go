@@ -38,6 +38,7 @@ func NewCardPaymentService(opts ...option.RequestOption) (r *CardPaymentService)
 // Retrieve a Card Payment
 func (r *CardPaymentService) Get(ctx context.Context, cardPaymentID string, opts ...option.RequestOption) (res *CardPayment, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardPayments.Get")}, opts...)
 	path := fmt.Sprintf("card_payments/%s", cardPaymentID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *CardPaymentService) Get(ctx context.Context, cardPaymentID string, opts
 func (r *CardPaymentService) List(ctx context.Context, query CardPaymentListParams, opts ...option.RequestOption) (res *shared.Page[CardPayment], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CardPayments.List")}, opts...)
 	path := "card_payments"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -105,6 +106,14 @@ func (r *CardPayment) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 type CardPaymentElement struct {
 	// A Card Authorization object. This field will be present in the JSON response if
 	// and only if `category` is equal to `card_authorization`.
@@ -164,6 +173,14 @@ func (r *CardPaymentElement) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Authorization object. This field will be present in the JSON response if
 // and only if `category` is equal to `card_authorization`.
 type CardPaymentElementsCardAuthorization struct {
@@ -252,6 +269,14 @@ func (r *CardPaymentElementsCardAuthorization) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorization) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CardPaymentElementsCardAuthorizationCurrency string
@@ -271,6 +296,14 @@ const (
 	CardPaymentElementsCardAuthorizationCurrencyUsd CardPaymentElementsCardAuthorizationCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardAuthorizationCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationCurrencyCad, CardPaymentElementsCardAuthorizationCurrencyChf, CardPaymentElementsCardAuthorizationCurrencyEur, CardPaymentElementsCardAuthorizationCurrencyGbp, CardPaymentElementsCardAuthorizationCurrencyJpy, CardPaymentElementsCardAuthorizationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The direction descibes the direction the funds will move, either from the
 // cardholder to the merchant or from the merchant to the cardholder.
 type CardPaymentElementsCardAuthorizationDirection string
@@ -283,6 +316,14 @@ const (
 	CardPaymentElementsCardAuthorizationDirectionRefund CardPaymentElementsCardAuthorizationDirection = "refund"
 )
 
+func (r CardPaymentElementsCardAuthorizationDirection) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationDirectionSettlement, CardPaymentElementsCardAuthorizationDirectionRefund:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type CardPaymentElementsCardAuthorizationNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -305,6 +346,14 @@ func (r *CardPaymentElementsCardAuthorizationNetworkDetails) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type CardPaymentElementsCardAuthorizationNetworkDetailsCategory string
 
@@ -313,6 +362,14 @@ const (
 	CardPaymentElementsCardAuthorizationNetworkDetailsCategoryVisa CardPaymentElementsCardAuthorizationNetworkDetailsCategory = "visa"
 )
 
+func (r CardPaymentElementsCardAuthorizationNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type CardPaymentElementsCardAuthorizationNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -338,6 +395,14 @@ func (r *CardPaymentElementsCardAuthorizationNetworkDetailsVisa) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -380,6 +445,14 @@ const (
 	CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorRecurring, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorInstallment, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, CardPaymentElementsCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode string
@@ -407,6 +480,14 @@ const (
 	CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeUnknown, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeManual, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactless, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, CardPaymentElementsCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardAuthorizationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -436,6 +517,14 @@ func (r *CardPaymentElementsCardAuthorizationNetworkIdentifiers) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type CardPaymentElementsCardAuthorizationProcessingCategory string
@@ -460,6 +549,14 @@ const (
 	CardPaymentElementsCardAuthorizationProcessingCategoryRefund CardPaymentElementsCardAuthorizationProcessingCategory = "refund"
 )
 
+func (r CardPaymentElementsCardAuthorizationProcessingCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationProcessingCategoryAccountFunding, CardPaymentElementsCardAuthorizationProcessingCategoryAutomaticFuelDispenser, CardPaymentElementsCardAuthorizationProcessingCategoryBillPayment, CardPaymentElementsCardAuthorizationProcessingCategoryPurchase, CardPaymentElementsCardAuthorizationProcessingCategoryQuasiCash, CardPaymentElementsCardAuthorizationProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_authorization`.
 type CardPaymentElementsCardAuthorizationType string
@@ -468,6 +565,14 @@ const (
 	CardPaymentElementsCardAuthorizationTypeCardAuthorization CardPaymentElementsCardAuthorizationType = "card_authorization"
 )
 
+func (r CardPaymentElementsCardAuthorizationType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationTypeCardAuthorization:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type CardPaymentElementsCardAuthorizationVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -492,6 +597,14 @@ func (r *CardPaymentElementsCardAuthorizationVerification) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type CardPaymentElementsCardAuthorizationVerificationCardVerificationCode struct {
@@ -513,6 +626,14 @@ func (r *CardPaymentElementsCardAuthorizationVerificationCardVerificationCode) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResult string
 
@@ -525,6 +646,14 @@ const (
 	CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResultNoMatch CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResultNotChecked, CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResultMatch, CardPaymentElementsCardAuthorizationVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type CardPaymentElementsCardAuthorizationVerificationCardholderAddress struct {
@@ -559,6 +688,14 @@ func (r *CardPaymentElementsCardAuthorizationVerificationCardholderAddress) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type CardPaymentElementsCardAuthorizationVerificationCardholderAddressResult string
 
@@ -577,6 +714,14 @@ const (
 	CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultNoMatch CardPaymentElementsCardAuthorizationVerificationCardholderAddressResult = "no_match"
 )
 
+func (r CardPaymentElementsCardAuthorizationVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultNotChecked, CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultMatch, CardPaymentElementsCardAuthorizationVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // A Card Authorization Expiration object. This field will be present in the JSON
 // response if and only if `category` is equal to `card_authorization_expiration`.
 type CardPaymentElementsCardAuthorizationExpiration struct {
@@ -615,6 +760,14 @@ func (r *CardPaymentElementsCardAuthorizationExpiration) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardAuthorizationExpiration) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardAuthorizationExpirationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the reversal's
 // currency.
 type CardPaymentElementsCardAuthorizationExpirationCurrency string
@@ -634,6 +787,14 @@ const (
 	CardPaymentElementsCardAuthorizationExpirationCurrencyUsd CardPaymentElementsCardAuthorizationExpirationCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardAuthorizationExpirationCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationExpirationCurrencyCad, CardPaymentElementsCardAuthorizationExpirationCurrencyChf, CardPaymentElementsCardAuthorizationExpirationCurrencyEur, CardPaymentElementsCardAuthorizationExpirationCurrencyGbp, CardPaymentElementsCardAuthorizationExpirationCurrencyJpy, CardPaymentElementsCardAuthorizationExpirationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The card network used to process this card authorization.
 type CardPaymentElementsCardAuthorizationExpirationNetwork string
 
@@ -642,6 +803,14 @@ const (
 	CardPaymentElementsCardAuthorizationExpirationNetworkVisa CardPaymentElementsCardAuthorizationExpirationNetwork = "visa"
 )
 
+func (r CardPaymentElementsCardAuthorizationExpirationNetwork) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationExpirationNetworkVisa:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_authorization_expiration`.
 type CardPaymentElementsCardAuthorizationExpirationType string
@@ -650,6 +819,14 @@ const (
 	CardPaymentElementsCardAuthorizationExpirationTypeCardAuthorizationExpiration CardPaymentElementsCardAuthorizationExpirationType = "card_authorization_expiration"
 )
 
+func (r CardPaymentElementsCardAuthorizationExpirationType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardAuthorizationExpirationTypeCardAuthorizationExpiration:
+		return true
+	}
+	return false
+}
+
 // A Card Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_decline`.
 type CardPaymentElementsCardDecline struct {
@@ -729,6 +906,14 @@ func (r *CardPaymentElementsCardDecline) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type CardPaymentElementsCardDeclineCurrency string
@@ -748,6 +933,14 @@ const (
 	CardPaymentElementsCardDeclineCurrencyUsd CardPaymentElementsCardDeclineCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardDeclineCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineCurrencyCad, CardPaymentElementsCardDeclineCurrencyChf, CardPaymentElementsCardDeclineCurrencyEur, CardPaymentElementsCardDeclineCurrencyGbp, CardPaymentElementsCardDeclineCurrencyJpy, CardPaymentElementsCardDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type CardPaymentElementsCardDeclineNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -770,6 +963,14 @@ func (r *CardPaymentElementsCardDeclineNetworkDetails) UnmarshalJSON(data []byte
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type CardPaymentElementsCardDeclineNetworkDetailsCategory string
 
@@ -778,6 +979,14 @@ const (
 	CardPaymentElementsCardDeclineNetworkDetailsCategoryVisa CardPaymentElementsCardDeclineNetworkDetailsCategory = "visa"
 )
 
+func (r CardPaymentElementsCardDeclineNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type CardPaymentElementsCardDeclineNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -803,6 +1012,14 @@ func (r *CardPaymentElementsCardDeclineNetworkDetailsVisa) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -845,6 +1062,14 @@ const (
 	CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorRecurring, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorInstallment, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, CardPaymentElementsCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryMode string
@@ -872,6 +1097,14 @@ const (
 	CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeUnknown, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeManual, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactless, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, CardPaymentElementsCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardDeclineNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -901,6 +1134,14 @@ func (r *CardPaymentElementsCardDeclineNetworkIdentifiers) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type CardPaymentElementsCardDeclineProcessingCategory string
@@ -925,6 +1166,14 @@ const (
 	CardPaymentElementsCardDeclineProcessingCategoryRefund CardPaymentElementsCardDeclineProcessingCategory = "refund"
 )
 
+func (r CardPaymentElementsCardDeclineProcessingCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineProcessingCategoryAccountFunding, CardPaymentElementsCardDeclineProcessingCategoryAutomaticFuelDispenser, CardPaymentElementsCardDeclineProcessingCategoryBillPayment, CardPaymentElementsCardDeclineProcessingCategoryPurchase, CardPaymentElementsCardDeclineProcessingCategoryQuasiCash, CardPaymentElementsCardDeclineProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // Why the transaction was declined.
 type CardPaymentElementsCardDeclineReason string
 
@@ -961,6 +1210,14 @@ const (
 	CardPaymentElementsCardDeclineReasonSuspectedFraud CardPaymentElementsCardDeclineReason = "suspected_fraud"
 )
 
+func (r CardPaymentElementsCardDeclineReason) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineReasonCardNotActive, CardPaymentElementsCardDeclineReasonPhysicalCardNotActive, CardPaymentElementsCardDeclineReasonEntityNotActive, CardPaymentElementsCardDeclineReasonGroupLocked, CardPaymentElementsCardDeclineReasonInsufficientFunds, CardPaymentElementsCardDeclineReasonCvv2Mismatch, CardPaymentElementsCardDeclineReasonTransactionNotAllowed, CardPaymentElementsCardDeclineReasonBreachesLimit, CardPaymentElementsCardDeclineReasonWebhookDeclined, CardPaymentElementsCardDeclineReasonWebhookTimedOut, CardPaymentElementsCardDeclineReasonDeclinedByStandInProcessing, CardPaymentElementsCardDeclineReasonInvalidPhysicalCard, CardPaymentElementsCardDeclineReasonMissingOriginalAuthorization, CardPaymentElementsCardDeclineReasonSuspectedFraud:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type CardPaymentElementsCardDeclineVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -985,6 +1242,14 @@ func (r *CardPaymentElementsCardDeclineVerification) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type CardPaymentElementsCardDeclineVerificationCardVerificationCode struct {
@@ -1006,6 +1271,14 @@ func (r *CardPaymentElementsCardDeclineVerificationCardVerificationCode) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type CardPaymentElementsCardDeclineVerificationCardVerificationCodeResult string
 
@@ -1018,6 +1291,14 @@ const (
 	CardPaymentElementsCardDeclineVerificationCardVerificationCodeResultNoMatch CardPaymentElementsCardDeclineVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r CardPaymentElementsCardDeclineVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineVerificationCardVerificationCodeResultNotChecked, CardPaymentElementsCardDeclineVerificationCardVerificationCodeResultMatch, CardPaymentElementsCardDeclineVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type CardPaymentElementsCardDeclineVerificationCardholderAddress struct {
@@ -1052,6 +1333,14 @@ func (r *CardPaymentElementsCardDeclineVerificationCardholderAddress) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardDeclineVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardDeclineVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type CardPaymentElementsCardDeclineVerificationCardholderAddressResult string
 
@@ -1070,6 +1359,14 @@ const (
 	CardPaymentElementsCardDeclineVerificationCardholderAddressResultNoMatch CardPaymentElementsCardDeclineVerificationCardholderAddressResult = "no_match"
 )
 
+func (r CardPaymentElementsCardDeclineVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardDeclineVerificationCardholderAddressResultNotChecked, CardPaymentElementsCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, CardPaymentElementsCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, CardPaymentElementsCardDeclineVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, CardPaymentElementsCardDeclineVerificationCardholderAddressResultMatch, CardPaymentElementsCardDeclineVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // A Card Fuel Confirmation object. This field will be present in the JSON response
 // if and only if `category` is equal to `card_fuel_confirmation`.
 type CardPaymentElementsCardFuelConfirmation struct {
@@ -1115,6 +1412,14 @@ func (r *CardPaymentElementsCardFuelConfirmation) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardFuelConfirmation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardFuelConfirmationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the increment's
 // currency.
 type CardPaymentElementsCardFuelConfirmationCurrency string
@@ -1134,6 +1439,14 @@ const (
 	CardPaymentElementsCardFuelConfirmationCurrencyUsd CardPaymentElementsCardFuelConfirmationCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardFuelConfirmationCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardFuelConfirmationCurrencyCad, CardPaymentElementsCardFuelConfirmationCurrencyChf, CardPaymentElementsCardFuelConfirmationCurrencyEur, CardPaymentElementsCardFuelConfirmationCurrencyGbp, CardPaymentElementsCardFuelConfirmationCurrencyJpy, CardPaymentElementsCardFuelConfirmationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The card network used to process this card authorization.
 type CardPaymentElementsCardFuelConfirmationNetwork string
 
@@ -1142,6 +1455,14 @@ const (
 	CardPaymentElementsCardFuelConfirmationNetworkVisa CardPaymentElementsCardFuelConfirmationNetwork = "visa"
 )
 
+func (r CardPaymentElementsCardFuelConfirmationNetwork) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardFuelConfirmationNetworkVisa:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardFuelConfirmationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -1172,6 +1493,14 @@ func (r *CardPaymentElementsCardFuelConfirmationNetworkIdentifiers) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardFuelConfirmationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardFuelConfirmationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_fuel_confirmation`.
 type CardPaymentElementsCardFuelConfirmationType string
@@ -1180,6 +1509,14 @@ const (
 	CardPaymentElementsCardFuelConfirmationTypeCardFuelConfirmation CardPaymentElementsCardFuelConfirmationType = "card_fuel_confirmation"
 )
 
+func (r CardPaymentElementsCardFuelConfirmationType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardFuelConfirmationTypeCardFuelConfirmation:
+		return true
+	}
+	return false
+}
+
 // A Card Increment object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_increment`.
 type CardPaymentElementsCardIncrement struct {
@@ -1232,6 +1569,14 @@ func (r *CardPaymentElementsCardIncrement) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardIncrement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardIncrementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the increment's
 // currency.
 type CardPaymentElementsCardIncrementCurrency string
@@ -1251,6 +1596,14 @@ const (
 	CardPaymentElementsCardIncrementCurrencyUsd CardPaymentElementsCardIncrementCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardIncrementCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardIncrementCurrencyCad, CardPaymentElementsCardIncrementCurrencyChf, CardPaymentElementsCardIncrementCurrencyEur, CardPaymentElementsCardIncrementCurrencyGbp, CardPaymentElementsCardIncrementCurrencyJpy, CardPaymentElementsCardIncrementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The card network used to process this card authorization.
 type CardPaymentElementsCardIncrementNetwork string
 
@@ -1259,6 +1612,14 @@ const (
 	CardPaymentElementsCardIncrementNetworkVisa CardPaymentElementsCardIncrementNetwork = "visa"
 )
 
+func (r CardPaymentElementsCardIncrementNetwork) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardIncrementNetworkVisa:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardIncrementNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -1288,6 +1649,14 @@ func (r *CardPaymentElementsCardIncrementNetworkIdentifiers) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardIncrementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardIncrementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_increment`.
 type CardPaymentElementsCardIncrementType string
@@ -1296,6 +1665,14 @@ const (
 	CardPaymentElementsCardIncrementTypeCardIncrement CardPaymentElementsCardIncrementType = "card_increment"
 )
 
+func (r CardPaymentElementsCardIncrementType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardIncrementTypeCardIncrement:
+		return true
+	}
+	return false
+}
+
 // A Card Refund object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_refund`.
 type CardPaymentElementsCardRefund struct {
@@ -1360,6 +1737,14 @@ func (r *CardPaymentElementsCardRefund) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefund) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CardPaymentElementsCardRefundCurrency string
@@ -1379,6 +1764,14 @@ const (
 	CardPaymentElementsCardRefundCurrencyUsd CardPaymentElementsCardRefundCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardRefundCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundCurrencyCad, CardPaymentElementsCardRefundCurrencyChf, CardPaymentElementsCardRefundCurrencyEur, CardPaymentElementsCardRefundCurrencyGbp, CardPaymentElementsCardRefundCurrencyJpy, CardPaymentElementsCardRefundCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type CardPaymentElementsCardRefundNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -1406,6 +1799,14 @@ func (r *CardPaymentElementsCardRefundNetworkIdentifiers) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type CardPaymentElementsCardRefundPurchaseDetails struct {
@@ -1455,6 +1856,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetails) UnmarshalJSON(data []byte
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type CardPaymentElementsCardRefundPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -1527,6 +1936,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsCarRental) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraCharges string
 
@@ -1545,6 +1962,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesNoExtraCharge, CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesGas, CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesExtraMileage, CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesLateReturn, CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, CardPaymentElementsCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicator string
@@ -1556,6 +1981,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, CardPaymentElementsCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type CardPaymentElementsCardRefundPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -1627,6 +2060,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsLodging) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraCharges string
 
@@ -1647,6 +2088,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesLaundry CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesNoExtraCharge, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesRestaurant, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesGiftShop, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesMiniBar, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesTelephone, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesOther, CardPaymentElementsCardRefundPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicator string
@@ -1658,6 +2107,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicatorNotApplicable, CardPaymentElementsCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1674,6 +2131,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatFreeText, CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatOrderNumber, CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, CardPaymentElementsCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type CardPaymentElementsCardRefundPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1726,6 +2191,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsTravel) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1760,6 +2233,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsTravelAncillary) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1774,6 +2255,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1796,6 +2285,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryService) Unm
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1850,6 +2347,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryNone, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBundledService, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCargo, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryLounge, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMedical, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryOther, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPets, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategorySeatFees, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStandby, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStore, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryTravelService, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, CardPaymentElementsCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1868,6 +2373,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorNoCredit, CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorOther, CardPaymentElementsCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1878,6 +2391,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, CardPaymentElementsCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1890,6 +2411,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicatorNone, CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, CardPaymentElementsCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type CardPaymentElementsCardRefundPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -1924,6 +2453,14 @@ func (r *CardPaymentElementsCardRefundPurchaseDetailsTravelTripLeg) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardRefundPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -1936,6 +2473,14 @@ const (
 	CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCodeNone, CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, CardPaymentElementsCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_refund`.
 type CardPaymentElementsCardRefundType string
@@ -1944,6 +2489,14 @@ const (
 	CardPaymentElementsCardRefundTypeCardRefund CardPaymentElementsCardRefundType = "card_refund"
 )
 
+func (r CardPaymentElementsCardRefundType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardRefundTypeCardRefund:
+		return true
+	}
+	return false
+}
+
 // A Card Reversal object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_reversal`.
 type CardPaymentElementsCardReversal struct {
@@ -1992,6 +2545,14 @@ func (r *CardPaymentElementsCardReversal) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the reversal's
 // currency.
 type CardPaymentElementsCardReversalCurrency string
@@ -2011,6 +2572,14 @@ const (
 	CardPaymentElementsCardReversalCurrencyUsd CardPaymentElementsCardReversalCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardReversalCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardReversalCurrencyCad, CardPaymentElementsCardReversalCurrencyChf, CardPaymentElementsCardReversalCurrencyEur, CardPaymentElementsCardReversalCurrencyGbp, CardPaymentElementsCardReversalCurrencyJpy, CardPaymentElementsCardReversalCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The card network used to process this card authorization.
 type CardPaymentElementsCardReversalNetwork string
 
@@ -2019,6 +2588,14 @@ const (
 	CardPaymentElementsCardReversalNetworkVisa CardPaymentElementsCardReversalNetwork = "visa"
 )
 
+func (r CardPaymentElementsCardReversalNetwork) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardReversalNetworkVisa:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardReversalNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -2048,6 +2625,14 @@ func (r *CardPaymentElementsCardReversalNetworkIdentifiers) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardReversalNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardReversalNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_reversal`.
 type CardPaymentElementsCardReversalType string
@@ -2056,6 +2641,14 @@ const (
 	CardPaymentElementsCardReversalTypeCardReversal CardPaymentElementsCardReversalType = "card_reversal"
 )
 
+func (r CardPaymentElementsCardReversalType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardReversalTypeCardReversal:
+		return true
+	}
+	return false
+}
+
 // A Card Settlement object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_settlement`.
 type CardPaymentElementsCardSettlement struct {
@@ -2134,6 +2727,14 @@ func (r *CardPaymentElementsCardSettlement) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's settlement currency.
 type CardPaymentElementsCardSettlementCurrency string
@@ -2153,6 +2754,14 @@ const (
 	CardPaymentElementsCardSettlementCurrencyUsd CardPaymentElementsCardSettlementCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardSettlementCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementCurrencyCad, CardPaymentElementsCardSettlementCurrencyChf, CardPaymentElementsCardSettlementCurrencyEur, CardPaymentElementsCardSettlementCurrencyGbp, CardPaymentElementsCardSettlementCurrencyJpy, CardPaymentElementsCardSettlementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type CardPaymentElementsCardSettlementNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -2180,6 +2789,14 @@ func (r *CardPaymentElementsCardSettlementNetworkIdentifiers) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type CardPaymentElementsCardSettlementPurchaseDetails struct {
@@ -2229,6 +2846,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetails) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type CardPaymentElementsCardSettlementPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -2302,6 +2927,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsCarRental) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraCharges string
 
@@ -2320,6 +2953,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesNoExtraCharge, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesGas, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesExtraMileage, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesLateReturn, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicator string
@@ -2331,6 +2972,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, CardPaymentElementsCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type CardPaymentElementsCardSettlementPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -2403,6 +3052,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsLodging) UnmarshalJSON(
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraCharges string
 
@@ -2423,6 +3080,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesLaundry CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesNoExtraCharge, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesRestaurant, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesGiftShop, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesMiniBar, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesTelephone, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesOther, CardPaymentElementsCardSettlementPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicator string
@@ -2434,6 +3099,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicatorNotApplicable, CardPaymentElementsCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -2450,6 +3123,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatFreeText, CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatOrderNumber, CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, CardPaymentElementsCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -2502,6 +3183,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsTravel) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -2536,6 +3225,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillary) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -2550,6 +3247,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -2572,6 +3277,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryService)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -2626,6 +3339,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryNone, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBundledService, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCargo, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryLounge, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMedical, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryOther, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPets, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategorySeatFees, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStandby, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStore, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryTravelService, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, CardPaymentElementsCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -2644,6 +3365,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorNoCredit, CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorOther, CardPaymentElementsCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -2654,6 +3383,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, CardPaymentElementsCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -2666,6 +3403,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNone, CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, CardPaymentElementsCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -2700,6 +3445,14 @@ func (r *CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLeg) Unmarsha
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -2712,6 +3465,14 @@ const (
 	CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeNone, CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, CardPaymentElementsCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_settlement`.
 type CardPaymentElementsCardSettlementType string
@@ -2720,6 +3481,14 @@ const (
 	CardPaymentElementsCardSettlementTypeCardSettlement CardPaymentElementsCardSettlementType = "card_settlement"
 )
 
+func (r CardPaymentElementsCardSettlementType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardSettlementTypeCardSettlement:
+		return true
+	}
+	return false
+}
+
 // A Card Validation object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_validation`.
 type CardPaymentElementsCardValidation struct {
@@ -2789,6 +3558,14 @@ func (r *CardPaymentElementsCardValidation) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CardPaymentElementsCardValidationCurrency string
@@ -2808,6 +3585,14 @@ const (
 	CardPaymentElementsCardValidationCurrencyUsd CardPaymentElementsCardValidationCurrency = "USD"
 )
 
+func (r CardPaymentElementsCardValidationCurrency) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationCurrencyCad, CardPaymentElementsCardValidationCurrencyChf, CardPaymentElementsCardValidationCurrencyEur, CardPaymentElementsCardValidationCurrencyGbp, CardPaymentElementsCardValidationCurrencyJpy, CardPaymentElementsCardValidationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type CardPaymentElementsCardValidationNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -2830,6 +3615,14 @@ func (r *CardPaymentElementsCardValidationNetworkDetails) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type CardPaymentElementsCardValidationNetworkDetailsCategory string
 
@@ -2838,6 +3631,14 @@ const (
 	CardPaymentElementsCardValidationNetworkDetailsCategoryVisa CardPaymentElementsCardValidationNetworkDetailsCategory = "visa"
 )
 
+func (r CardPaymentElementsCardValidationNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type CardPaymentElementsCardValidationNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -2863,6 +3664,14 @@ func (r *CardPaymentElementsCardValidationNetworkDetailsVisa) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -2905,6 +3714,14 @@ const (
 	CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorRecurring, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorInstallment, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, CardPaymentElementsCardValidationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryMode string
@@ -2932,6 +3749,14 @@ const (
 	CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeUnknown, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeManual, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeContactless, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, CardPaymentElementsCardValidationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardPaymentElementsCardValidationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -2961,6 +3786,14 @@ func (r *CardPaymentElementsCardValidationNetworkIdentifiers) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_validation`.
 type CardPaymentElementsCardValidationType string
@@ -2969,6 +3802,14 @@ const (
 	CardPaymentElementsCardValidationTypeCardValidation CardPaymentElementsCardValidationType = "card_validation"
 )
 
+func (r CardPaymentElementsCardValidationType) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationTypeCardValidation:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type CardPaymentElementsCardValidationVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -2993,6 +3834,14 @@ func (r *CardPaymentElementsCardValidationVerification) UnmarshalJSON(data []byt
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type CardPaymentElementsCardValidationVerificationCardVerificationCode struct {
@@ -3014,6 +3863,14 @@ func (r *CardPaymentElementsCardValidationVerificationCardVerificationCode) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type CardPaymentElementsCardValidationVerificationCardVerificationCodeResult string
 
@@ -3026,6 +3883,14 @@ const (
 	CardPaymentElementsCardValidationVerificationCardVerificationCodeResultNoMatch CardPaymentElementsCardValidationVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r CardPaymentElementsCardValidationVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationVerificationCardVerificationCodeResultNotChecked, CardPaymentElementsCardValidationVerificationCardVerificationCodeResultMatch, CardPaymentElementsCardValidationVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type CardPaymentElementsCardValidationVerificationCardholderAddress struct {
@@ -3060,6 +3925,14 @@ func (r *CardPaymentElementsCardValidationVerificationCardholderAddress) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentElementsCardValidationVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentElementsCardValidationVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type CardPaymentElementsCardValidationVerificationCardholderAddressResult string
 
@@ -3078,6 +3951,14 @@ const (
 	CardPaymentElementsCardValidationVerificationCardholderAddressResultNoMatch CardPaymentElementsCardValidationVerificationCardholderAddressResult = "no_match"
 )
 
+func (r CardPaymentElementsCardValidationVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCardValidationVerificationCardholderAddressResultNotChecked, CardPaymentElementsCardValidationVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, CardPaymentElementsCardValidationVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, CardPaymentElementsCardValidationVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, CardPaymentElementsCardValidationVerificationCardholderAddressResultMatch, CardPaymentElementsCardValidationVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type CardPaymentElementsCategory string
@@ -3107,6 +3988,14 @@ const (
 	CardPaymentElementsCategoryOther CardPaymentElementsCategory = "other"
 )
 
+func (r CardPaymentElementsCategory) IsKnown() bool {
+	switch r {
+	case CardPaymentElementsCategoryCardAuthorization, CardPaymentElementsCategoryCardValidation, CardPaymentElementsCategoryCardDecline, CardPaymentElementsCategoryCardReversal, CardPaymentElementsCategoryCardAuthorizationExpiration, CardPaymentElementsCategoryCardIncrement, CardPaymentElementsCategoryCardSettlement, CardPaymentElementsCategoryCardRefund, CardPaymentElementsCategoryCardFuelConfirmation, CardPaymentElementsCategoryOther:
+		return true
+	}
+	return false
+}
+
 // The summarized state of this card payment.
 type CardPaymentState struct {
 	// The total authorized amount in the minor unit of the transaction's currency. For
@@ -3143,6 +4032,14 @@ func (r *CardPaymentState) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPaymentState) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPaymentStateJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_payment`.
 type CardPaymentType string
@@ -3151,6 +4048,14 @@ const (
 	CardPaymentTypeCardPayment CardPaymentType = "card_payment"
 )
 
+func (r CardPaymentType) IsKnown() bool {
+	switch r {
+	case CardPaymentTypeCardPayment:
+		return true
+	}
+	return false
+}
+
 type CardPaymentListParams struct {
 	// Filter Card Payments to ones belonging to the specified Account.
 	AccountID param.Field[string] `query:"account_id"`
@@ -38,6 +38,7 @@ func NewCardProfileService(opts ...option.RequestOption) (r *CardProfileService)
 // Create a Card Profile
 func (r *CardProfileService) New(ctx context.Context, body CardProfileNewParams, opts ...option.RequestOption) (res *CardProfile, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardProfiles.New")}, opts...)
 	path := "card_profiles"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *CardProfileService) New(ctx context.Context, body CardProfileNewParams,
 // Retrieve a Card Profile
 func (r *CardProfileService) Get(ctx context.Context, cardProfileID string, opts ...option.RequestOption) (res *CardProfile, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardProfiles.Get")}, opts...)
 	path := fmt.Sprintf("card_profiles/%s", cardProfileID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *CardProfileService) Get(ctx context.Context, cardProfileID string, opts
 func (r *CardProfileService) List(ctx context.Context, query CardProfileListParams, opts ...option.RequestOption) (res *shared.Page[CardProfile], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CardProfiles.List")}, opts...)
 	path := "card_profiles"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +79,7 @@ func (r *CardProfileService) ListAutoPaging(ctx context.Context, query CardProfi
 // Archive an Card Profile
 func (r *CardProfileService) Archive(ctx context.Context, cardProfileID string, opts ...option.RequestOption) (res *CardProfile, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardProfiles.Archive")}, opts...)
 	path := fmt.Sprintf("card_profiles/%s/archive", cardProfileID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -126,6 +129,14 @@ func (r *CardProfile) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardProfile) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardProfileJSON) RawJSON() string {
+	return r.raw
+}
+
 // How Cards should appear in digital wallets such as Apple Pay. Different wallets
 // will use these values to render card artwork appropriately for their app.
 type CardProfileDigitalWallets struct {
@@ -167,6 +178,14 @@ func (r *CardProfileDigitalWallets) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardProfileDigitalWallets) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardProfileDigitalWalletsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The Card's text color, specified as an RGB triple.
 type CardProfileDigitalWalletsTextColor struct {
 	// The value of the blue channel in the RGB color.
@@ -192,6 +211,14 @@ func (r *CardProfileDigitalWalletsTextColor) UnmarshalJSON(data []byte) (err err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardProfileDigitalWalletsTextColor) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardProfileDigitalWalletsTextColorJSON) RawJSON() string {
+	return r.raw
+}
+
 // How physical cards should be designed and shipped.
 type CardProfilePhysicalCards struct {
 	// The identifier of the File containing the physical card's back image.
@@ -223,6 +250,14 @@ func (r *CardProfilePhysicalCards) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardProfilePhysicalCards) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardProfilePhysicalCardsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The status of the Physical Card Profile.
 type CardProfilePhysicalCardsStatus string
 
@@ -242,6 +277,14 @@ const (
 	CardProfilePhysicalCardsStatusActive CardProfilePhysicalCardsStatus = "active"
 )
 
+func (r CardProfilePhysicalCardsStatus) IsKnown() bool {
+	switch r {
+	case CardProfilePhysicalCardsStatusNotEligible, CardProfilePhysicalCardsStatusRejected, CardProfilePhysicalCardsStatusPendingCreating, CardProfilePhysicalCardsStatusPendingReviewing, CardProfilePhysicalCardsStatusPendingSubmitting, CardProfilePhysicalCardsStatusActive:
+		return true
+	}
+	return false
+}
+
 // The status of the Card Profile.
 type CardProfileStatus string
 
@@ -257,6 +300,14 @@ const (
 	CardProfileStatusArchived CardProfileStatus = "archived"
 )
 
+func (r CardProfileStatus) IsKnown() bool {
+	switch r {
+	case CardProfileStatusPending, CardProfileStatusRejected, CardProfileStatusActive, CardProfileStatusArchived:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_profile`.
 type CardProfileType string
@@ -265,6 +316,14 @@ const (
 	CardProfileTypeCardProfile CardProfileType = "card_profile"
 )
 
+func (r CardProfileType) IsKnown() bool {
+	switch r {
+	case CardProfileTypeCardProfile:
+		return true
+	}
+	return false
+}
+
 type CardProfileNewParams struct {
 	// A description you can use to identify the Card Profile.
 	Description param.Field[string] `json:"description,required"`
@@ -384,6 +443,14 @@ const (
 	CardProfileListParamsPhysicalCardsStatusInActive CardProfileListParamsPhysicalCardsStatusIn = "active"
 )
 
+func (r CardProfileListParamsPhysicalCardsStatusIn) IsKnown() bool {
+	switch r {
+	case CardProfileListParamsPhysicalCardsStatusInNotEligible, CardProfileListParamsPhysicalCardsStatusInRejected, CardProfileListParamsPhysicalCardsStatusInPendingCreating, CardProfileListParamsPhysicalCardsStatusInPendingReviewing, CardProfileListParamsPhysicalCardsStatusInPendingSubmitting, CardProfileListParamsPhysicalCardsStatusInActive:
+		return true
+	}
+	return false
+}
+
 type CardProfileListParamsStatus struct {
 	// Filter Card Profiles for those with the specified digital wallet status or
 	// statuses. For GET requests, this should be encoded as a comma-delimited string,
@@ -413,3 +480,11 @@ const (
 	// The Card Profile is no longer in use.
 	CardProfileListParamsStatusInArchived CardProfileListParamsStatusIn = "archived"
 )
+
+func (r CardProfileListParamsStatusIn) IsKnown() bool {
+	switch r {
+	case CardProfileListParamsStatusInPending, CardProfileListParamsStatusInRejected, CardProfileListParamsStatusInActive, CardProfileListParamsStatusInArchived:
+		return true
+	}
+	return false
+}
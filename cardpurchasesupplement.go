@@ -38,6 +38,7 @@ func NewCardPurchaseSupplementService(opts ...option.RequestOption) (r *CardPurc
 // Retrieve a Card Purchase Supplement
 func (r *CardPurchaseSupplementService) Get(ctx context.Context, cardPurchaseSupplementID string, opts ...option.RequestOption) (res *CardPurchaseSupplement, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CardPurchaseSupplements.Get")}, opts...)
 	path := fmt.Sprintf("card_purchase_supplements/%s", cardPurchaseSupplementID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *CardPurchaseSupplementService) Get(ctx context.Context, cardPurchaseSup
 func (r *CardPurchaseSupplementService) List(ctx context.Context, query CardPurchaseSupplementListParams, opts ...option.RequestOption) (res *shared.Page[CardPurchaseSupplement], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CardPurchaseSupplements.List")}, opts...)
 	path := "card_purchase_supplements"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -102,6 +103,14 @@ func (r *CardPurchaseSupplement) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPurchaseSupplement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPurchaseSupplementJSON) RawJSON() string {
+	return r.raw
+}
+
 // Invoice-level information about the payment.
 type CardPurchaseSupplementInvoice struct {
 	// Discount given to cardholder.
@@ -168,6 +177,14 @@ func (r *CardPurchaseSupplementInvoice) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPurchaseSupplementInvoice) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPurchaseSupplementInvoiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates how the merchant applied the discount.
 type CardPurchaseSupplementInvoiceDiscountTreatmentCode string
 
@@ -180,6 +197,14 @@ const (
 	CardPurchaseSupplementInvoiceDiscountTreatmentCodeTaxCalculatedOnPreDiscountInvoiceTotal CardPurchaseSupplementInvoiceDiscountTreatmentCode = "tax_calculated_on_pre_discount_invoice_total"
 )
 
+func (r CardPurchaseSupplementInvoiceDiscountTreatmentCode) IsKnown() bool {
+	switch r {
+	case CardPurchaseSupplementInvoiceDiscountTreatmentCodeNoInvoiceLevelDiscountProvided, CardPurchaseSupplementInvoiceDiscountTreatmentCodeTaxCalculatedOnPostDiscountInvoiceTotal, CardPurchaseSupplementInvoiceDiscountTreatmentCodeTaxCalculatedOnPreDiscountInvoiceTotal:
+		return true
+	}
+	return false
+}
+
 // Indicates how the merchant applied taxes.
 type CardPurchaseSupplementInvoiceTaxTreatments string
 
@@ -196,6 +221,14 @@ const (
 	CardPurchaseSupplementInvoiceTaxTreatmentsGrossPriceInvoiceLevel CardPurchaseSupplementInvoiceTaxTreatments = "gross_price_invoice_level"
 )
 
+func (r CardPurchaseSupplementInvoiceTaxTreatments) IsKnown() bool {
+	switch r {
+	case CardPurchaseSupplementInvoiceTaxTreatmentsNoTaxApplies, CardPurchaseSupplementInvoiceTaxTreatmentsNetPriceLineItemLevel, CardPurchaseSupplementInvoiceTaxTreatmentsNetPriceInvoiceLevel, CardPurchaseSupplementInvoiceTaxTreatmentsGrossPriceLineItemLevel, CardPurchaseSupplementInvoiceTaxTreatmentsGrossPriceInvoiceLevel:
+		return true
+	}
+	return false
+}
+
 type CardPurchaseSupplementLineItem struct {
 	// Indicates the type of line item.
 	DetailIndicator CardPurchaseSupplementLineItemsDetailIndicator `json:"detail_indicator,required,nullable"`
@@ -261,6 +294,14 @@ func (r *CardPurchaseSupplementLineItem) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardPurchaseSupplementLineItem) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardPurchaseSupplementLineItemJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the type of line item.
 type CardPurchaseSupplementLineItemsDetailIndicator string
 
@@ -273,6 +314,14 @@ const (
 	CardPurchaseSupplementLineItemsDetailIndicatorPayment CardPurchaseSupplementLineItemsDetailIndicator = "payment"
 )
 
+func (r CardPurchaseSupplementLineItemsDetailIndicator) IsKnown() bool {
+	switch r {
+	case CardPurchaseSupplementLineItemsDetailIndicatorNormal, CardPurchaseSupplementLineItemsDetailIndicatorCredit, CardPurchaseSupplementLineItemsDetailIndicatorPayment:
+		return true
+	}
+	return false
+}
+
 // Indicates how the merchant applied the discount for this specific line item.
 type CardPurchaseSupplementLineItemsDiscountTreatmentCode string
 
@@ -285,6 +334,14 @@ const (
 	CardPurchaseSupplementLineItemsDiscountTreatmentCodeTaxCalculatedOnPreDiscountLineItemTotal CardPurchaseSupplementLineItemsDiscountTreatmentCode = "tax_calculated_on_pre_discount_line_item_total"
 )
 
+func (r CardPurchaseSupplementLineItemsDiscountTreatmentCode) IsKnown() bool {
+	switch r {
+	case CardPurchaseSupplementLineItemsDiscountTreatmentCodeNoLineItemLevelDiscountProvided, CardPurchaseSupplementLineItemsDiscountTreatmentCodeTaxCalculatedOnPostDiscountLineItemTotal, CardPurchaseSupplementLineItemsDiscountTreatmentCodeTaxCalculatedOnPreDiscountLineItemTotal:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_purchase_supplement`.
 type CardPurchaseSupplementType string
@@ -293,6 +350,14 @@ const (
 	CardPurchaseSupplementTypeCardPurchaseSupplement CardPurchaseSupplementType = "card_purchase_supplement"
 )
 
+func (r CardPurchaseSupplementType) IsKnown() bool {
+	switch r {
+	case CardPurchaseSupplementTypeCardPurchaseSupplement:
+		return true
+	}
+	return false
+}
+
 type CardPurchaseSupplementListParams struct {
 	// Filter Card Purchase Supplements to ones belonging to the specified Card
 	// Payment.
@@ -38,6 +38,7 @@ func NewCheckDepositService(opts ...option.RequestOption) (r *CheckDepositServic
 // Create a Check Deposit
 func (r *CheckDepositService) New(ctx context.Context, body CheckDepositNewParams, opts ...option.RequestOption) (res *CheckDeposit, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckDeposits.New")}, opts...)
 	path := "check_deposits"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *CheckDepositService) New(ctx context.Context, body CheckDepositNewParam
 // Retrieve a Check Deposit
 func (r *CheckDepositService) Get(ctx context.Context, checkDepositID string, opts ...option.RequestOption) (res *CheckDeposit, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckDeposits.Get")}, opts...)
 	path := fmt.Sprintf("check_deposits/%s", checkDepositID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -55,7 +57,7 @@ func (r *CheckDepositService) Get(ctx context.Context, checkDepositID string, op
 func (r *CheckDepositService) List(ctx context.Context, query CheckDepositListParams, opts ...option.RequestOption) (res *shared.Page[CheckDeposit], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CheckDeposits.List")}, opts...)
 	path := "check_deposits"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -134,6 +136,14 @@ func (r *CheckDeposit) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the deposit.
 type CheckDepositCurrency string
 
@@ -152,6 +162,14 @@ const (
 	CheckDepositCurrencyUsd CheckDepositCurrency = "USD"
 )
 
+func (r CheckDepositCurrency) IsKnown() bool {
+	switch r {
+	case CheckDepositCurrencyCad, CheckDepositCurrencyChf, CheckDepositCurrencyEur, CheckDepositCurrencyGbp, CheckDepositCurrencyJpy, CheckDepositCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // If your deposit is successfully parsed and accepted by Increase, this will
 // contain details of the parsed check.
 type CheckDepositDepositAcceptance struct {
@@ -194,6 +212,14 @@ func (r *CheckDepositDepositAcceptance) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckDepositDepositAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkDepositDepositAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CheckDepositDepositAcceptanceCurrency string
@@ -213,6 +239,14 @@ const (
 	CheckDepositDepositAcceptanceCurrencyUsd CheckDepositDepositAcceptanceCurrency = "USD"
 )
 
+func (r CheckDepositDepositAcceptanceCurrency) IsKnown() bool {
+	switch r {
+	case CheckDepositDepositAcceptanceCurrencyCad, CheckDepositDepositAcceptanceCurrencyChf, CheckDepositDepositAcceptanceCurrencyEur, CheckDepositDepositAcceptanceCurrencyGbp, CheckDepositDepositAcceptanceCurrencyJpy, CheckDepositDepositAcceptanceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // If your deposit is rejected by Increase, this will contain details as to why it
 // was rejected.
 type CheckDepositDepositRejection struct {
@@ -245,6 +279,14 @@ func (r *CheckDepositDepositRejection) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckDepositDepositRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkDepositDepositRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type CheckDepositDepositRejectionCurrency string
@@ -264,6 +306,14 @@ const (
 	CheckDepositDepositRejectionCurrencyUsd CheckDepositDepositRejectionCurrency = "USD"
 )
 
+func (r CheckDepositDepositRejectionCurrency) IsKnown() bool {
+	switch r {
+	case CheckDepositDepositRejectionCurrencyCad, CheckDepositDepositRejectionCurrencyChf, CheckDepositDepositRejectionCurrencyEur, CheckDepositDepositRejectionCurrencyGbp, CheckDepositDepositRejectionCurrencyJpy, CheckDepositDepositRejectionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why the check deposit was rejected.
 type CheckDepositDepositRejectionReason string
 
@@ -286,6 +336,14 @@ const (
 	CheckDepositDepositRejectionReasonUnknown CheckDepositDepositRejectionReason = "unknown"
 )
 
+func (r CheckDepositDepositRejectionReason) IsKnown() bool {
+	switch r {
+	case CheckDepositDepositRejectionReasonIncompleteImage, CheckDepositDepositRejectionReasonDuplicate, CheckDepositDepositRejectionReasonPoorImageQuality, CheckDepositDepositRejectionReasonIncorrectAmount, CheckDepositDepositRejectionReasonIncorrectRecipient, CheckDepositDepositRejectionReasonNotEligibleForMobileDeposit, CheckDepositDepositRejectionReasonMissingRequiredDataElements, CheckDepositDepositRejectionReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // If your deposit is returned, this will contain details as to why it was
 // returned.
 type CheckDepositDepositReturn struct {
@@ -326,6 +384,14 @@ func (r *CheckDepositDepositReturn) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckDepositDepositReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkDepositDepositReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CheckDepositDepositReturnCurrency string
@@ -345,6 +411,14 @@ const (
 	CheckDepositDepositReturnCurrencyUsd CheckDepositDepositReturnCurrency = "USD"
 )
 
+func (r CheckDepositDepositReturnCurrency) IsKnown() bool {
+	switch r {
+	case CheckDepositDepositReturnCurrencyCad, CheckDepositDepositReturnCurrencyChf, CheckDepositDepositReturnCurrencyEur, CheckDepositDepositReturnCurrencyGbp, CheckDepositDepositReturnCurrencyJpy, CheckDepositDepositReturnCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why this check was returned by the bank holding the account it was drawn
 // against.
 type CheckDepositDepositReturnReturnReason string
@@ -376,6 +450,14 @@ const (
 	CheckDepositDepositReturnReturnReasonEndorsementIrregular CheckDepositDepositReturnReturnReason = "endorsement_irregular"
 )
 
+func (r CheckDepositDepositReturnReturnReason) IsKnown() bool {
+	switch r {
+	case CheckDepositDepositReturnReturnReasonACHConversionNotSupported, CheckDepositDepositReturnReturnReasonClosedAccount, CheckDepositDepositReturnReturnReasonDuplicateSubmission, CheckDepositDepositReturnReturnReasonInsufficientFunds, CheckDepositDepositReturnReturnReasonNoAccount, CheckDepositDepositReturnReturnReasonNotAuthorized, CheckDepositDepositReturnReturnReasonStaleDated, CheckDepositDepositReturnReturnReasonStopPayment, CheckDepositDepositReturnReturnReasonUnknownReason, CheckDepositDepositReturnReturnReasonUnmatchedDetails, CheckDepositDepositReturnReturnReasonUnreadableImage, CheckDepositDepositReturnReturnReasonEndorsementIrregular:
+		return true
+	}
+	return false
+}
+
 // The status of the Check Deposit.
 type CheckDepositStatus string
 
@@ -390,6 +472,14 @@ const (
 	CheckDepositStatusReturned CheckDepositStatus = "returned"
 )
 
+func (r CheckDepositStatus) IsKnown() bool {
+	switch r {
+	case CheckDepositStatusPending, CheckDepositStatusSubmitted, CheckDepositStatusRejected, CheckDepositStatusReturned:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_deposit`.
 type CheckDepositType string
@@ -398,6 +488,14 @@ const (
 	CheckDepositTypeCheckDeposit CheckDepositType = "check_deposit"
 )
 
+func (r CheckDepositType) IsKnown() bool {
+	switch r {
+	case CheckDepositTypeCheckDeposit:
+		return true
+	}
+	return false
+}
+
 type CheckDepositNewParams struct {
 	// The identifier for the Account to deposit the check in.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -0,0 +1,108 @@
+package increase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Checkpoint is the progress of an [EventConsumer]. Every Event created before
+// CreatedAt has been handled, as have the Events in Handled, which were created
+// at or after it.
+type Checkpoint struct {
+	CreatedAt time.Time `json:"created_at"`
+	// Before is the end of the range of creation times being listed, when more
+	// Events were created after CreatedAt than fit on a page. It is zero
+	// otherwise.
+	Before time.Time `json:"before"`
+	// Cursor is the next page of the Events created between CreatedAt and
+	// Before, when they are only a second apart and the Events created in that
+	// second are listed a page at a time.
+	Cursor  string   `json:"cursor,omitempty"`
+	Handled []string `json:"handled,omitempty"`
+}
+
+// CheckpointStore saves the progress of an [EventConsumer], so that it carries
+// on where it left off when it is restarted.
+type CheckpointStore interface {
+	// Load returns the last Checkpoint saved, or a zero Checkpoint if none has
+	// been saved.
+	Load(ctx context.Context) (Checkpoint, error)
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore in memory, which does not outlive
+// the process.
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint Checkpoint
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoint, nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = checkpoint
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore which saves Checkpoints as JSON in a
+// file. The file is replaced atomically, so it is never left partly written.
+type FileCheckpointStore struct {
+	Path string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore which saves Checkpoints
+// in the file at path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{Path: path}
+}
+
+func (s *FileCheckpointStore) Load(ctx context.Context) (checkpoint Checkpoint, err error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Checkpoint{}, nil
+	}
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	err = json.Unmarshal(data, &checkpoint)
+	return checkpoint, err
+}
+
+func (s *FileCheckpointStore) Save(ctx context.Context, checkpoint Checkpoint) (err error) {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), "."+filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
@@ -38,6 +38,7 @@ func NewCheckTransferService(opts ...option.RequestOption) (r *CheckTransferServ
 // Create a Check Transfer
 func (r *CheckTransferService) New(ctx context.Context, body CheckTransferNewParams, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckTransfers.New")}, opts...)
 	path := "check_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,16 +47,28 @@ func (r *CheckTransferService) New(ctx context.Context, body CheckTransferNewPar
 // Retrieve a Check Transfer
 func (r *CheckTransferService) Get(ctx context.Context, checkTransferID string, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckTransfers.Get")}, opts...)
 	path := fmt.Sprintf("check_transfers/%s", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// Create a Check Transfer, or if one was already created with the same
+// UniqueIdentifier, retrieve and return it with existed set to true.
+func (r *CheckTransferService) NewOrGet(ctx context.Context, body CheckTransferNewParams, opts ...option.RequestOption) (res *CheckTransfer, existed bool, err error) {
+	res, err = r.New(ctx, body, opts...)
+	if id, ok := existingResourceID(err); ok {
+		res, err = r.Get(ctx, id, opts...)
+		return res, err == nil, err
+	}
+	return
+}
+
 // List Check Transfers
 func (r *CheckTransferService) List(ctx context.Context, query CheckTransferListParams, opts ...option.RequestOption) (res *shared.Page[CheckTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("CheckTransfers.List")}, opts...)
 	path := "check_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +90,7 @@ func (r *CheckTransferService) ListAutoPaging(ctx context.Context, query CheckTr
 // Approve a Check Transfer
 func (r *CheckTransferService) Approve(ctx context.Context, checkTransferID string, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckTransfers.Approve")}, opts...)
 	path := fmt.Sprintf("check_transfers/%s/approve", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -85,6 +99,7 @@ func (r *CheckTransferService) Approve(ctx context.Context, checkTransferID stri
 // Cancel a pending Check Transfer
 func (r *CheckTransferService) Cancel(ctx context.Context, checkTransferID string, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckTransfers.Cancel")}, opts...)
 	path := fmt.Sprintf("check_transfers/%s/cancel", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -93,6 +108,7 @@ func (r *CheckTransferService) Cancel(ctx context.Context, checkTransferID strin
 // Request a stop payment on a Check Transfer
 func (r *CheckTransferService) StopPayment(ctx context.Context, checkTransferID string, body CheckTransferStopPaymentParams, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("CheckTransfers.StopPayment")}, opts...)
 	path := fmt.Sprintf("check_transfers/%s/stop_payment", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -189,6 +205,14 @@ func (r *CheckTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was approved,
 // this will contain details of the approval.
 type CheckTransferApproval struct {
@@ -214,6 +238,14 @@ func (r *CheckTransferApproval) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferApproval) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferApprovalJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was not
 // approved, this will contain details of the cancellation.
 type CheckTransferCancellation struct {
@@ -239,6 +271,14 @@ func (r *CheckTransferCancellation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferCancellation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferCancellationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type CheckTransferCurrency string
@@ -258,6 +298,14 @@ const (
 	CheckTransferCurrencyUsd CheckTransferCurrency = "USD"
 )
 
+func (r CheckTransferCurrency) IsKnown() bool {
+	switch r {
+	case CheckTransferCurrencyCad, CheckTransferCurrencyChf, CheckTransferCurrencyEur, CheckTransferCurrencyGbp, CheckTransferCurrencyJpy, CheckTransferCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // After a check transfer is deposited, this will contain supplemental details.
 type CheckTransferDeposit struct {
 	// The identifier of the API File object containing an image of the back of the
@@ -300,6 +348,14 @@ func (r *CheckTransferDeposit) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_deposit`.
 type CheckTransferDepositType string
@@ -308,6 +364,14 @@ const (
 	CheckTransferDepositTypeCheckTransferDeposit CheckTransferDepositType = "check_transfer_deposit"
 )
 
+func (r CheckTransferDepositType) IsKnown() bool {
+	switch r {
+	case CheckTransferDepositTypeCheckTransferDeposit:
+		return true
+	}
+	return false
+}
+
 // Whether Increase will print and mail the check or if you will do it yourself.
 type CheckTransferFulfillmentMethod string
 
@@ -320,6 +384,14 @@ const (
 	CheckTransferFulfillmentMethodThirdParty CheckTransferFulfillmentMethod = "third_party"
 )
 
+func (r CheckTransferFulfillmentMethod) IsKnown() bool {
+	switch r {
+	case CheckTransferFulfillmentMethodPhysicalCheck, CheckTransferFulfillmentMethodThirdParty:
+		return true
+	}
+	return false
+}
+
 // If the check has been mailed by Increase, this will contain details of the
 // shipment.
 type CheckTransferMailing struct {
@@ -345,6 +417,14 @@ func (r *CheckTransferMailing) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferMailing) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferMailingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Details relating to the physical check that Increase will print and mail. Will
 // be present if and only if `fulfillment_method` is equal to `physical_check`.
 type CheckTransferPhysicalCheck struct {
@@ -377,6 +457,14 @@ func (r *CheckTransferPhysicalCheck) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferPhysicalCheck) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferPhysicalCheckJSON) RawJSON() string {
+	return r.raw
+}
+
 // Details for where Increase will mail the check.
 type CheckTransferPhysicalCheckMailingAddress struct {
 	// The city of the check's destination.
@@ -411,6 +499,14 @@ func (r *CheckTransferPhysicalCheckMailingAddress) UnmarshalJSON(data []byte) (e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferPhysicalCheckMailingAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferPhysicalCheckMailingAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The return address to be printed on the check.
 type CheckTransferPhysicalCheckReturnAddress struct {
 	// The city of the check's destination.
@@ -445,6 +541,14 @@ func (r *CheckTransferPhysicalCheckReturnAddress) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferPhysicalCheckReturnAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferPhysicalCheckReturnAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The lifecycle status of the transfer.
 type CheckTransferStatus string
 
@@ -471,6 +575,14 @@ const (
 	CheckTransferStatusRequiresAttention CheckTransferStatus = "requires_attention"
 )
 
+func (r CheckTransferStatus) IsKnown() bool {
+	switch r {
+	case CheckTransferStatusPendingApproval, CheckTransferStatusPendingSubmission, CheckTransferStatusSubmitted, CheckTransferStatusPendingMailing, CheckTransferStatusMailed, CheckTransferStatusCanceled, CheckTransferStatusDeposited, CheckTransferStatusStopped, CheckTransferStatusRejected, CheckTransferStatusRequiresAttention:
+		return true
+	}
+	return false
+}
+
 // After a stop-payment is requested on the check, this will contain supplemental
 // details.
 type CheckTransferStopPaymentRequest struct {
@@ -501,6 +613,14 @@ func (r *CheckTransferStopPaymentRequest) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferStopPaymentRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferStopPaymentRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason why this transfer was stopped.
 type CheckTransferStopPaymentRequestReason string
 
@@ -514,6 +634,14 @@ const (
 	CheckTransferStopPaymentRequestReasonUnknown CheckTransferStopPaymentRequestReason = "unknown"
 )
 
+func (r CheckTransferStopPaymentRequestReason) IsKnown() bool {
+	switch r {
+	case CheckTransferStopPaymentRequestReasonMailDeliveryFailed, CheckTransferStopPaymentRequestReasonRejectedByIncrease, CheckTransferStopPaymentRequestReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_stop_payment_request`.
 type CheckTransferStopPaymentRequestType string
@@ -522,6 +650,14 @@ const (
 	CheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest CheckTransferStopPaymentRequestType = "check_transfer_stop_payment_request"
 )
 
+func (r CheckTransferStopPaymentRequestType) IsKnown() bool {
+	switch r {
+	case CheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest:
+		return true
+	}
+	return false
+}
+
 // After the transfer is submitted, this will contain supplemental details.
 type CheckTransferSubmission struct {
 	// When this check transfer was submitted to our check printer.
@@ -541,6 +677,14 @@ func (r *CheckTransferSubmission) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CheckTransferSubmission) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r checkTransferSubmissionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer`.
 type CheckTransferType string
@@ -549,6 +693,14 @@ const (
 	CheckTransferTypeCheckTransfer CheckTransferType = "check_transfer"
 )
 
+func (r CheckTransferType) IsKnown() bool {
+	switch r {
+	case CheckTransferTypeCheckTransfer:
+		return true
+	}
+	return false
+}
+
 type CheckTransferNewParams struct {
 	// The identifier for the account that will send the transfer.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -587,6 +739,14 @@ const (
 	CheckTransferNewParamsFulfillmentMethodThirdParty CheckTransferNewParamsFulfillmentMethod = "third_party"
 )
 
+func (r CheckTransferNewParamsFulfillmentMethod) IsKnown() bool {
+	switch r {
+	case CheckTransferNewParamsFulfillmentMethodPhysicalCheck, CheckTransferNewParamsFulfillmentMethodThirdParty:
+		return true
+	}
+	return false
+}
+
 // Details relating to the physical check that Increase will print and mail. This
 // is required if `fulfillment_method` is equal to `physical_check`. It must not be
 // included if any other `fulfillment_method` is provided.
@@ -714,3 +874,11 @@ const (
 	// The check was stopped for another reason.
 	CheckTransferStopPaymentParamsReasonUnknown CheckTransferStopPaymentParamsReason = "unknown"
 )
+
+func (r CheckTransferStopPaymentParamsReason) IsKnown() bool {
+	switch r {
+	case CheckTransferStopPaymentParamsReasonMailDeliveryFailed, CheckTransferStopPaymentParamsReasonUnknown:
+		return true
+	}
+	return false
+}
@@ -5,6 +5,7 @@ package increase
 import (
 	"os"
 
+	"github.com/increase/increase-go/internal/requestconfig"
 	"github.com/increase/increase-go/option"
 )
 
@@ -64,6 +65,7 @@ func NewClient(opts ...option.RequestOption) (r *Client) {
 		defaults = append(defaults, option.WithAPIKey(o))
 	}
 	opts = append(defaults, opts...)
+	opts = append(append([]option.RequestOption{requestconfig.BeginClientOptions}, opts...), requestconfig.EndClientOptions)
 
 	r = &Client{Options: opts}
 
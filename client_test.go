@@ -3,10 +3,16 @@
 package increase_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -113,3 +119,703 @@ func TestContextDeadline(t *testing.T) {
 		}
 	}
 }
+
+// recordingTransport records each request it receives and responds with the
+// given status codes in order, repeating the last one once they run out.
+type recordingTransport struct {
+	statuses []int
+	header   http.Header
+	body     string
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := t.statuses[len(t.statuses)-1]
+	if len(t.requests) < len(t.statuses) {
+		status = t.statuses[len(t.requests)]
+	}
+	t.requests = append(t.requests, req)
+	header := http.Header{"Content-Type": {"application/json"}, "Retry-After": {"0"}}
+	for k, v := range t.header {
+		header[k] = v
+	}
+	body := t.body
+	if body == "" {
+		body = "{}"
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestIdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{500, 500, 200}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	_, err := client.Accounts.New(context.Background(), increase.AccountNewParams{
+		Name: increase.F("My First Increase Account"),
+	})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if len(transport.requests) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(transport.requests))
+	}
+	key := transport.requests[0].Header.Get("Idempotency-Key")
+	if !strings.HasPrefix(key, "stainless-go-") {
+		t.Fatalf("expected a generated idempotency key, got %q", key)
+	}
+	for i, req := range transport.requests {
+		if got := req.Header.Get("Idempotency-Key"); got != key {
+			t.Errorf("attempt %d sent idempotency key %q, want %q", i, got, key)
+		}
+	}
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{200}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	_, err := client.Accounts.New(context.Background(), increase.AccountNewParams{
+		Name: increase.F("My First Increase Account"),
+	}, option.WithIdempotencyKey("account-for-customer-42"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	_, err = client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky", option.WithIdempotencyKey("ignored"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if got := transport.requests[0].Header.Get("Idempotency-Key"); got != "account-for-customer-42" {
+		t.Errorf("expected the given idempotency key on POST, got %q", got)
+	}
+	if got := transport.requests[1].Header.Get("Idempotency-Key"); got != "" {
+		t.Errorf("expected no idempotency key on GET, got %q", got)
+	}
+}
+
+func TestWithIdempotencyKeyOnClient(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{200}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithIdempotencyKey("account-for-customer-42"),
+	)
+	_, err := client.Accounts.New(context.Background(), increase.AccountNewParams{
+		Name: increase.F("My First Increase Account"),
+	})
+	if err == nil {
+		t.Fatal("expected an error from a client given an idempotency key")
+	}
+	if len(transport.requests) != 0 {
+		t.Errorf("expected no requests, got %d", len(transport.requests))
+	}
+}
+
+func TestWithResponseMeta(t *testing.T) {
+	transport := &recordingTransport{
+		statuses: []int{503, 200},
+		header: http.Header{
+			"X-Request-Id":          {"req_1234"},
+			"Idempotent-Replayed":   {"true"},
+			"X-Ratelimit-Limit":     {"100"},
+			"X-Ratelimit-Remaining": {"99"},
+			"X-Ratelimit-Reset":     {"30"},
+		},
+	}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	var meta increase.ResponseMeta
+	_, err := client.Accounts.New(context.Background(), increase.AccountNewParams{
+		Name: increase.F("My First Increase Account"),
+	}, option.WithResponseMeta(&meta), option.WithIdempotencyKey("account-for-customer-42"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	expected := increase.ResponseMeta{
+		RequestID:          "req_1234",
+		IdempotencyKey:     "account-for-customer-42",
+		IdempotentReplayed: true,
+		StatusCode:         200,
+		Attempts:           2,
+		RateLimitLimit:     100,
+		RateLimitRemaining: 99,
+		RateLimitReset:     30 * time.Second,
+	}
+	if meta != expected {
+		t.Errorf("expected response meta %+v, got %+v", expected, meta)
+	}
+}
+
+func TestDefaultRetryPolicyConflicts(t *testing.T) {
+	cases := map[string]struct {
+		body     string
+		attempts int
+	}{
+		"unique identifier conflicts are final": {
+			body:     `{"type":"unique_identifier_already_exists_error","status":409,"title":"Exists","detail":null,"resource_id":"check_transfer_123"}`,
+			attempts: 1,
+		},
+		"invalid operations are final": {
+			body:     `{"type":"invalid_operation_error","status":409,"title":"Invalid","detail":null}`,
+			attempts: 1,
+		},
+		"used idempotency keys are final": {
+			body:     `{"type":"idempotency_key_already_used_error","status":409,"title":"Conflict","detail":null,"resource_id":"check_transfer_123"}`,
+			attempts: 1,
+		},
+		"other conflicts are retried": {
+			body:     `<html><body>409 Conflict</body></html>`,
+			attempts: 3,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			transport := &recordingTransport{statuses: []int{409}, body: c.body}
+			client := increase.NewClient(
+				option.WithBaseURL("http://localhost:4010"),
+				option.WithAPIKey("My API Key"),
+				option.WithHTTPClient(&http.Client{Transport: transport}),
+			)
+			_, err := client.CheckTransfers.New(context.Background(), increase.CheckTransferNewParams{
+				AccountID: increase.F("account_in71c4amph0vgo2qllky"),
+				Amount:    increase.F(int64(1000)),
+			})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if len(transport.requests) != c.attempts {
+				t.Errorf("expected %d attempts, got %d", c.attempts, len(transport.requests))
+			}
+		})
+	}
+}
+
+// neverRetryPolicy records the attempts it is asked about and never retries.
+type neverRetryPolicy struct {
+	attempts []option.RetryAttempt
+}
+
+func (p *neverRetryPolicy) ShouldRetry(attempt option.RetryAttempt) bool {
+	p.attempts = append(p.attempts, attempt)
+	return false
+}
+
+func (p *neverRetryPolicy) Backoff(attempt option.RetryAttempt) time.Duration {
+	return 0
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	transport := &recordingTransport{
+		statuses: []int{500},
+		body:     `{"type":"internal_server_error","status":500,"title":"Oops","detail":null}`,
+	}
+	policy := &neverRetryPolicy{}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithRetryPolicy(policy),
+	)
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if !errors.Is(err, increase.ErrInternalServer) {
+		t.Fatalf("expected an internal server error, got %v", err)
+	}
+	if len(transport.requests) != 1 || len(policy.attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %d requests and %d policy calls", len(transport.requests), len(policy.attempts))
+	}
+	attempt := policy.attempts[0]
+	if attempt.Attempt != 1 || attempt.Response.StatusCode != 500 || attempt.APIError == nil || attempt.APIError.Type != increase.ErrorTypeInternalServerError {
+		t.Errorf("unexpected attempt %+v", attempt)
+	}
+}
+
+func TestContextCancelDuringBackoff(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{500}, header: http.Header{"Retry-After": {"5"}}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	_, err := client.Accounts.Get(cancelCtx, "account_in71c4amph0vgo2qllky")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancel error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to return when the context was cancelled, took %v", elapsed)
+	}
+}
+
+func TestWithRetryDeadline(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{500}, header: http.Header{"Retry-After": {"1"}}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithRetryDeadline(500*time.Millisecond),
+	)
+	start := time.Now()
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(transport.requests) != 1 {
+		t.Errorf("expected no retries past the deadline, got %d attempts", len(transport.requests))
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected to return without waiting for the backoff, took %v", elapsed)
+	}
+}
+
+// slowFirstTransport never completes the first request it receives, and
+// succeeds on later ones.
+type slowFirstTransport struct {
+	requests int
+}
+
+func (t *slowFirstTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests += 1
+	if t.requests == 1 {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id":"account_in71c4amph0vgo2qllky"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestRequestTimeoutRetried(t *testing.T) {
+	transport := &slowFirstTransport{}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithRequestTimeout(20*time.Millisecond),
+		option.WithRetryPolicy(option.DefaultRetryPolicy{InitialDelay: time.Millisecond}),
+	)
+	res, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if res.ID != "account_in71c4amph0vgo2qllky" || transport.requests != 2 {
+		t.Errorf("expected the second attempt to succeed, got %q after %d attempts", res.ID, transport.requests)
+	}
+}
+
+func TestWithRateLimitPausesClient(t *testing.T) {
+	transport := &recordingTransport{
+		statuses: []int{429, 200},
+		header:   http.Header{"Retry-After": {"1"}},
+		body:     `{"type":"rate_limited_error","status":429,"title":"Rate limited","detail":null,"retry_after":1}`,
+	}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithMaxRetries(0),
+		option.WithRateLimit(1000, 10),
+	)
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if !increase.IsRateLimited(err) {
+		t.Fatalf("expected a rate limited error, got %v", err)
+	}
+
+	// A request from another service of the same client waits out the pause.
+	start := time.Now()
+	_, err = client.Cards.Get(context.Background(), "card_oubs0hwk5rn6knuecxg2")
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Errorf("expected the request to wait for the rate limit pause, waited %v", elapsed)
+	}
+}
+
+func TestWithRateLimitInvalid(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{200}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithRateLimit(0, 10),
+	)
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err == nil || !strings.Contains(err.Error(), "must be positive") {
+		t.Errorf("expected an error for the rate limit, got %v", err)
+	}
+	if len(transport.requests) != 0 {
+		t.Errorf("expected no requests to be sent, got %d", len(transport.requests))
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	transport := &recordingTransport{
+		statuses: []int{500, 200},
+		body:     `{"id":"external_account_ukk55lr923a3ac0pp7iv","account_number":"987654321","identification":{"method":"social_security_number","number":"078051120"}}`,
+	}
+	var logs strings.Builder
+	logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithLogger(logger),
+	)
+	res, err := client.ExternalAccounts.New(context.Background(), increase.ExternalAccountNewParams{
+		AccountNumber: increase.F("987654321"),
+		Description:   increase.F("Landlord"),
+		RoutingNumber: increase.F("101050001"),
+	}, option.WithIdempotencyKey("landlord-account"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if res.AccountNumber != "987654321" {
+		t.Errorf("expected the response to be decoded after logging, got %+v", res)
+	}
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one log line per attempt, got %d:\n%s", len(lines), logs.String())
+	}
+	for _, secret := range []string{"My API Key", "987654321", "078051120"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("expected %q to be redacted from the logs:\n%s", secret, logs.String())
+		}
+	}
+	for _, expected := range []string{`"level":"WARN"`, `"status":500`, `"retry_count":1`, `"idempotency_key":"landlord-account"`, `"path":"/external_accounts"`, `"operation":"ExternalAccounts.New"`, `[REDACTED]`} {
+		if !strings.Contains(logs.String(), expected) {
+			t.Errorf("expected the logs to contain %s:\n%s", expected, logs.String())
+		}
+	}
+}
+
+// countingBody counts the bytes read from it.
+type countingBody struct {
+	io.Reader
+	read int
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.read += n
+	return n, err
+}
+
+func (b *countingBody) Close() error { return nil }
+
+func TestWithLoggerDoesNotReadDownloads(t *testing.T) {
+	contents := `{"exported":"` + strings.Repeat("x", 1<<20) + `"}`
+	body := &countingBody{Reader: strings.NewReader(contents)}
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/files/file_makxrc67oh9l6sg7w9yc" {
+			return jsonResponse(req, 200, `{"id":"file_makxrc67oh9l6sg7w9yc","type":"file","download_url":"http://localhost:4010/downloads/file_makxrc67oh9l6sg7w9yc"}`), nil
+		}
+		return &http.Response{
+			StatusCode:    200,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			ContentLength: int64(len(contents)),
+			Body:          body,
+			Request:       req,
+		}, nil
+	})
+	logger := slog.New(slog.NewJSONHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithLogger(logger),
+	)
+	r, err := client.Files.DownloadReader(context.Background(), "file_makxrc67oh9l6sg7w9yc")
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer r.Close()
+	if body.read != 0 {
+		t.Errorf("expected the download not to be read before it is returned, read %d bytes", body.read)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil || string(data) != contents {
+		t.Errorf("expected the whole download, got %d bytes and %v", len(data), err)
+	}
+}
+
+type recordingInstrumentation struct {
+	option.NoopInstrumentation
+	events []string
+}
+
+func (i *recordingInstrumentation) RequestStart(ctx context.Context, info option.RequestInfo) context.Context {
+	i.events = append(i.events, "request start "+info.Operation)
+	return ctx
+}
+
+func (i *recordingInstrumentation) AttemptStart(ctx context.Context, info option.AttemptInfo) context.Context {
+	i.events = append(i.events, fmt.Sprintf("attempt start %s %d", info.Operation, info.Attempt))
+	return ctx
+}
+
+func (i *recordingInstrumentation) AttemptEnd(ctx context.Context, info option.AttemptInfo) {
+	i.events = append(i.events, fmt.Sprintf("attempt end %s %d %d %q", info.Operation, info.Attempt, info.Response.StatusCode, info.RetryReason))
+}
+
+func (i *recordingInstrumentation) ResponseDecoded(ctx context.Context, info option.DecodeInfo) {
+	i.events = append(i.events, fmt.Sprintf("decoded %s %v", info.Operation, info.Err))
+}
+
+func (i *recordingInstrumentation) RequestEnd(ctx context.Context, info option.RequestInfo, err error) {
+	i.events = append(i.events, fmt.Sprintf("request end %s %d %v", info.Operation, info.Attempts, err))
+}
+
+func TestWithInstrumentation(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{503, 200}}
+	instrumentation := &recordingInstrumentation{}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithInstrumentation(instrumentation),
+	)
+	_, err := client.Simulations.ACHTransfers.Return(context.Background(), "ach_transfer_uoxatyh3lt5evrsdvo7q", increase.SimulationACHTransferReturnParams{})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	expected := []string{
+		"request start Simulations.ACHTransfers.Return",
+		"attempt start Simulations.ACHTransfers.Return 1",
+		`attempt end Simulations.ACHTransfers.Return 1 503 "status_503"`,
+		"attempt start Simulations.ACHTransfers.Return 2",
+		`attempt end Simulations.ACHTransfers.Return 2 200 ""`,
+		"decoded Simulations.ACHTransfers.Return <nil>",
+		"request end Simulations.ACHTransfers.Return 2 <nil>",
+	}
+	if !reflect.DeepEqual(instrumentation.events, expected) {
+		t.Fatalf("expected hooks %q, got %q", expected, instrumentation.events)
+	}
+
+	instrumentation.events = nil
+	transport.statuses = []int{200}
+	_, err = client.ACHTransfers.List(context.Background(), increase.ACHTransferListParams{})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if instrumentation.events[0] != "request start ACHTransfers.List" {
+		t.Fatalf("expected the list to be named ACHTransfers.List, got %q", instrumentation.events)
+	}
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{503}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithCircuitBreaker(option.CircuitBreakerConfig{
+			FailureRate:     1,
+			MinimumAttempts: 2,
+			OpenDuration:    time.Hour,
+		}),
+	)
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if !errors.Is(err, increase.ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to open during the retries, got %v", err)
+	}
+	if len(transport.requests) != 2 {
+		t.Fatalf("expected 2 attempts before the circuit opened, got %d", len(transport.requests))
+	}
+
+	_, err = client.Cards.Get(context.Background(), "card_oubs0hwk5rn6knuecxg2")
+	if !errors.Is(err, increase.ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be shared by every service, got %v", err)
+	}
+	if len(transport.requests) != 2 {
+		t.Fatalf("expected no request to be sent while the circuit is open, got %d", len(transport.requests))
+	}
+}
+
+func TestMalformedResponseReturnsError(t *testing.T) {
+	transport := &recordingTransport{statuses: []int{200}, body: `{"id":"account_in71c4amph0vgo2qllky",`}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err == nil || !strings.Contains(err.Error(), "error parsing response json") {
+		t.Fatalf("expected a decoding error, got %v", err)
+	}
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	transport := &recordingTransport{
+		statuses: []int{200},
+		body:     `{"id":"account_in71c4amph0vgo2qllky","bank":"first_internet_bank","created_at":"2020-01-31T23:59:59Z","currency":"USD","entity_id":null,"informational_entity_id":null,"interest_accrued":"0.01","interest_accrued_at":"2020-01-31","interest_rate":"0.055","name":"My first account!","status":"frozen","type":"account"}`,
+	}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	res, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err != nil {
+		t.Fatalf("expected unknown enum values to be accepted by default, got %s", err.Error())
+	}
+	if res.Status.IsKnown() {
+		t.Fatalf("expected the status %q not to be known", res.Status)
+	}
+
+	_, err = client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky", option.WithStrictDecoding())
+	var decodeErr *increase.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a *increase.DecodeError, got %v", err)
+	}
+	expected := []increase.DecodeIssue{{Path: "status", Kind: increase.DecodeIssueUnknownEnum, Raw: `"frozen"`}}
+	if !reflect.DeepEqual(decodeErr.Issues, expected) {
+		t.Fatalf("expected issues %+v, got %+v", expected, decodeErr.Issues)
+	}
+
+	transport.body = `{"data":[{"id":"account_in71c4amph0vgo2qllky","created_at":"yesterday"}],"next_cursor":null}`
+	_, err = client.Accounts.List(context.Background(), increase.AccountListParams{}, option.WithStrictDecoding())
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a *increase.DecodeError, got %v", err)
+	}
+	for _, path := range []string{"data[0].bank", "data[0].created_at", "data[0].type"} {
+		if !strings.Contains(err.Error(), path) {
+			t.Errorf("expected the error to mention %s: %s", path, err.Error())
+		}
+	}
+}
+
+func TestWithUnknownValueHandler(t *testing.T) {
+	account := `{"id":"account_in71c4amph0vgo2qllky","bank":"first_internet_bank","created_at":"2020-01-31T23:59:59Z","currency":"USD","entity_id":null,"informational_entity_id":null,"interest_accrued":"0.01","interest_accrued_at":"2020-01-31","interest_rate":"0.055","name":"My first account!","status":"frozen","type":"account","loyalty_tier":"gold"}`
+	transport := &recordingTransport{
+		statuses: []int{200},
+		body:     `{"data":[` + account + `,` + account + `],"next_cursor":null}`,
+	}
+	var unknowns []option.UnknownValue
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithUnknownValueHandler(func(unknown option.UnknownValue) {
+			unknowns = append(unknowns, unknown)
+		}),
+	)
+	page, err := client.Accounts.List(context.Background(), increase.AccountListParams{})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if raw := page.Data[0].JSON.ExtraFields["loyalty_tier"].Raw(); raw != `"gold"` {
+		t.Errorf("expected the unknown property in ExtraFields, got %q", raw)
+	}
+	transport.body = account
+	_, err = client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+
+	if len(unknowns) != 2 {
+		t.Fatalf("expected each unknown value to be reported once, got %+v", unknowns)
+	}
+	status, tier := unknowns[0], unknowns[1]
+	if status.Kind != option.UnknownEnumValue || status.Type != "increase.AccountStatus" || status.Value != "frozen" || status.Path != "data[0].status" || status.Operation != "Accounts.List" {
+		t.Errorf("unexpected report of the unknown status: %+v", status)
+	}
+	if tier.Kind != option.UnknownField || tier.Type != "increase.Account" || tier.Value != "loyalty_tier" || tier.Raw != `"gold"` {
+		t.Errorf("unexpected report of the unknown property: %+v", tier)
+	}
+}
+
+func TestFileUploadStreamedAndRetried(t *testing.T) {
+	var uploads []string
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		if req.ContentLength > 0 {
+			t.Errorf("expected a streamed body of unknown length, got %d", req.ContentLength)
+		}
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		file, _, err := req.FormFile("file")
+		if err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		contents, _ := io.ReadAll(file)
+		uploads = append(uploads, string(contents))
+		if len(uploads) == 1 {
+			res := jsonResponse(req, 503, `{}`)
+			res.Header.Set("Retry-After", "0")
+			return res, nil
+		}
+		return jsonResponse(req, 200, `{"id":"file_makxrc67oh9l6sg7w9yc","filename":"check.png"}`), nil
+	})
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	opens := 0
+	res, err := client.Files.New(context.Background(), increase.FileNewParams{
+		File: increase.F(increase.ReopenableFile("check.png", func() (io.ReadCloser, error) {
+			opens += 1
+			return io.NopCloser(strings.NewReader("check image contents")), nil
+		})),
+		Purpose: increase.F(increase.FileNewParamsPurposeCheckImageFront),
+	})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if res.ID != "file_makxrc67oh9l6sg7w9yc" {
+		t.Errorf("expected the file to be decoded, got %+v", res)
+	}
+	if !reflect.DeepEqual(uploads, []string{"check image contents", "check image contents"}) || opens != 2 {
+		t.Fatalf("expected the file to be reopened and sent in full for each attempt, got %q after %d opens", uploads, opens)
+	}
+}
+
+func TestFileParam(t *testing.T) {
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		_, header, err := req.FormFile("file")
+		if err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		if header.Filename != "front.jpg" || header.Header.Get("Content-Type") != "image/jpeg" {
+			t.Errorf("expected front.jpg as image/jpeg, got %s as %s", header.Filename, header.Header.Get("Content-Type"))
+		}
+		return jsonResponse(req, 200, `{"id":"file_makxrc67oh9l6sg7w9yc"}`), nil
+	})
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	var sent, total int64
+	_, err := client.Files.New(context.Background(), increase.FileNewParams{
+		File: increase.F(increase.FileParam(bytes.NewBufferString("not really a jpeg"), "front.jpg", "", increase.UploadProgress(func(s, t int64) {
+			sent, total = s, t
+		}))),
+		Purpose: increase.F(increase.FileNewParamsPurposeCheckImageFront),
+	})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if sent != 17 || total != 17 {
+		t.Errorf("expected progress to reach 17 of 17 bytes, got %d of %d", sent, total)
+	}
+}
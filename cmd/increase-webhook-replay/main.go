@@ -0,0 +1,113 @@
+// Command increase-webhook-replay sends Events to a local webhook endpoint,
+// signed with a local secret, so that webhook handlers can be tested end to end
+// during development.
+//
+// Events are listed from the sandbox with the API key in INCREASE_API_KEY, or
+// read from a file of JSON Lines with -file:
+//
+//	increase-webhook-replay -url http://localhost:8080/webhooks -secret whsec_local -category ach_transfer.updated
+//	increase-webhook-replay -url http://localhost:8080/webhooks -file events.jsonl -event event_001dzz0r20rzr4zrhrr1364hy80
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+	"github.com/increase/increase-go/webhook"
+)
+
+func main() {
+	url := flag.String("url", "http://localhost:8080/webhooks", "the webhook endpoint to send Events to")
+	secret := flag.String("secret", os.Getenv("INCREASE_WEBHOOK_SECRET"), "the shared secret to sign webhooks with (default $INCREASE_WEBHOOK_SECRET)")
+	file := flag.String("file", "", "a file of Events as JSON Lines to read instead of listing them from the sandbox")
+	categories := flag.String("category", "", "a comma-separated list of Event categories to send")
+	eventID := flag.String("event", "", "the ID of a single Event to send")
+	since := flag.String("since", "", "only list Events created on or after this RFC 3339 time from the sandbox")
+	flag.Parse()
+
+	if err := run(*url, *secret, *file, *categories, *eventID, *since); err != nil {
+		fmt.Fprintln(os.Stderr, "increase-webhook-replay:", err)
+		os.Exit(1)
+	}
+}
+
+func run(url, secret, file, categories, eventID, since string) error {
+	if secret == "" {
+		return fmt.Errorf("a secret is required, with -secret or $INCREASE_WEBHOOK_SECRET")
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	config := webhook.ReplayConfig{URL: url, Secret: secret, EventID: eventID}
+	for _, category := range strings.Split(categories, ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			config.Categories = append(config.Categories, increase.EventCategory(category))
+		}
+	}
+
+	events, err := readEvents(ctx, file, config, since)
+	if err != nil {
+		return err
+	}
+	results, err := webhook.Replay(ctx, events, config)
+	failed := 0
+	for _, result := range results {
+		fmt.Printf("%s %s %d\n", result.Event.ID, result.Event.Category, result.StatusCode)
+		if result.StatusCode >= 300 {
+			failed += 1
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no Events matched")
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d Events were not accepted", failed, len(results))
+	}
+	return nil
+}
+
+func readEvents(ctx context.Context, file string, config webhook.ReplayConfig, since string) ([]increase.Event, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return webhook.ReadEvents(f)
+	}
+
+	client := increase.NewClient(option.WithEnvironmentSandbox())
+	if config.EventID != "" {
+		event, err := client.Events.Get(ctx, config.EventID)
+		if err != nil {
+			return nil, err
+		}
+		return []increase.Event{*event}, nil
+	}
+	query := increase.EventListParams{}
+	if len(config.Categories) > 0 {
+		in := make([]increase.EventListParamsCategoryIn, len(config.Categories))
+		for i, category := range config.Categories {
+			in[i] = increase.EventListParamsCategoryIn(category)
+		}
+		query.Category = increase.F(increase.EventListParamsCategory{In: increase.F(in)})
+	}
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -since: %w", err)
+		}
+		query.CreatedAt = increase.F(increase.EventListParamsCreatedAt{OnOrAfter: increase.F(t)})
+	}
+	return webhook.ListEvents(ctx, client.Events, query)
+}
@@ -38,6 +38,7 @@ func NewDeclinedTransactionService(opts ...option.RequestOption) (r *DeclinedTra
 // Retrieve a Declined Transaction
 func (r *DeclinedTransactionService) Get(ctx context.Context, declinedTransactionID string, opts ...option.RequestOption) (res *DeclinedTransaction, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("DeclinedTransactions.Get")}, opts...)
 	path := fmt.Sprintf("declined_transactions/%s", declinedTransactionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *DeclinedTransactionService) Get(ctx context.Context, declinedTransactio
 func (r *DeclinedTransactionService) List(ctx context.Context, query DeclinedTransactionListParams, opts ...option.RequestOption) (res *shared.Page[DeclinedTransaction], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("DeclinedTransactions.List")}, opts...)
 	path := "declined_transactions"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -124,6 +125,14 @@ func (r *DeclinedTransaction) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Declined
 // Transaction's currency. This will match the currency on the Declined
 // Transaction's Account.
@@ -144,6 +153,14 @@ const (
 	DeclinedTransactionCurrencyUsd DeclinedTransactionCurrency = "USD"
 )
 
+func (r DeclinedTransactionCurrency) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionCurrencyCad, DeclinedTransactionCurrencyChf, DeclinedTransactionCurrencyEur, DeclinedTransactionCurrencyGbp, DeclinedTransactionCurrencyJpy, DeclinedTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Declined Transaction came through.
 type DeclinedTransactionRouteType string
 
@@ -154,6 +171,14 @@ const (
 	DeclinedTransactionRouteTypeCard DeclinedTransactionRouteType = "card"
 )
 
+func (r DeclinedTransactionRouteType) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionRouteTypeAccountNumber, DeclinedTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Declined Transaction. For example, for a card transaction this lists the
 // merchant's industry and location. Note that for backwards compatibility reasons,
@@ -203,6 +228,14 @@ func (r *DeclinedTransactionSource) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `ach_decline`.
 type DeclinedTransactionSourceACHDecline struct {
@@ -255,6 +288,14 @@ func (r *DeclinedTransactionSourceACHDecline) UnmarshalJSON(data []byte) (err er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH transfer was declined.
 type DeclinedTransactionSourceACHDeclineReason string
 
@@ -291,6 +332,14 @@ const (
 	DeclinedTransactionSourceACHDeclineReasonUserInitiated DeclinedTransactionSourceACHDeclineReason = "user_initiated"
 )
 
+func (r DeclinedTransactionSourceACHDeclineReason) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceACHDeclineReasonACHRouteCanceled, DeclinedTransactionSourceACHDeclineReasonACHRouteDisabled, DeclinedTransactionSourceACHDeclineReasonBreachesLimit, DeclinedTransactionSourceACHDeclineReasonCreditEntryRefusedByReceiver, DeclinedTransactionSourceACHDeclineReasonDuplicateReturn, DeclinedTransactionSourceACHDeclineReasonEntityNotActive, DeclinedTransactionSourceACHDeclineReasonGroupLocked, DeclinedTransactionSourceACHDeclineReasonInsufficientFunds, DeclinedTransactionSourceACHDeclineReasonMisroutedReturn, DeclinedTransactionSourceACHDeclineReasonReturnOfErroneousOrReversingDebit, DeclinedTransactionSourceACHDeclineReasonNoACHRoute, DeclinedTransactionSourceACHDeclineReasonOriginatorRequest, DeclinedTransactionSourceACHDeclineReasonTransactionNotAllowed, DeclinedTransactionSourceACHDeclineReasonUserInitiated:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `ach_decline`.
 type DeclinedTransactionSourceACHDeclineType string
@@ -299,6 +348,14 @@ const (
 	DeclinedTransactionSourceACHDeclineTypeACHDecline DeclinedTransactionSourceACHDeclineType = "ach_decline"
 )
 
+func (r DeclinedTransactionSourceACHDeclineType) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceACHDeclineTypeACHDecline:
+		return true
+	}
+	return false
+}
+
 // A Card Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_decline`.
 type DeclinedTransactionSourceCardDecline struct {
@@ -378,6 +435,14 @@ func (r *DeclinedTransactionSourceCardDecline) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type DeclinedTransactionSourceCardDeclineCurrency string
@@ -397,6 +462,14 @@ const (
 	DeclinedTransactionSourceCardDeclineCurrencyUsd DeclinedTransactionSourceCardDeclineCurrency = "USD"
 )
 
+func (r DeclinedTransactionSourceCardDeclineCurrency) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineCurrencyCad, DeclinedTransactionSourceCardDeclineCurrencyChf, DeclinedTransactionSourceCardDeclineCurrencyEur, DeclinedTransactionSourceCardDeclineCurrencyGbp, DeclinedTransactionSourceCardDeclineCurrencyJpy, DeclinedTransactionSourceCardDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type DeclinedTransactionSourceCardDeclineNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -419,6 +492,14 @@ func (r *DeclinedTransactionSourceCardDeclineNetworkDetails) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type DeclinedTransactionSourceCardDeclineNetworkDetailsCategory string
 
@@ -427,6 +508,14 @@ const (
 	DeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa DeclinedTransactionSourceCardDeclineNetworkDetailsCategory = "visa"
 )
 
+func (r DeclinedTransactionSourceCardDeclineNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type DeclinedTransactionSourceCardDeclineNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -452,6 +541,14 @@ func (r *DeclinedTransactionSourceCardDeclineNetworkDetailsVisa) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -494,6 +591,14 @@ const (
 	DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorRecurring, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorInstallment, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode string
@@ -521,6 +626,14 @@ const (
 	DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeUnknown, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeManual, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactless, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, DeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type DeclinedTransactionSourceCardDeclineNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -550,6 +663,14 @@ func (r *DeclinedTransactionSourceCardDeclineNetworkIdentifiers) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type DeclinedTransactionSourceCardDeclineProcessingCategory string
@@ -574,6 +695,14 @@ const (
 	DeclinedTransactionSourceCardDeclineProcessingCategoryRefund DeclinedTransactionSourceCardDeclineProcessingCategory = "refund"
 )
 
+func (r DeclinedTransactionSourceCardDeclineProcessingCategory) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineProcessingCategoryAccountFunding, DeclinedTransactionSourceCardDeclineProcessingCategoryAutomaticFuelDispenser, DeclinedTransactionSourceCardDeclineProcessingCategoryBillPayment, DeclinedTransactionSourceCardDeclineProcessingCategoryPurchase, DeclinedTransactionSourceCardDeclineProcessingCategoryQuasiCash, DeclinedTransactionSourceCardDeclineProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // Why the transaction was declined.
 type DeclinedTransactionSourceCardDeclineReason string
 
@@ -610,6 +739,14 @@ const (
 	DeclinedTransactionSourceCardDeclineReasonSuspectedFraud DeclinedTransactionSourceCardDeclineReason = "suspected_fraud"
 )
 
+func (r DeclinedTransactionSourceCardDeclineReason) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineReasonCardNotActive, DeclinedTransactionSourceCardDeclineReasonPhysicalCardNotActive, DeclinedTransactionSourceCardDeclineReasonEntityNotActive, DeclinedTransactionSourceCardDeclineReasonGroupLocked, DeclinedTransactionSourceCardDeclineReasonInsufficientFunds, DeclinedTransactionSourceCardDeclineReasonCvv2Mismatch, DeclinedTransactionSourceCardDeclineReasonTransactionNotAllowed, DeclinedTransactionSourceCardDeclineReasonBreachesLimit, DeclinedTransactionSourceCardDeclineReasonWebhookDeclined, DeclinedTransactionSourceCardDeclineReasonWebhookTimedOut, DeclinedTransactionSourceCardDeclineReasonDeclinedByStandInProcessing, DeclinedTransactionSourceCardDeclineReasonInvalidPhysicalCard, DeclinedTransactionSourceCardDeclineReasonMissingOriginalAuthorization, DeclinedTransactionSourceCardDeclineReasonSuspectedFraud:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type DeclinedTransactionSourceCardDeclineVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -634,6 +771,14 @@ func (r *DeclinedTransactionSourceCardDeclineVerification) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type DeclinedTransactionSourceCardDeclineVerificationCardVerificationCode struct {
@@ -655,6 +800,14 @@ func (r *DeclinedTransactionSourceCardDeclineVerificationCardVerificationCode) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult string
 
@@ -667,6 +820,14 @@ const (
 	DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNotChecked, DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultMatch, DeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type DeclinedTransactionSourceCardDeclineVerificationCardholderAddress struct {
@@ -701,6 +862,14 @@ func (r *DeclinedTransactionSourceCardDeclineVerificationCardholderAddress) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCardDeclineVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCardDeclineVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult string
 
@@ -719,6 +888,14 @@ const (
 	DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult = "no_match"
 )
 
+func (r DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNotChecked, DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultMatch, DeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type DeclinedTransactionSourceCategory string
@@ -742,6 +919,14 @@ const (
 	DeclinedTransactionSourceCategoryOther DeclinedTransactionSourceCategory = "other"
 )
 
+func (r DeclinedTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCategoryACHDecline, DeclinedTransactionSourceCategoryCardDecline, DeclinedTransactionSourceCategoryCheckDecline, DeclinedTransactionSourceCategoryInboundRealTimePaymentsTransferDecline, DeclinedTransactionSourceCategoryInternationalACHDecline, DeclinedTransactionSourceCategoryWireDecline, DeclinedTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `check_decline`.
 type DeclinedTransactionSourceCheckDecline struct {
@@ -779,6 +964,14 @@ func (r *DeclinedTransactionSourceCheckDecline) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceCheckDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceCheckDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the check was declined.
 type DeclinedTransactionSourceCheckDeclineReason string
 
@@ -810,6 +1003,14 @@ const (
 	DeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound DeclinedTransactionSourceCheckDeclineReason = "no_account_number_found"
 )
 
+func (r DeclinedTransactionSourceCheckDeclineReason) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceCheckDeclineReasonACHRouteDisabled, DeclinedTransactionSourceCheckDeclineReasonACHRouteCanceled, DeclinedTransactionSourceCheckDeclineReasonBreachesLimit, DeclinedTransactionSourceCheckDeclineReasonEntityNotActive, DeclinedTransactionSourceCheckDeclineReasonGroupLocked, DeclinedTransactionSourceCheckDeclineReasonInsufficientFunds, DeclinedTransactionSourceCheckDeclineReasonStopPaymentRequested, DeclinedTransactionSourceCheckDeclineReasonDuplicatePresentment, DeclinedTransactionSourceCheckDeclineReasonNotAuthorized, DeclinedTransactionSourceCheckDeclineReasonAmountMismatch, DeclinedTransactionSourceCheckDeclineReasonNotOurItem, DeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Decline object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_decline`.
@@ -859,6 +1060,14 @@ func (r *DeclinedTransactionSourceInboundRealTimePaymentsTransferDecline) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceInboundRealTimePaymentsTransferDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceInboundRealTimePaymentsTransferDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the declined
 // transfer's currency. This will always be "USD" for a Real-Time Payments
 // transfer.
@@ -879,6 +1088,14 @@ const (
 	DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency = "USD"
 )
 
+func (r DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyCad, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyChf, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyEur, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyGbp, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyJpy, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why the transfer was declined.
 type DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason string
 
@@ -897,6 +1114,14 @@ const (
 	DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason = "real_time_payments_not_enabled"
 )
 
+func (r DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberCanceled, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberDisabled, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountRestricted, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonGroupLocked, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonEntityNotActive, DeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled:
+		return true
+	}
+	return false
+}
+
 // An International ACH Decline object. This field will be present in the JSON
 // response if and only if `category` is equal to `international_ach_decline`.
 type DeclinedTransactionSourceInternationalACHDecline struct {
@@ -1047,6 +1272,14 @@ func (r *DeclinedTransactionSourceInternationalACHDecline) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceInternationalACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceInternationalACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator string
 
@@ -1062,6 +1295,14 @@ const (
 	DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToVariable, DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorVariableToFixed, DeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator string
@@ -1076,6 +1317,14 @@ const (
 	DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeRate, DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, DeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode string
 
@@ -1122,6 +1371,14 @@ const (
 	DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAnnuity, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBusinessOrCommercial, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeDeposit, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeLoan, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMiscellaneous, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMortgage, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePension, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRemittance, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRentOrLease, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSalaryOrPayroll, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTax, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAccountsReceivable, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBackOfficeConversion, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMachineTransfer, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfPurchase, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfSale, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRepresentedCheck, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSharedNetworkTransaction, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTelphoneInitiated, DeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -1136,6 +1393,14 @@ const (
 	DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, DeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -1150,6 +1415,14 @@ const (
 	DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierBicCode, DeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // A Wire Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `wire_decline`.
 type DeclinedTransactionSourceWireDecline struct {
@@ -1225,6 +1498,14 @@ func (r *DeclinedTransactionSourceWireDecline) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DeclinedTransactionSourceWireDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r declinedTransactionSourceWireDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the wire transfer was declined.
 type DeclinedTransactionSourceWireDeclineReason string
 
@@ -1243,6 +1524,14 @@ const (
 	DeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed DeclinedTransactionSourceWireDeclineReason = "transaction_not_allowed"
 )
 
+func (r DeclinedTransactionSourceWireDeclineReason) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionSourceWireDeclineReasonAccountNumberCanceled, DeclinedTransactionSourceWireDeclineReasonAccountNumberDisabled, DeclinedTransactionSourceWireDeclineReasonEntityNotActive, DeclinedTransactionSourceWireDeclineReasonGroupLocked, DeclinedTransactionSourceWireDeclineReasonNoAccountNumber, DeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `declined_transaction`.
 type DeclinedTransactionType string
@@ -1251,6 +1540,14 @@ const (
 	DeclinedTransactionTypeDeclinedTransaction DeclinedTransactionType = "declined_transaction"
 )
 
+func (r DeclinedTransactionType) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionTypeDeclinedTransaction:
+		return true
+	}
+	return false
+}
+
 type DeclinedTransactionListParams struct {
 	// Filter Declined Transactions to ones belonging to the specified Account.
 	AccountID param.Field[string]                                 `query:"account_id"`
@@ -1310,6 +1607,14 @@ const (
 	DeclinedTransactionListParamsCategoryInOther DeclinedTransactionListParamsCategoryIn = "other"
 )
 
+func (r DeclinedTransactionListParamsCategoryIn) IsKnown() bool {
+	switch r {
+	case DeclinedTransactionListParamsCategoryInACHDecline, DeclinedTransactionListParamsCategoryInCardDecline, DeclinedTransactionListParamsCategoryInCheckDecline, DeclinedTransactionListParamsCategoryInInboundRealTimePaymentsTransferDecline, DeclinedTransactionListParamsCategoryInInternationalACHDecline, DeclinedTransactionListParamsCategoryInWireDecline, DeclinedTransactionListParamsCategoryInOther:
+		return true
+	}
+	return false
+}
+
 type DeclinedTransactionListParamsCreatedAt struct {
 	// Return results after this [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601)
 	// timestamp.
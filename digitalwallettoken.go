@@ -38,6 +38,7 @@ func NewDigitalWalletTokenService(opts ...option.RequestOption) (r *DigitalWalle
 // Retrieve a Digital Wallet Token
 func (r *DigitalWalletTokenService) Get(ctx context.Context, digitalWalletTokenID string, opts ...option.RequestOption) (res *DigitalWalletToken, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("DigitalWalletTokens.Get")}, opts...)
 	path := fmt.Sprintf("digital_wallet_tokens/%s", digitalWalletTokenID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *DigitalWalletTokenService) Get(ctx context.Context, digitalWalletTokenI
 func (r *DigitalWalletTokenService) List(ctx context.Context, query DigitalWalletTokenListParams, opts ...option.RequestOption) (res *shared.Page[DigitalWalletToken], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("DigitalWalletTokens.List")}, opts...)
 	path := "digital_wallet_tokens"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -104,6 +105,14 @@ func (r *DigitalWalletToken) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r DigitalWalletToken) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r digitalWalletTokenJSON) RawJSON() string {
+	return r.raw
+}
+
 // This indicates if payments can be made with the Digital Wallet Token.
 type DigitalWalletTokenStatus string
 
@@ -119,6 +128,14 @@ const (
 	DigitalWalletTokenStatusDeactivated DigitalWalletTokenStatus = "deactivated"
 )
 
+func (r DigitalWalletTokenStatus) IsKnown() bool {
+	switch r {
+	case DigitalWalletTokenStatusActive, DigitalWalletTokenStatusInactive, DigitalWalletTokenStatusSuspended, DigitalWalletTokenStatusDeactivated:
+		return true
+	}
+	return false
+}
+
 // The digital wallet app being used.
 type DigitalWalletTokenTokenRequestor string
 
@@ -131,6 +148,14 @@ const (
 	DigitalWalletTokenTokenRequestorUnknown DigitalWalletTokenTokenRequestor = "unknown"
 )
 
+func (r DigitalWalletTokenTokenRequestor) IsKnown() bool {
+	switch r {
+	case DigitalWalletTokenTokenRequestorApplePay, DigitalWalletTokenTokenRequestorGooglePay, DigitalWalletTokenTokenRequestorUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `digital_wallet_token`.
 type DigitalWalletTokenType string
@@ -139,6 +164,14 @@ const (
 	DigitalWalletTokenTypeDigitalWalletToken DigitalWalletTokenType = "digital_wallet_token"
 )
 
+func (r DigitalWalletTokenType) IsKnown() bool {
+	switch r {
+	case DigitalWalletTokenTypeDigitalWalletToken:
+		return true
+	}
+	return false
+}
+
 type DigitalWalletTokenListParams struct {
 	// Filter Digital Wallet Tokens to ones belonging to the specified Card.
 	CardID    param.Field[string]                                `query:"card_id"`
@@ -37,6 +37,7 @@ func NewDocumentService(opts ...option.RequestOption) (r *DocumentService) {
 // Retrieve a Document
 func (r *DocumentService) Get(ctx context.Context, documentID string, opts ...option.RequestOption) (res *Document, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Documents.Get")}, opts...)
 	path := fmt.Sprintf("documents/%s", documentID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -46,7 +47,7 @@ func (r *DocumentService) Get(ctx context.Context, documentID string, opts ...op
 func (r *DocumentService) List(ctx context.Context, query DocumentListParams, opts ...option.RequestOption) (res *shared.Page[Document], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Documents.List")}, opts...)
 	path := "documents"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -102,6 +103,14 @@ func (r *Document) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Document) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r documentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The type of document.
 type DocumentCategory string
 
@@ -116,6 +125,14 @@ const (
 	DocumentCategoryCompanyInformation DocumentCategory = "company_information"
 )
 
+func (r DocumentCategory) IsKnown() bool {
+	switch r {
+	case DocumentCategoryForm1099Int, DocumentCategoryProofOfAuthorization, DocumentCategoryCompanyInformation:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `document`.
 type DocumentType string
@@ -124,6 +141,14 @@ const (
 	DocumentTypeDocument DocumentType = "document"
 )
 
+func (r DocumentType) IsKnown() bool {
+	switch r {
+	case DocumentTypeDocument:
+		return true
+	}
+	return false
+}
+
 type DocumentListParams struct {
 	Category  param.Field[DocumentListParamsCategory]  `query:"category"`
 	CreatedAt param.Field[DocumentListParamsCreatedAt] `query:"created_at"`
@@ -173,6 +198,14 @@ const (
 	DocumentListParamsCategoryInCompanyInformation DocumentListParamsCategoryIn = "company_information"
 )
 
+func (r DocumentListParamsCategoryIn) IsKnown() bool {
+	switch r {
+	case DocumentListParamsCategoryInForm1099Int, DocumentListParamsCategoryInProofOfAuthorization, DocumentListParamsCategoryInCompanyInformation:
+		return true
+	}
+	return false
+}
+
 type DocumentListParamsCreatedAt struct {
 	// Return results after this [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601)
 	// timestamp.
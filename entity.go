@@ -41,6 +41,7 @@ func NewEntityService(opts ...option.RequestOption) (r *EntityService) {
 // Create an Entity
 func (r *EntityService) New(ctx context.Context, body EntityNewParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.New")}, opts...)
 	path := "entities"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -49,6 +50,7 @@ func (r *EntityService) New(ctx context.Context, body EntityNewParams, opts ...o
 // Retrieve an Entity
 func (r *EntityService) Get(ctx context.Context, entityID string, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.Get")}, opts...)
 	path := fmt.Sprintf("entities/%s", entityID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -58,7 +60,7 @@ func (r *EntityService) Get(ctx context.Context, entityID string, opts ...option
 func (r *EntityService) List(ctx context.Context, query EntityListParams, opts ...option.RequestOption) (res *shared.Page[Entity], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Entities.List")}, opts...)
 	path := "entities"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -80,6 +82,7 @@ func (r *EntityService) ListAutoPaging(ctx context.Context, query EntityListPara
 // Archive an Entity
 func (r *EntityService) Archive(ctx context.Context, entityID string, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.Archive")}, opts...)
 	path := fmt.Sprintf("entities/%s/archive", entityID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -88,6 +91,7 @@ func (r *EntityService) Archive(ctx context.Context, entityID string, opts ...op
 // Update a Natural Person or Corporation's address
 func (r *EntityService) UpdateAddress(ctx context.Context, entityID string, body EntityUpdateAddressParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.UpdateAddress")}, opts...)
 	path := fmt.Sprintf("entities/%s/address", entityID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -144,6 +148,14 @@ func (r *Entity) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Entity) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityJSON) RawJSON() string {
+	return r.raw
+}
+
 // Details of the corporation entity. Will be present if `structure` is equal to
 // `corporation`.
 type EntityCorporation struct {
@@ -181,6 +193,14 @@ func (r *EntityCorporation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The corporation's address.
 type EntityCorporationAddress struct {
 	// The city of the address.
@@ -213,6 +233,14 @@ func (r *EntityCorporationAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporationAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 type EntityCorporationBeneficialOwner struct {
 	// The identifier of this beneficial owner.
 	BeneficialOwnerID string `json:"beneficial_owner_id,required"`
@@ -240,6 +268,14 @@ func (r *EntityCorporationBeneficialOwner) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporationBeneficialOwner) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationBeneficialOwnerJSON) RawJSON() string {
+	return r.raw
+}
+
 // Personal details for the beneficial owner.
 type EntityCorporationBeneficialOwnersIndividual struct {
 	// The person's address.
@@ -268,6 +304,14 @@ func (r *EntityCorporationBeneficialOwnersIndividual) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporationBeneficialOwnersIndividual) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationBeneficialOwnersIndividualJSON) RawJSON() string {
+	return r.raw
+}
+
 // The person's address.
 type EntityCorporationBeneficialOwnersIndividualAddress struct {
 	// The city of the address.
@@ -300,6 +344,14 @@ func (r *EntityCorporationBeneficialOwnersIndividualAddress) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporationBeneficialOwnersIndividualAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationBeneficialOwnersIndividualAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // A means of verifying the person's identity.
 type EntityCorporationBeneficialOwnersIndividualIdentification struct {
 	// A method that can be used to verify the individual's identity.
@@ -324,6 +376,14 @@ func (r *EntityCorporationBeneficialOwnersIndividualIdentification) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityCorporationBeneficialOwnersIndividualIdentification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityCorporationBeneficialOwnersIndividualIdentificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // A method that can be used to verify the individual's identity.
 type EntityCorporationBeneficialOwnersIndividualIdentificationMethod string
 
@@ -340,6 +400,14 @@ const (
 	EntityCorporationBeneficialOwnersIndividualIdentificationMethodOther EntityCorporationBeneficialOwnersIndividualIdentificationMethod = "other"
 )
 
+func (r EntityCorporationBeneficialOwnersIndividualIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityCorporationBeneficialOwnersIndividualIdentificationMethodSocialSecurityNumber, EntityCorporationBeneficialOwnersIndividualIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityCorporationBeneficialOwnersIndividualIdentificationMethodPassport, EntityCorporationBeneficialOwnersIndividualIdentificationMethodDriversLicense, EntityCorporationBeneficialOwnersIndividualIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Why this person is considered a beneficial owner of the entity.
 type EntityCorporationBeneficialOwnersProng string
 
@@ -350,6 +418,14 @@ const (
 	EntityCorporationBeneficialOwnersProngControl EntityCorporationBeneficialOwnersProng = "control"
 )
 
+func (r EntityCorporationBeneficialOwnersProng) IsKnown() bool {
+	switch r {
+	case EntityCorporationBeneficialOwnersProngOwnership, EntityCorporationBeneficialOwnersProngControl:
+		return true
+	}
+	return false
+}
+
 // Details of the joint entity. Will be present if `structure` is equal to `joint`.
 type EntityJoint struct {
 	// The two individuals that share control of the entity.
@@ -371,6 +447,14 @@ func (r *EntityJoint) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityJoint) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityJointJSON) RawJSON() string {
+	return r.raw
+}
+
 type EntityJointIndividual struct {
 	// The person's address.
 	Address EntityJointIndividualsAddress `json:"address,required"`
@@ -398,6 +482,14 @@ func (r *EntityJointIndividual) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityJointIndividual) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityJointIndividualJSON) RawJSON() string {
+	return r.raw
+}
+
 // The person's address.
 type EntityJointIndividualsAddress struct {
 	// The city of the address.
@@ -430,6 +522,14 @@ func (r *EntityJointIndividualsAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityJointIndividualsAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityJointIndividualsAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // A means of verifying the person's identity.
 type EntityJointIndividualsIdentification struct {
 	// A method that can be used to verify the individual's identity.
@@ -453,6 +553,14 @@ func (r *EntityJointIndividualsIdentification) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityJointIndividualsIdentification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityJointIndividualsIdentificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // A method that can be used to verify the individual's identity.
 type EntityJointIndividualsIdentificationMethod string
 
@@ -469,6 +577,14 @@ const (
 	EntityJointIndividualsIdentificationMethodOther EntityJointIndividualsIdentificationMethod = "other"
 )
 
+func (r EntityJointIndividualsIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityJointIndividualsIdentificationMethodSocialSecurityNumber, EntityJointIndividualsIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityJointIndividualsIdentificationMethodPassport, EntityJointIndividualsIdentificationMethodDriversLicense, EntityJointIndividualsIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Details of the natural person entity. Will be present if `structure` is equal to
 // `natural_person`.
 type EntityNaturalPerson struct {
@@ -498,6 +614,14 @@ func (r *EntityNaturalPerson) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityNaturalPerson) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityNaturalPersonJSON) RawJSON() string {
+	return r.raw
+}
+
 // The person's address.
 type EntityNaturalPersonAddress struct {
 	// The city of the address.
@@ -530,6 +654,14 @@ func (r *EntityNaturalPersonAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityNaturalPersonAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityNaturalPersonAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // A means of verifying the person's identity.
 type EntityNaturalPersonIdentification struct {
 	// A method that can be used to verify the individual's identity.
@@ -553,6 +685,14 @@ func (r *EntityNaturalPersonIdentification) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityNaturalPersonIdentification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityNaturalPersonIdentificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // A method that can be used to verify the individual's identity.
 type EntityNaturalPersonIdentificationMethod string
 
@@ -569,6 +709,14 @@ const (
 	EntityNaturalPersonIdentificationMethodOther EntityNaturalPersonIdentificationMethod = "other"
 )
 
+func (r EntityNaturalPersonIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNaturalPersonIdentificationMethodSocialSecurityNumber, EntityNaturalPersonIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNaturalPersonIdentificationMethodPassport, EntityNaturalPersonIdentificationMethodDriversLicense, EntityNaturalPersonIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // The status of the entity.
 type EntityStatus string
 
@@ -581,6 +729,14 @@ const (
 	EntityStatusDisabled EntityStatus = "disabled"
 )
 
+func (r EntityStatus) IsKnown() bool {
+	switch r {
+	case EntityStatusActive, EntityStatusArchived, EntityStatusDisabled:
+		return true
+	}
+	return false
+}
+
 // The entity's legal structure.
 type EntityStructure string
 
@@ -595,6 +751,14 @@ const (
 	EntityStructureTrust EntityStructure = "trust"
 )
 
+func (r EntityStructure) IsKnown() bool {
+	switch r {
+	case EntityStructureCorporation, EntityStructureNaturalPerson, EntityStructureJoint, EntityStructureTrust:
+		return true
+	}
+	return false
+}
+
 // Supplemental Documents are uploaded files connected to an Entity during
 // onboarding.
 type EntitySupplementalDocument struct {
@@ -623,6 +787,14 @@ func (r *EntitySupplementalDocument) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntitySupplementalDocument) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entitySupplementalDocumentJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `entity_supplemental_document`.
 type EntitySupplementalDocumentsType string
@@ -631,6 +803,14 @@ const (
 	EntitySupplementalDocumentsTypeEntitySupplementalDocument EntitySupplementalDocumentsType = "entity_supplemental_document"
 )
 
+func (r EntitySupplementalDocumentsType) IsKnown() bool {
+	switch r {
+	case EntitySupplementalDocumentsTypeEntitySupplementalDocument:
+		return true
+	}
+	return false
+}
+
 // Details of the trust entity. Will be present if `structure` is equal to `trust`.
 type EntityTrust struct {
 	// The trust's address.
@@ -671,6 +851,14 @@ func (r *EntityTrust) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrust) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustJSON) RawJSON() string {
+	return r.raw
+}
+
 // The trust's address.
 type EntityTrustAddress struct {
 	// The city of the address.
@@ -703,6 +891,14 @@ func (r *EntityTrustAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether the trust is `revocable` or `irrevocable`.
 type EntityTrustCategory string
 
@@ -713,6 +909,14 @@ const (
 	EntityTrustCategoryIrrevocable EntityTrustCategory = "irrevocable"
 )
 
+func (r EntityTrustCategory) IsKnown() bool {
+	switch r {
+	case EntityTrustCategoryRevocable, EntityTrustCategoryIrrevocable:
+		return true
+	}
+	return false
+}
+
 // The grantor of the trust. Will be present if the `category` is `revocable`.
 type EntityTrustGrantor struct {
 	// The person's address.
@@ -741,6 +945,14 @@ func (r *EntityTrustGrantor) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustGrantor) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustGrantorJSON) RawJSON() string {
+	return r.raw
+}
+
 // The person's address.
 type EntityTrustGrantorAddress struct {
 	// The city of the address.
@@ -773,6 +985,14 @@ func (r *EntityTrustGrantorAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustGrantorAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustGrantorAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // A means of verifying the person's identity.
 type EntityTrustGrantorIdentification struct {
 	// A method that can be used to verify the individual's identity.
@@ -796,6 +1016,14 @@ func (r *EntityTrustGrantorIdentification) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustGrantorIdentification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustGrantorIdentificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // A method that can be used to verify the individual's identity.
 type EntityTrustGrantorIdentificationMethod string
 
@@ -812,6 +1040,14 @@ const (
 	EntityTrustGrantorIdentificationMethodOther EntityTrustGrantorIdentificationMethod = "other"
 )
 
+func (r EntityTrustGrantorIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityTrustGrantorIdentificationMethodSocialSecurityNumber, EntityTrustGrantorIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityTrustGrantorIdentificationMethodPassport, EntityTrustGrantorIdentificationMethodDriversLicense, EntityTrustGrantorIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 type EntityTrustTrustee struct {
 	// The individual trustee of the trust. Will be present if the trustee's
 	// `structure` is equal to `individual`.
@@ -834,6 +1070,14 @@ func (r *EntityTrustTrustee) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustTrustee) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustTrusteeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The individual trustee of the trust. Will be present if the trustee's
 // `structure` is equal to `individual`.
 type EntityTrustTrusteesIndividual struct {
@@ -863,6 +1107,14 @@ func (r *EntityTrustTrusteesIndividual) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustTrusteesIndividual) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustTrusteesIndividualJSON) RawJSON() string {
+	return r.raw
+}
+
 // The person's address.
 type EntityTrustTrusteesIndividualAddress struct {
 	// The city of the address.
@@ -895,6 +1147,14 @@ func (r *EntityTrustTrusteesIndividualAddress) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustTrusteesIndividualAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustTrusteesIndividualAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // A means of verifying the person's identity.
 type EntityTrustTrusteesIndividualIdentification struct {
 	// A method that can be used to verify the individual's identity.
@@ -918,6 +1178,14 @@ func (r *EntityTrustTrusteesIndividualIdentification) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EntityTrustTrusteesIndividualIdentification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r entityTrustTrusteesIndividualIdentificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // A method that can be used to verify the individual's identity.
 type EntityTrustTrusteesIndividualIdentificationMethod string
 
@@ -934,6 +1202,14 @@ const (
 	EntityTrustTrusteesIndividualIdentificationMethodOther EntityTrustTrusteesIndividualIdentificationMethod = "other"
 )
 
+func (r EntityTrustTrusteesIndividualIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityTrustTrusteesIndividualIdentificationMethodSocialSecurityNumber, EntityTrustTrusteesIndividualIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityTrustTrusteesIndividualIdentificationMethodPassport, EntityTrustTrusteesIndividualIdentificationMethodDriversLicense, EntityTrustTrusteesIndividualIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // The structure of the trustee. Will always be equal to `individual`.
 type EntityTrustTrusteesStructure string
 
@@ -942,6 +1218,14 @@ const (
 	EntityTrustTrusteesStructureIndividual EntityTrustTrusteesStructure = "individual"
 )
 
+func (r EntityTrustTrusteesStructure) IsKnown() bool {
+	switch r {
+	case EntityTrustTrusteesStructureIndividual:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `entity`.
 type EntityType string
@@ -950,6 +1234,14 @@ const (
 	EntityTypeEntity EntityType = "entity"
 )
 
+func (r EntityType) IsKnown() bool {
+	switch r {
+	case EntityTypeEntity:
+		return true
+	}
+	return false
+}
+
 type EntityNewParams struct {
 	// The type of Entity to create.
 	Structure param.Field[EntityNewParamsStructure] `json:"structure,required"`
@@ -993,6 +1285,14 @@ const (
 	EntityNewParamsStructureTrust EntityNewParamsStructure = "trust"
 )
 
+func (r EntityNewParamsStructure) IsKnown() bool {
+	switch r {
+	case EntityNewParamsStructureCorporation, EntityNewParamsStructureNaturalPerson, EntityNewParamsStructureJoint, EntityNewParamsStructureTrust:
+		return true
+	}
+	return false
+}
+
 // Details of the corporation entity to create. Required if `structure` is equal to
 // `corporation`.
 type EntityNewParamsCorporation struct {
@@ -1128,6 +1428,14 @@ const (
 	EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodOther EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethod = "other"
 )
 
+func (r EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodSocialSecurityNumber, EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodPassport, EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodDriversLicense, EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityNewParamsCorporationBeneficialOwnersIndividualIdentificationDriversLicense struct {
@@ -1190,6 +1498,14 @@ const (
 	EntityNewParamsCorporationBeneficialOwnersProngControl EntityNewParamsCorporationBeneficialOwnersProng = "control"
 )
 
+func (r EntityNewParamsCorporationBeneficialOwnersProng) IsKnown() bool {
+	switch r {
+	case EntityNewParamsCorporationBeneficialOwnersProngOwnership, EntityNewParamsCorporationBeneficialOwnersProngControl:
+		return true
+	}
+	return false
+}
+
 // Details of the joint entity to create. Required if `structure` is equal to
 // `joint`.
 type EntityNewParamsJoint struct {
@@ -1280,6 +1596,14 @@ const (
 	EntityNewParamsJointIndividualsIdentificationMethodOther EntityNewParamsJointIndividualsIdentificationMethod = "other"
 )
 
+func (r EntityNewParamsJointIndividualsIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNewParamsJointIndividualsIdentificationMethodSocialSecurityNumber, EntityNewParamsJointIndividualsIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNewParamsJointIndividualsIdentificationMethodPassport, EntityNewParamsJointIndividualsIdentificationMethodDriversLicense, EntityNewParamsJointIndividualsIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityNewParamsJointIndividualsIdentificationDriversLicense struct {
@@ -1414,6 +1738,14 @@ const (
 	EntityNewParamsNaturalPersonIdentificationMethodOther EntityNewParamsNaturalPersonIdentificationMethod = "other"
 )
 
+func (r EntityNewParamsNaturalPersonIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNewParamsNaturalPersonIdentificationMethodSocialSecurityNumber, EntityNewParamsNaturalPersonIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNewParamsNaturalPersonIdentificationMethodPassport, EntityNewParamsNaturalPersonIdentificationMethodDriversLicense, EntityNewParamsNaturalPersonIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityNewParamsNaturalPersonIdentificationDriversLicense struct {
@@ -1480,6 +1812,14 @@ const (
 	EntityNewParamsRelationshipUnaffiliated EntityNewParamsRelationship = "unaffiliated"
 )
 
+func (r EntityNewParamsRelationship) IsKnown() bool {
+	switch r {
+	case EntityNewParamsRelationshipAffiliated, EntityNewParamsRelationshipInformational, EntityNewParamsRelationshipUnaffiliated:
+		return true
+	}
+	return false
+}
+
 type EntityNewParamsSupplementalDocument struct {
 	// The identifier of the File containing the document.
 	FileID param.Field[string] `json:"file_id,required"`
@@ -1549,6 +1889,14 @@ const (
 	EntityNewParamsTrustCategoryIrrevocable EntityNewParamsTrustCategory = "irrevocable"
 )
 
+func (r EntityNewParamsTrustCategory) IsKnown() bool {
+	switch r {
+	case EntityNewParamsTrustCategoryRevocable, EntityNewParamsTrustCategoryIrrevocable:
+		return true
+	}
+	return false
+}
+
 type EntityNewParamsTrustTrustee struct {
 	// The structure of the trustee.
 	Structure param.Field[EntityNewParamsTrustTrusteesStructure] `json:"structure,required"`
@@ -1569,6 +1917,14 @@ const (
 	EntityNewParamsTrustTrusteesStructureIndividual EntityNewParamsTrustTrusteesStructure = "individual"
 )
 
+func (r EntityNewParamsTrustTrusteesStructure) IsKnown() bool {
+	switch r {
+	case EntityNewParamsTrustTrusteesStructureIndividual:
+		return true
+	}
+	return false
+}
+
 // Details of the individual trustee. Required when the trustee `structure` is
 // equal to `individual`.
 type EntityNewParamsTrustTrusteesIndividual struct {
@@ -1648,6 +2004,14 @@ const (
 	EntityNewParamsTrustTrusteesIndividualIdentificationMethodOther EntityNewParamsTrustTrusteesIndividualIdentificationMethod = "other"
 )
 
+func (r EntityNewParamsTrustTrusteesIndividualIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNewParamsTrustTrusteesIndividualIdentificationMethodSocialSecurityNumber, EntityNewParamsTrustTrusteesIndividualIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNewParamsTrustTrusteesIndividualIdentificationMethodPassport, EntityNewParamsTrustTrusteesIndividualIdentificationMethodDriversLicense, EntityNewParamsTrustTrusteesIndividualIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityNewParamsTrustTrusteesIndividualIdentificationDriversLicense struct {
@@ -1779,6 +2143,14 @@ const (
 	EntityNewParamsTrustGrantorIdentificationMethodOther EntityNewParamsTrustGrantorIdentificationMethod = "other"
 )
 
+func (r EntityNewParamsTrustGrantorIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityNewParamsTrustGrantorIdentificationMethodSocialSecurityNumber, EntityNewParamsTrustGrantorIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityNewParamsTrustGrantorIdentificationMethodPassport, EntityNewParamsTrustGrantorIdentificationMethodDriversLicense, EntityNewParamsTrustGrantorIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityNewParamsTrustGrantorIdentificationDriversLicense struct {
@@ -1900,6 +2272,14 @@ const (
 	EntityListParamsStatusInDisabled EntityListParamsStatusIn = "disabled"
 )
 
+func (r EntityListParamsStatusIn) IsKnown() bool {
+	switch r {
+	case EntityListParamsStatusInActive, EntityListParamsStatusInArchived, EntityListParamsStatusInDisabled:
+		return true
+	}
+	return false
+}
+
 type EntityUpdateAddressParams struct {
 	// The entity's physical address. Post Office Boxes are disallowed.
 	Address param.Field[EntityUpdateAddressParamsAddress] `json:"address,required"`
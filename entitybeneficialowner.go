@@ -34,6 +34,7 @@ func NewEntityBeneficialOwnerService(opts ...option.RequestOption) (r *EntityBen
 // Create a beneficial owner for a corporate Entity
 func (r *EntityBeneficialOwnerService) New(ctx context.Context, body EntityBeneficialOwnerNewParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.BeneficialOwners.New")}, opts...)
 	path := "entity_beneficial_owners"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -42,6 +43,7 @@ func (r *EntityBeneficialOwnerService) New(ctx context.Context, body EntityBenef
 // Archive a beneficial owner for a corporate Entity
 func (r *EntityBeneficialOwnerService) Archive(ctx context.Context, body EntityBeneficialOwnerArchiveParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.BeneficialOwners.Archive")}, opts...)
 	path := "entity_beneficial_owners/archive"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -50,6 +52,7 @@ func (r *EntityBeneficialOwnerService) Archive(ctx context.Context, body EntityB
 // Update the address for a beneficial owner belonging to a corporate Entity
 func (r *EntityBeneficialOwnerService) UpdateAddress(ctx context.Context, body EntityBeneficialOwnerUpdateAddressParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.BeneficialOwners.UpdateAddress")}, opts...)
 	path := "entity_beneficial_owners/address"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -162,6 +165,14 @@ const (
 	EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodOther EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethod = "other"
 )
 
+func (r EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethod) IsKnown() bool {
+	switch r {
+	case EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodSocialSecurityNumber, EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodIndividualTaxpayerIdentificationNumber, EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodPassport, EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodDriversLicense, EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationMethodOther:
+		return true
+	}
+	return false
+}
+
 // Information about the United States driver's license used for identification.
 // Required if `method` is equal to `drivers_license`.
 type EntityBeneficialOwnerNewParamsBeneficialOwnerIndividualIdentificationDriversLicense struct {
@@ -224,6 +235,14 @@ const (
 	EntityBeneficialOwnerNewParamsBeneficialOwnerProngControl EntityBeneficialOwnerNewParamsBeneficialOwnerProng = "control"
 )
 
+func (r EntityBeneficialOwnerNewParamsBeneficialOwnerProng) IsKnown() bool {
+	switch r {
+	case EntityBeneficialOwnerNewParamsBeneficialOwnerProngOwnership, EntityBeneficialOwnerNewParamsBeneficialOwnerProngControl:
+		return true
+	}
+	return false
+}
+
 type EntityBeneficialOwnerArchiveParams struct {
 	// The identifying details of anyone controlling or owning 25% or more of the
 	// corporation.
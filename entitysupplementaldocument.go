@@ -38,6 +38,7 @@ func NewEntitySupplementalDocumentService(opts ...option.RequestOption) (r *Enti
 // Create a supplemental document for an Entity
 func (r *EntitySupplementalDocumentService) New(ctx context.Context, entityID string, body EntitySupplementalDocumentNewParams, opts ...option.RequestOption) (res *Entity, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Entities.SupplementalDocuments.New")}, opts...)
 	path := fmt.Sprintf("entities/%s/supplemental_documents", entityID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *EntitySupplementalDocumentService) New(ctx context.Context, entityID st
 func (r *EntitySupplementalDocumentService) List(ctx context.Context, query EntitySupplementalDocumentListParams, opts ...option.RequestOption) (res *shared.Page[SupplementalDocument], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Entities.SupplementalDocuments.List")}, opts...)
 	path := "entity_supplemental_documents"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -94,6 +95,14 @@ func (r *SupplementalDocument) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r SupplementalDocument) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r supplementalDocumentJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `entity_supplemental_document`.
 type SupplementalDocumentType string
@@ -102,6 +111,14 @@ const (
 	SupplementalDocumentTypeEntitySupplementalDocument SupplementalDocumentType = "entity_supplemental_document"
 )
 
+func (r SupplementalDocumentType) IsKnown() bool {
+	switch r {
+	case SupplementalDocumentTypeEntitySupplementalDocument:
+		return true
+	}
+	return false
+}
+
 type EntitySupplementalDocumentNewParams struct {
 	// The identifier of the File containing the document.
 	FileID param.Field[string] `json:"file_id,required"`
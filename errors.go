@@ -0,0 +1,101 @@
+package increase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/increase/increase-go/internal/apijson"
+	"github.com/increase/increase-go/internal/circuitbreaker"
+)
+
+// DecodeError is returned by requests made with [option.WithStrictDecoding]
+// when a response does not match its schema. Its Issues list each problem with
+// the JSON path of the field.
+type DecodeError = apijson.DecodeError
+
+// DecodeIssue is a single problem listed in a [DecodeError].
+type DecodeIssue = apijson.DecodeIssue
+
+// DecodeIssueKind is the kind of a [DecodeIssue].
+type DecodeIssueKind = apijson.DecodeIssueKind
+
+const DecodeIssueMissingRequired = apijson.DecodeIssueMissingRequired
+const DecodeIssueInvalid = apijson.DecodeIssueInvalid
+const DecodeIssueUnknownEnum = apijson.DecodeIssueUnknownEnum
+
+// ErrCircuitOpen is returned instead of sending a request while the circuit
+// breaker configured with [option.WithCircuitBreaker] is open.
+var ErrCircuitOpen = circuitbreaker.ErrCircuitOpen
+
+// ErrExportFailed is wrapped by the error returned by
+// [ExportService.NewAndWait] when the export fails.
+var ErrExportFailed = errors.New("increase: export failed")
+
+// ErrUnknownAssociatedObjectType is wrapped by the error returned by
+// [EventService.GetAssociatedObject] for an Event about a type of object which
+// this SDK cannot retrieve.
+var ErrUnknownAssociatedObjectType = errors.New("increase: unknown associated object type")
+
+// IsNotFound reports whether err is an API error for an object or API method
+// that does not exist.
+func IsNotFound(err error) bool {
+	var apierr *Error
+	if !errors.As(err, &apierr) {
+		return false
+	}
+	return apierr.Type == ErrorTypeObjectNotFoundError ||
+		apierr.Type == ErrorTypeAPIMethodNotFoundError ||
+		apierr.Type == "" && apierr.StatusCode == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an API error for a rate limited request.
+// The error's RetryAfter says how many seconds to wait before trying again.
+func IsRateLimited(err error) bool {
+	var apierr *Error
+	if !errors.As(err, &apierr) {
+		return false
+	}
+	return apierr.Type == ErrorTypeRateLimitedError ||
+		apierr.Type == "" && apierr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsInvalidParameters reports whether err is an API error for request
+// parameters that could not be parsed. The error's Errors describe each invalid
+// parameter; see also [InvalidParameters].
+func IsInvalidParameters(err error) bool {
+	return errors.Is(err, ErrInvalidParameters)
+}
+
+// IsIdempotencyConflict reports whether err is an API error for a request whose
+// idempotency key was already used by another request. The error's ResourceID,
+// if any, is the ID of the object the other request created.
+func IsIdempotencyConflict(err error) bool {
+	return errors.Is(err, ErrIdempotencyKeyAlreadyUsed) || errors.Is(err, ErrIdempotencyUnprocessable)
+}
+
+// IsUniqueIdentifierAlreadyExists reports whether err is an API error for a
+// create request whose unique identifier was already used. The error's
+// ResourceID is the ID of the existing object.
+func IsUniqueIdentifierAlreadyExists(err error) bool {
+	return errors.Is(err, ErrUniqueIdentifierAlreadyExists)
+}
+
+// InvalidParameters returns the per-parameter errors of an invalid_parameters
+// API error, or nil if err is not one.
+func InvalidParameters(err error) []FieldError {
+	var apierr *Error
+	if !errors.As(err, &apierr) || apierr.Type != ErrorTypeInvalidParametersError {
+		return nil
+	}
+	return apierr.Errors
+}
+
+// existingResourceID returns the ID of the existing object if err is an API
+// error for a unique identifier that was already used.
+func existingResourceID(err error) (string, bool) {
+	var apierr *Error
+	if !errors.As(err, &apierr) || apierr.Type != ErrorTypeUniqueIdentifierAlreadyExistsError || apierr.ResourceID == "" {
+		return "", false
+	}
+	return apierr.ResourceID, true
+}
@@ -0,0 +1,150 @@
+package increase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+func TestErrorHelpers(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+		check  func(error) bool
+		target error
+	}{
+		"not found": {
+			status: 404,
+			body:   `{"type":"object_not_found_error","status":404,"title":"Not found","detail":null}`,
+			check:  increase.IsNotFound,
+			target: increase.ErrObjectNotFound,
+		},
+		"rate limited": {
+			status: 429,
+			body:   `{"type":"rate_limited_error","status":429,"title":"Rate limited","detail":null,"retry_after":1}`,
+			check:  increase.IsRateLimited,
+			target: increase.ErrRateLimited,
+		},
+		"invalid parameters": {
+			status: 400,
+			body:   `{"type":"invalid_parameters_error","status":400,"title":"Invalid","detail":null,"errors":[]}`,
+			check:  increase.IsInvalidParameters,
+			target: increase.ErrInvalidParameters,
+		},
+		"idempotency conflict": {
+			status: 409,
+			body:   `{"type":"idempotency_key_already_used_error","status":409,"title":"Conflict","detail":null,"resource_id":"check_transfer_123"}`,
+			check:  increase.IsIdempotencyConflict,
+			target: increase.ErrIdempotencyKeyAlreadyUsed,
+		},
+		"unique identifier": {
+			status: 409,
+			body:   `{"type":"unique_identifier_already_exists_error","status":409,"title":"Exists","detail":null,"resource_id":"check_transfer_123"}`,
+			check:  increase.IsUniqueIdentifierAlreadyExists,
+			target: increase.ErrUniqueIdentifierAlreadyExists,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := increase.NewClient(
+				option.WithBaseURL("http://localhost:4010"),
+				option.WithAPIKey("My API Key"),
+				option.WithHTTPClient(&http.Client{Transport: &recordingTransport{statuses: []int{c.status}, body: c.body}}),
+				option.WithMaxRetries(0),
+			)
+			_, err := client.Accounts.Get(context.Background(), "account_in71c4amph0vgo2qllky")
+			if !c.check(err) {
+				t.Errorf("expected helper to match %v", err)
+			}
+			if !errors.Is(err, c.target) {
+				t.Errorf("expected errors.Is(err, %v) for %v", c.target, err)
+			}
+			if errors.Is(err, increase.ErrInternalServer) {
+				t.Errorf("expected errors.Is(err, ErrInternalServer) to be false for %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidParameters(t *testing.T) {
+	body := `{
+		"type": "invalid_parameters_error",
+		"status": 400,
+		"title": "Invalid parameters",
+		"detail": null,
+		"errors": [
+			{"field": "name", "message": "must be present"},
+			{"field": "program_id", "message": "is not a valid ID"}
+		]
+	}`
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: &recordingTransport{statuses: []int{400}, body: body}}),
+	)
+	_, err := client.Accounts.New(context.Background(), increase.AccountNewParams{})
+	fieldErrs := increase.InvalidParameters(err)
+	if len(fieldErrs) != 2 {
+		t.Fatalf("expected 2 field errors, got %d from %v", len(fieldErrs), err)
+	}
+	if fieldErrs[0].Field != "name" || fieldErrs[0].Message != "must be present" {
+		t.Errorf("unexpected first field error %+v", fieldErrs[0])
+	}
+	if err.Error() != err.Error() {
+		t.Errorf("expected Error() to be stable across calls")
+	}
+}
+
+// funcTransport responds to each request with the given function.
+type funcTransport func(req *http.Request) (*http.Response, error)
+
+func (f funcTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func jsonResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestNewOrGet(t *testing.T) {
+	var paths []string
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.Method+" "+req.URL.Path)
+		if req.Method == http.MethodPost {
+			return jsonResponse(req, 409, `{"type":"unique_identifier_already_exists_error","status":409,"title":"Exists","detail":null,"resource_id":"check_transfer_30b43acfu9vw8fyc4f5"}`), nil
+		}
+		return jsonResponse(req, 200, `{"id":"check_transfer_30b43acfu9vw8fyc4f5","type":"check_transfer"}`), nil
+	})
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithMaxRetries(0),
+	)
+	res, existed, err := client.CheckTransfers.NewOrGet(context.Background(), increase.CheckTransferNewParams{
+		AccountID:        increase.F("account_in71c4amph0vgo2qllky"),
+		Amount:           increase.F(int64(1000)),
+		UniqueIdentifier: increase.F("payout-42"),
+	})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if !existed || res.ID != "check_transfer_30b43acfu9vw8fyc4f5" {
+		t.Errorf("expected the existing check transfer, got existed=%v id=%q", existed, res.ID)
+	}
+	expected := []string{"POST /check_transfers", "GET /check_transfers/check_transfer_30b43acfu9vw8fyc4f5"}
+	if strings.Join(paths, ", ") != strings.Join(expected, ", ") {
+		t.Errorf("expected requests %v, got %v", expected, paths)
+	}
+}
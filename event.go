@@ -37,6 +37,7 @@ func NewEventService(opts ...option.RequestOption) (r *EventService) {
 // Retrieve an Event
 func (r *EventService) Get(ctx context.Context, eventID string, opts ...option.RequestOption) (res *Event, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Events.Get")}, opts...)
 	path := fmt.Sprintf("events/%s", eventID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -46,7 +47,7 @@ func (r *EventService) Get(ctx context.Context, eventID string, opts ...option.R
 func (r *EventService) List(ctx context.Context, query EventListParams, opts ...option.RequestOption) (res *shared.Page[Event], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Events.List")}, opts...)
 	path := "events"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -103,6 +104,14 @@ func (r *Event) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Event) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r eventJSON) RawJSON() string {
+	return r.raw
+}
+
 // The category of the Event. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type EventCategory string
@@ -250,6 +259,14 @@ const (
 	EventCategoryWireTransferUpdated EventCategory = "wire_transfer.updated"
 )
 
+func (r EventCategory) IsKnown() bool {
+	switch r {
+	case EventCategoryAccountCreated, EventCategoryAccountUpdated, EventCategoryAccountNumberCreated, EventCategoryAccountNumberUpdated, EventCategoryAccountStatementCreated, EventCategoryAccountTransferCreated, EventCategoryAccountTransferUpdated, EventCategoryACHPrenotificationCreated, EventCategoryACHPrenotificationUpdated, EventCategoryACHTransferCreated, EventCategoryACHTransferUpdated, EventCategoryBookkeepingAccountCreated, EventCategoryBookkeepingAccountUpdated, EventCategoryBookkeepingEntrySetUpdated, EventCategoryCardCreated, EventCategoryCardUpdated, EventCategoryCardPaymentCreated, EventCategoryCardPaymentUpdated, EventCategoryCardProfileCreated, EventCategoryCardProfileUpdated, EventCategoryCardDisputeCreated, EventCategoryCardDisputeUpdated, EventCategoryCheckDepositCreated, EventCategoryCheckDepositUpdated, EventCategoryCheckTransferCreated, EventCategoryCheckTransferUpdated, EventCategoryDeclinedTransactionCreated, EventCategoryDigitalWalletTokenCreated, EventCategoryDigitalWalletTokenUpdated, EventCategoryDocumentCreated, EventCategoryEntityCreated, EventCategoryEntityUpdated, EventCategoryEventSubscriptionCreated, EventCategoryEventSubscriptionUpdated, EventCategoryExportCreated, EventCategoryExportUpdated, EventCategoryExternalAccountCreated, EventCategoryExternalAccountUpdated, EventCategoryFileCreated, EventCategoryGroupUpdated, EventCategoryGroupHeartbeat, EventCategoryInboundACHTransferCreated, EventCategoryInboundACHTransferUpdated, EventCategoryInboundACHTransferReturnCreated, EventCategoryInboundACHTransferReturnUpdated, EventCategoryInboundWireDrawdownRequestCreated, EventCategoryIntrafiAccountEnrollmentCreated, EventCategoryIntrafiAccountEnrollmentUpdated, EventCategoryIntrafiExclusionCreated, EventCategoryIntrafiExclusionUpdated, EventCategoryOauthConnectionCreated, EventCategoryOauthConnectionDeactivated, EventCategoryPendingTransactionCreated, EventCategoryPendingTransactionUpdated, EventCategoryPhysicalCardCreated, EventCategoryPhysicalCardUpdated, EventCategoryRealTimeDecisionCardAuthorizationRequested, EventCategoryRealTimeDecisionDigitalWalletTokenRequested, EventCategoryRealTimeDecisionDigitalWalletAuthenticationRequested, EventCategoryRealTimePaymentsTransferCreated, EventCategoryRealTimePaymentsTransferUpdated, EventCategoryRealTimePaymentsRequestForPaymentCreated, EventCategoryRealTimePaymentsRequestForPaymentUpdated, EventCategoryTransactionCreated, EventCategoryWireDrawdownRequestCreated, EventCategoryWireDrawdownRequestUpdated, EventCategoryWireTransferCreated, EventCategoryWireTransferUpdated:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `event`.
 type EventType string
@@ -258,6 +275,14 @@ const (
 	EventTypeEvent EventType = "event"
 )
 
+func (r EventType) IsKnown() bool {
+	switch r {
+	case EventTypeEvent:
+		return true
+	}
+	return false
+}
+
 type EventListParams struct {
 	// Filter Events to those belonging to the object with the provided identifier.
 	AssociatedObjectID param.Field[string]                   `query:"associated_object_id"`
@@ -439,6 +464,14 @@ const (
 	EventListParamsCategoryInWireTransferUpdated EventListParamsCategoryIn = "wire_transfer.updated"
 )
 
+func (r EventListParamsCategoryIn) IsKnown() bool {
+	switch r {
+	case EventListParamsCategoryInAccountCreated, EventListParamsCategoryInAccountUpdated, EventListParamsCategoryInAccountNumberCreated, EventListParamsCategoryInAccountNumberUpdated, EventListParamsCategoryInAccountStatementCreated, EventListParamsCategoryInAccountTransferCreated, EventListParamsCategoryInAccountTransferUpdated, EventListParamsCategoryInACHPrenotificationCreated, EventListParamsCategoryInACHPrenotificationUpdated, EventListParamsCategoryInACHTransferCreated, EventListParamsCategoryInACHTransferUpdated, EventListParamsCategoryInBookkeepingAccountCreated, EventListParamsCategoryInBookkeepingAccountUpdated, EventListParamsCategoryInBookkeepingEntrySetUpdated, EventListParamsCategoryInCardCreated, EventListParamsCategoryInCardUpdated, EventListParamsCategoryInCardPaymentCreated, EventListParamsCategoryInCardPaymentUpdated, EventListParamsCategoryInCardProfileCreated, EventListParamsCategoryInCardProfileUpdated, EventListParamsCategoryInCardDisputeCreated, EventListParamsCategoryInCardDisputeUpdated, EventListParamsCategoryInCheckDepositCreated, EventListParamsCategoryInCheckDepositUpdated, EventListParamsCategoryInCheckTransferCreated, EventListParamsCategoryInCheckTransferUpdated, EventListParamsCategoryInDeclinedTransactionCreated, EventListParamsCategoryInDigitalWalletTokenCreated, EventListParamsCategoryInDigitalWalletTokenUpdated, EventListParamsCategoryInDocumentCreated, EventListParamsCategoryInEntityCreated, EventListParamsCategoryInEntityUpdated, EventListParamsCategoryInEventSubscriptionCreated, EventListParamsCategoryInEventSubscriptionUpdated, EventListParamsCategoryInExportCreated, EventListParamsCategoryInExportUpdated, EventListParamsCategoryInExternalAccountCreated, EventListParamsCategoryInExternalAccountUpdated, EventListParamsCategoryInFileCreated, EventListParamsCategoryInGroupUpdated, EventListParamsCategoryInGroupHeartbeat, EventListParamsCategoryInInboundACHTransferCreated, EventListParamsCategoryInInboundACHTransferUpdated, EventListParamsCategoryInInboundACHTransferReturnCreated, EventListParamsCategoryInInboundACHTransferReturnUpdated, EventListParamsCategoryInInboundWireDrawdownRequestCreated, EventListParamsCategoryInIntrafiAccountEnrollmentCreated, EventListParamsCategoryInIntrafiAccountEnrollmentUpdated, EventListParamsCategoryInIntrafiExclusionCreated, EventListParamsCategoryInIntrafiExclusionUpdated, EventListParamsCategoryInOauthConnectionCreated, EventListParamsCategoryInOauthConnectionDeactivated, EventListParamsCategoryInPendingTransactionCreated, EventListParamsCategoryInPendingTransactionUpdated, EventListParamsCategoryInPhysicalCardCreated, EventListParamsCategoryInPhysicalCardUpdated, EventListParamsCategoryInRealTimeDecisionCardAuthorizationRequested, EventListParamsCategoryInRealTimeDecisionDigitalWalletTokenRequested, EventListParamsCategoryInRealTimeDecisionDigitalWalletAuthenticationRequested, EventListParamsCategoryInRealTimePaymentsTransferCreated, EventListParamsCategoryInRealTimePaymentsTransferUpdated, EventListParamsCategoryInRealTimePaymentsRequestForPaymentCreated, EventListParamsCategoryInRealTimePaymentsRequestForPaymentUpdated, EventListParamsCategoryInTransactionCreated, EventListParamsCategoryInWireDrawdownRequestCreated, EventListParamsCategoryInWireDrawdownRequestUpdated, EventListParamsCategoryInWireTransferCreated, EventListParamsCategoryInWireTransferUpdated:
+		return true
+	}
+	return false
+}
+
 type EventListParamsCreatedAt struct {
 	// Return results after this [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601)
 	// timestamp.
@@ -0,0 +1,333 @@
+package increase
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/increase/increase-go/internal/param"
+	"github.com/increase/increase-go/option"
+)
+
+// eventConsumerMinWindow is the shortest range of creation times an
+// EventConsumer lists at once. Times in List Events queries are whole seconds.
+const eventConsumerMinWindow = time.Second
+
+// eventConsumerMaxBackoff is the most times PollInterval that Run waits after
+// polls which fail one after another.
+const eventConsumerMaxBackoff = 16
+
+// EventConsumerConfig configures an [EventConsumer].
+type EventConsumerConfig struct {
+	// Handler is called for each Event. If it returns an error, the Event is
+	// passed to it again by a later poll.
+	Handler func(ctx context.Context, event *Event) error
+	// Checkpoints saves the consumer's progress. By default it is kept in a
+	// [MemoryCheckpointStore].
+	Checkpoints CheckpointStore
+	// Query filters the Events which are consumed, such as by Category. Its
+	// CreatedAt and Cursor are set by the consumer.
+	Query EventListParams
+	// Start is the time of the first Event to consume when there is no
+	// Checkpoint. If it is zero, every Event is consumed.
+	Start time.Time
+	// Concurrency is the number of Events which may be handled at once, which
+	// is one by default. Events with the same AssociatedObjectID are always
+	// handled one at a time, in the order they were created.
+	Concurrency int
+	// PollInterval is how long Run waits between polls which find no Events,
+	// which is ten seconds by default. After a poll fails, Run waits twice as
+	// long for each failure in a row, up to sixteen times as long.
+	PollInterval time.Duration
+	// Logger receives a warning each time Run fails to poll for Events. By
+	// default [slog.Default] is used.
+	Logger *slog.Logger
+}
+
+// EventConsumer passes Events to a handler as they are created, by polling
+// [EventService.List], for applications which cannot receive webhooks.
+//
+// Each Event is passed to the handler at least once: the consumer's progress is
+// saved to its CheckpointStore after each page of Events, once the handler has
+// returned, so an Event may be handled again if the process stops before its
+// progress is saved. Events are listed a page at a time by their creation
+// time, from the time of the oldest Event which has not been handled. If more
+// than a page of Events were created in the same second, those Events are
+// handled a page at a time, newest page first.
+type EventConsumer struct {
+	events *EventService
+	config EventConsumerConfig
+	opts   []option.RequestOption
+}
+
+// NewConsumer returns an EventConsumer which lists Events with this service and
+// the given options.
+func (r *EventService) NewConsumer(config EventConsumerConfig, opts ...option.RequestOption) *EventConsumer {
+	if config.Checkpoints == nil {
+		config.Checkpoints = &MemoryCheckpointStore{}
+	}
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 10 * time.Second
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+	return &EventConsumer{events: r, config: config, opts: opts}
+}
+
+// checkpointError is an error from an EventConsumer's CheckpointStore, which
+// stops Run.
+type checkpointError struct {
+	err error
+}
+
+func (e *checkpointError) Error() string {
+	return "increase: event consumer checkpoint: " + e.err.Error()
+}
+
+func (e *checkpointError) Unwrap() error {
+	return e.err
+}
+
+// Run polls for Events until ctx is done or the CheckpointStore fails, and
+// returns the error which stopped it. Other errors, including those returned by
+// the handler, are logged, and Run polls again after a delay.
+func (c *EventConsumer) Run(ctx context.Context) error {
+	failures := 0
+	for {
+		handled, err := c.Poll(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var storeErr *checkpointError
+		if errors.As(err, &storeErr) {
+			return storeErr.err
+		}
+		wait := c.config.PollInterval
+		if err != nil {
+			failures++
+			wait *= time.Duration(min(1<<min(failures-1, 30), eventConsumerMaxBackoff))
+			c.config.Logger.WarnContext(ctx, "increase: polling for events failed", "error", err, "failures", failures, "retry_in", wait)
+		} else {
+			failures = 0
+			if handled > 0 {
+				continue
+			}
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Poll lists the Events which have not been handled, a page at a time, and
+// passes them to the handler, saving the consumer's progress after each page.
+// It returns the number of Events handled. If the handler returns an error, no
+// more Events are passed to it, and Poll returns the error once the Events
+// already passed to it have been handled.
+func (c *EventConsumer) Poll(ctx context.Context) (handled int, err error) {
+	checkpoint, err := c.config.Checkpoints.Load(ctx)
+	if err != nil {
+		return 0, &checkpointError{err}
+	}
+	if checkpoint.CreatedAt.IsZero() {
+		checkpoint.CreatedAt = c.config.Start.Truncate(eventConsumerMinWindow)
+	}
+	for {
+		next, n, more, err := c.poll(ctx, checkpoint)
+		handled += n
+		if !sameCheckpoint(next, checkpoint) {
+			if saveErr := c.config.Checkpoints.Save(context.WithoutCancel(ctx), next); saveErr != nil {
+				return handled, errors.Join(err, &checkpointError{saveErr})
+			}
+			checkpoint = next
+		}
+		if err != nil || !more {
+			return handled, err
+		}
+	}
+}
+
+// poll lists a page of the Events after checkpoint and passes those which have
+// not been handled to the handler. It returns the Checkpoint after them, and
+// whether there may be more Events to list.
+func (c *EventConsumer) poll(ctx context.Context, checkpoint Checkpoint) (next Checkpoint, handled int, more bool, err error) {
+	query := c.config.Query
+	query.Cursor = param.Field[string]{}
+	if checkpoint.Cursor != "" {
+		query.Cursor = F(checkpoint.Cursor)
+	}
+	query.CreatedAt = param.Field[EventListParamsCreatedAt]{}
+	if !checkpoint.CreatedAt.IsZero() || !checkpoint.Before.IsZero() {
+		createdAt := EventListParamsCreatedAt{}
+		if !checkpoint.CreatedAt.IsZero() {
+			createdAt.OnOrAfter = F(checkpoint.CreatedAt)
+		}
+		if !checkpoint.Before.IsZero() {
+			createdAt.Before = F(checkpoint.Before)
+		}
+		query.CreatedAt = F(createdAt)
+	}
+	page, err := c.events.List(ctx, query, c.opts...)
+	if err != nil {
+		return checkpoint, 0, false, err
+	}
+	events := page.Data
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].CreatedAt.Before(events[j].CreatedAt)
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	// Pages are listed newest first, so if the Events do not fit on one page,
+	// list the older half of the range of creation times instead, until they
+	// do. The Events of a single second are listed a page at a time.
+	bounded := !checkpoint.Before.IsZero()
+	dense := bounded && checkpoint.Before.Sub(checkpoint.CreatedAt) <= eventConsumerMinWindow
+	if page.NextCursor != "" && !dense && len(events) > 0 {
+		half := (events[0].CreatedAt.Sub(checkpoint.CreatedAt) / 2).Truncate(eventConsumerMinWindow)
+		next = checkpoint
+		next.Before = checkpoint.CreatedAt.Add(max(half, eventConsumerMinWindow))
+		return next, 0, true, nil
+	}
+
+	done := make(map[string]bool, len(checkpoint.Handled))
+	for _, id := range checkpoint.Handled {
+		done[id] = true
+	}
+	var pending []*Event
+	for i := range events {
+		if !done[events[i].ID] {
+			pending = append(pending, &events[i])
+		}
+	}
+	succeeded, err := c.handle(ctx, pending)
+	for _, event := range succeeded {
+		done[event.ID] = true
+	}
+	next = advance(checkpoint, events, done, page.NextCursor, dense)
+	return next, len(succeeded), err == nil && bounded, err
+}
+
+// handle passes events to the handler, on up to Concurrency goroutines. Events
+// with the same AssociatedObjectID are handled by the same goroutine, in order.
+// It returns the Events which were handled successfully.
+func (c *EventConsumer) handle(ctx context.Context, events []*Event) (succeeded []*Event, err error) {
+	queues := make([][]*Event, c.config.Concurrency)
+	for _, event := range events {
+		h := fnv.New32a()
+		h.Write([]byte(event.AssociatedObjectID))
+		i := int(h.Sum32() % uint32(len(queues)))
+		queues[i] = append(queues[i], event)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, queue := range queues {
+		if len(queue) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(queue []*Event) {
+			defer wg.Done()
+			for _, event := range queue {
+				mu.Lock()
+				stopped := err != nil
+				mu.Unlock()
+				if stopped {
+					return
+				}
+				handlerErr := ctx.Err()
+				if handlerErr == nil {
+					handlerErr = c.config.Handler(ctx, event)
+				}
+				mu.Lock()
+				if handlerErr != nil {
+					if err == nil {
+						err = handlerErr
+					}
+				} else {
+					succeeded = append(succeeded, event)
+				}
+				mu.Unlock()
+			}
+		}(queue)
+	}
+	wg.Wait()
+	return succeeded, err
+}
+
+// advance returns the Checkpoint after handling a page of Events, given the
+// sorted Events on the page, the IDs of the Events which have been handled,
+// and the cursor of the next page.
+func advance(previous Checkpoint, events []Event, done map[string]bool, nextCursor string, dense bool) Checkpoint {
+	next := Checkpoint{CreatedAt: previous.CreatedAt, Before: previous.Before, Cursor: previous.Cursor}
+	var unhandled *Event
+	for i := range events {
+		if !done[events[i].ID] {
+			unhandled = &events[i]
+			break
+		}
+	}
+	switch {
+	case unhandled != nil:
+		if !dense {
+			next.CreatedAt = unhandled.CreatedAt.Truncate(eventConsumerMinWindow)
+		}
+	case dense && nextCursor != "":
+		next.Cursor = nextCursor
+	case !previous.Before.IsZero():
+		// Every Event in the range has been handled. List the next range,
+		// twice as long, unless it ends within the last minute, whose Events
+		// may not all be listed yet.
+		next.CreatedAt = previous.Before
+		next.Before = time.Time{}
+		next.Cursor = ""
+		length := previous.Before.Sub(previous.CreatedAt)
+		if length <= math.MaxInt64/2 {
+			length *= 2
+		}
+		if end := previous.Before.Add(length); end.Before(time.Now().Add(-time.Minute)) {
+			next.Before = end
+		}
+	case len(events) > 0:
+		next.CreatedAt = events[len(events)-1].CreatedAt.Truncate(eventConsumerMinWindow)
+	}
+
+	// Only the Events created at or after the new CreatedAt need to be
+	// remembered. Events which are not on the page were created after the
+	// range, if it is bounded, and otherwise no longer match the query.
+	onPage := make(map[string]bool, len(events))
+	for _, event := range events {
+		onPage[event.ID] = true
+		if done[event.ID] && !event.CreatedAt.Before(next.CreatedAt) && next.Cursor == previous.Cursor {
+			next.Handled = append(next.Handled, event.ID)
+		}
+	}
+	if !previous.Before.IsZero() {
+		for _, id := range previous.Handled {
+			if !onPage[id] {
+				next.Handled = append(next.Handled, id)
+			}
+		}
+	}
+	return next
+}
+
+func sameCheckpoint(a Checkpoint, b Checkpoint) bool {
+	return a.CreatedAt.Equal(b.CreatedAt) && a.Before.Equal(b.Before) && a.Cursor == b.Cursor && slices.Equal(a.Handled, b.Handled)
+}
@@ -0,0 +1,295 @@
+package increase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+// eventServer serves a list of Events, newest first, two to a page.
+type eventServer struct {
+	mu       sync.Mutex
+	events   []map[string]any
+	requests []url.Values
+}
+
+func (s *eventServer) add(id string, objectID string, createdAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, map[string]any{
+		"id":                     id,
+		"associated_object_id":   objectID,
+		"associated_object_type": "account",
+		"category":               "account.updated",
+		"created_at":             createdAt.Format(time.RFC3339),
+		"type":                   "event",
+	})
+}
+
+func (s *eventServer) client() *increase.Client {
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		query := req.URL.Query()
+		s.requests = append(s.requests, query)
+		var matching []map[string]any
+		for i := len(s.events) - 1; i >= 0; i-- {
+			createdAt, _ := time.Parse(time.RFC3339, s.events[i]["created_at"].(string))
+			if after, err := time.Parse(time.RFC3339, query.Get("created_at.on_or_after")); err == nil && createdAt.Before(after) {
+				continue
+			}
+			if before, err := time.Parse(time.RFC3339, query.Get("created_at.before")); err == nil && !createdAt.Before(before) {
+				continue
+			}
+			matching = append(matching, s.events[i])
+		}
+		start, _ := strconv.Atoi(query.Get("cursor"))
+		end := min(start+2, len(matching))
+		page := map[string]any{"data": matching[start:end], "next_cursor": nil}
+		if end < len(matching) {
+			page["next_cursor"] = strconv.Itoa(end)
+		}
+		body, _ := json.Marshal(page)
+		return jsonResponse(req, 200, string(body)), nil
+	})
+	return increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+}
+
+func TestEventConsumerPoll(t *testing.T) {
+	start := time.Date(2020, 1, 31, 23, 59, 0, 0, time.UTC)
+	server := &eventServer{}
+	for i := 0; i < 9; i++ {
+		server.add(fmt.Sprintf("event_%d", i), fmt.Sprintf("account_%d", i%3), start.Add(time.Duration(i/2)*time.Second))
+	}
+
+	var mu sync.Mutex
+	order := map[string][]string{}
+	store := increase.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	config := increase.EventConsumerConfig{
+		Handler: func(ctx context.Context, event *increase.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order[event.AssociatedObjectID] = append(order[event.AssociatedObjectID], event.ID)
+			return nil
+		},
+		Checkpoints: store,
+		Concurrency: 3,
+	}
+	consumer := server.client().Events.NewConsumer(config)
+
+	handled, err := consumer.Poll(context.Background())
+	if err != nil || handled != 9 {
+		t.Fatalf("expected 9 events to be handled, got %d and %v", handled, err)
+	}
+	for object, ids := range order {
+		for i := 1; i < len(ids); i++ {
+			if ids[i-1] > ids[i] {
+				t.Errorf("expected the events of %s in order, got %v", object, ids)
+			}
+		}
+	}
+
+	handled, err = consumer.Poll(context.Background())
+	if err != nil || handled != 0 {
+		t.Errorf("expected no events to be handled again, got %d and %v", handled, err)
+	}
+
+	// A new consumer carries on from the saved checkpoint.
+	created := time.Now().Truncate(time.Second)
+	server.add("event_9", "account_0", created)
+	handled, err = server.client().Events.NewConsumer(config).Poll(context.Background())
+	if err != nil || handled != 1 {
+		t.Errorf("expected only the new event to be handled, got %d and %v", handled, err)
+	}
+	checkpoint, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if !checkpoint.CreatedAt.Equal(created) || fmt.Sprint(checkpoint.Handled) != "[event_9]" {
+		t.Errorf("unexpected checkpoint %+v", checkpoint)
+	}
+}
+
+func TestEventConsumerRetriesFailedEvents(t *testing.T) {
+	start := time.Date(2020, 1, 31, 23, 59, 0, 0, time.UTC)
+	server := &eventServer{}
+	for i := 0; i < 5; i++ {
+		server.add(fmt.Sprintf("event_%d", i), "account_0", start.Add(time.Duration(i)*time.Second))
+	}
+
+	var handledIDs []string
+	failed := false
+	consumer := server.client().Events.NewConsumer(increase.EventConsumerConfig{
+		Handler: func(ctx context.Context, event *increase.Event) error {
+			if event.ID == "event_2" && !failed {
+				failed = true
+				return errors.New("database unavailable")
+			}
+			handledIDs = append(handledIDs, event.ID)
+			return nil
+		},
+	})
+
+	handled, err := consumer.Poll(context.Background())
+	if err == nil || handled != 2 {
+		t.Errorf("expected the handler's error after 2 events, got %d and %v", handled, err)
+	}
+	handled, err = consumer.Poll(context.Background())
+	if err != nil || handled != 3 {
+		t.Errorf("expected the remaining 3 events to be handled, got %d and %v", handled, err)
+	}
+	if fmt.Sprint(handledIDs) != "[event_0 event_1 event_2 event_3 event_4]" {
+		t.Errorf("expected each event to be handled once in order, got %v", handledIDs)
+	}
+}
+
+func TestEventConsumerPagesThroughASecond(t *testing.T) {
+	start := time.Date(2020, 1, 31, 23, 59, 0, 0, time.UTC)
+	server := &eventServer{}
+	for i := 0; i < 5; i++ {
+		server.add(fmt.Sprintf("event_%d", i), fmt.Sprintf("account_%d", i), start)
+	}
+	server.add("event_5", "account_0", start.Add(time.Second))
+
+	var handledIDs []string
+	failed := false
+	store := &increase.MemoryCheckpointStore{}
+	consumer := server.client().Events.NewConsumer(increase.EventConsumerConfig{
+		Handler: func(ctx context.Context, event *increase.Event) error {
+			if event.ID == "event_1" && !failed {
+				failed = true
+				return errors.New("database unavailable")
+			}
+			handledIDs = append(handledIDs, event.ID)
+			return nil
+		},
+		Checkpoints: store,
+		Start:       start,
+	})
+
+	if _, err := consumer.Poll(context.Background()); err == nil {
+		t.Fatalf("expected the handler's error")
+	}
+	checkpoint, _ := store.Load(context.Background())
+	if checkpoint.Cursor == "" || !checkpoint.Before.Equal(start.Add(time.Second)) {
+		t.Fatalf("expected the page of the second to be saved, got %+v", checkpoint)
+	}
+
+	server.requests = nil
+	handled, err := consumer.Poll(context.Background())
+	if err != nil || handled != 4 {
+		t.Fatalf("expected the remaining 4 events to be handled, got %d and %v", handled, err)
+	}
+	if cursor := server.requests[0].Get("cursor"); cursor != checkpoint.Cursor {
+		t.Errorf("expected the poll to start from the saved cursor %q, got %q", checkpoint.Cursor, cursor)
+	}
+	if len(handledIDs) != 6 || handledIDs[5] != "event_5" {
+		t.Errorf("expected each event to be handled once, got %v", handledIDs)
+	}
+	checkpoint, _ = store.Load(context.Background())
+	if checkpoint.Cursor != "" || !checkpoint.Before.IsZero() {
+		t.Errorf("unexpected checkpoint %+v", checkpoint)
+	}
+	handled, err = consumer.Poll(context.Background())
+	if err != nil || handled != 0 {
+		t.Errorf("expected no events to be handled again, got %d and %v", handled, err)
+	}
+}
+
+func TestEventConsumerPrunesHandled(t *testing.T) {
+	start := time.Date(2020, 1, 31, 23, 59, 0, 0, time.UTC)
+	server := &eventServer{}
+	server.add("event_0", "account_0", start)
+	for i := 1; i < 9; i++ {
+		server.add(fmt.Sprintf("event_%d", i), "account_1", start.Add(time.Duration(i)*time.Second))
+	}
+
+	store := &increase.MemoryCheckpointStore{}
+	consumer := server.client().Events.NewConsumer(increase.EventConsumerConfig{
+		Handler: func(ctx context.Context, event *increase.Event) error {
+			if event.ID == "event_0" {
+				return errors.New("database unavailable")
+			}
+			return nil
+		},
+		Checkpoints: store,
+		Concurrency: 2,
+		Start:       start,
+	})
+	for i := 0; i < 5; i++ {
+		if _, err := consumer.Poll(context.Background()); err == nil {
+			t.Fatalf("expected the handler's error")
+		}
+		checkpoint, _ := store.Load(context.Background())
+		if !checkpoint.CreatedAt.Equal(start) || len(checkpoint.Handled) > 2 {
+			t.Fatalf("expected the checkpoint to stay at the failing event with at most a page of handled events, got %+v", checkpoint)
+		}
+	}
+}
+
+// failingCheckpointStore fails to save checkpoints.
+type failingCheckpointStore struct {
+	increase.MemoryCheckpointStore
+}
+
+func (s *failingCheckpointStore) Save(ctx context.Context, checkpoint increase.Checkpoint) error {
+	return errors.New("disk full")
+}
+
+func TestEventConsumerRun(t *testing.T) {
+	start := time.Date(2020, 1, 31, 23, 59, 0, 0, time.UTC)
+	server := &eventServer{}
+	for i := 0; i < 5; i++ {
+		server.add(fmt.Sprintf("event_%d", i), "account_0", start.Add(time.Duration(i)*time.Second))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var handledIDs []string
+	failures := 0
+	config := increase.EventConsumerConfig{
+		Handler: func(ctx context.Context, event *increase.Event) error {
+			if event.ID == "event_2" && failures < 2 {
+				failures++
+				return errors.New("database unavailable")
+			}
+			handledIDs = append(handledIDs, event.ID)
+			if len(handledIDs) == 5 {
+				cancel()
+			}
+			return nil
+		},
+		PollInterval: time.Millisecond,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	err := server.client().Events.NewConsumer(config).Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected Run to stop when the context is canceled, got %v", err)
+	}
+	if fmt.Sprint(handledIDs) != "[event_0 event_1 event_2 event_3 event_4]" {
+		t.Errorf("expected each event to be handled once in order after the handler's errors, got %v", handledIDs)
+	}
+
+	config.Checkpoints = &failingCheckpointStore{}
+	err = server.client().Events.NewConsumer(config).Run(context.Background())
+	if err == nil || err.Error() != "disk full" {
+		t.Errorf("expected Run to stop when the checkpoint cannot be saved, got %v", err)
+	}
+}
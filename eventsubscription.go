@@ -38,6 +38,7 @@ func NewEventSubscriptionService(opts ...option.RequestOption) (r *EventSubscrip
 // Create an Event Subscription
 func (r *EventSubscriptionService) New(ctx context.Context, body EventSubscriptionNewParams, opts ...option.RequestOption) (res *EventSubscription, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("EventSubscriptions.New")}, opts...)
 	path := "event_subscriptions"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *EventSubscriptionService) New(ctx context.Context, body EventSubscripti
 // Retrieve an Event Subscription
 func (r *EventSubscriptionService) Get(ctx context.Context, eventSubscriptionID string, opts ...option.RequestOption) (res *EventSubscription, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("EventSubscriptions.Get")}, opts...)
 	path := fmt.Sprintf("event_subscriptions/%s", eventSubscriptionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -54,6 +56,7 @@ func (r *EventSubscriptionService) Get(ctx context.Context, eventSubscriptionID
 // Update an Event Subscription
 func (r *EventSubscriptionService) Update(ctx context.Context, eventSubscriptionID string, body EventSubscriptionUpdateParams, opts ...option.RequestOption) (res *EventSubscription, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("EventSubscriptions.Update")}, opts...)
 	path := fmt.Sprintf("event_subscriptions/%s", eventSubscriptionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -63,7 +66,7 @@ func (r *EventSubscriptionService) Update(ctx context.Context, eventSubscription
 func (r *EventSubscriptionService) List(ctx context.Context, query EventSubscriptionListParams, opts ...option.RequestOption) (res *shared.Page[EventSubscription], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("EventSubscriptions.List")}, opts...)
 	path := "event_subscriptions"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -123,6 +126,14 @@ func (r *EventSubscription) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r EventSubscription) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r eventSubscriptionJSON) RawJSON() string {
+	return r.raw
+}
+
 // If specified, this subscription will only receive webhooks for Events with the
 // specified `category`.
 type EventSubscriptionSelectedEventCategory string
@@ -270,6 +281,14 @@ const (
 	EventSubscriptionSelectedEventCategoryWireTransferUpdated EventSubscriptionSelectedEventCategory = "wire_transfer.updated"
 )
 
+func (r EventSubscriptionSelectedEventCategory) IsKnown() bool {
+	switch r {
+	case EventSubscriptionSelectedEventCategoryAccountCreated, EventSubscriptionSelectedEventCategoryAccountUpdated, EventSubscriptionSelectedEventCategoryAccountNumberCreated, EventSubscriptionSelectedEventCategoryAccountNumberUpdated, EventSubscriptionSelectedEventCategoryAccountStatementCreated, EventSubscriptionSelectedEventCategoryAccountTransferCreated, EventSubscriptionSelectedEventCategoryAccountTransferUpdated, EventSubscriptionSelectedEventCategoryACHPrenotificationCreated, EventSubscriptionSelectedEventCategoryACHPrenotificationUpdated, EventSubscriptionSelectedEventCategoryACHTransferCreated, EventSubscriptionSelectedEventCategoryACHTransferUpdated, EventSubscriptionSelectedEventCategoryBookkeepingAccountCreated, EventSubscriptionSelectedEventCategoryBookkeepingAccountUpdated, EventSubscriptionSelectedEventCategoryBookkeepingEntrySetUpdated, EventSubscriptionSelectedEventCategoryCardCreated, EventSubscriptionSelectedEventCategoryCardUpdated, EventSubscriptionSelectedEventCategoryCardPaymentCreated, EventSubscriptionSelectedEventCategoryCardPaymentUpdated, EventSubscriptionSelectedEventCategoryCardProfileCreated, EventSubscriptionSelectedEventCategoryCardProfileUpdated, EventSubscriptionSelectedEventCategoryCardDisputeCreated, EventSubscriptionSelectedEventCategoryCardDisputeUpdated, EventSubscriptionSelectedEventCategoryCheckDepositCreated, EventSubscriptionSelectedEventCategoryCheckDepositUpdated, EventSubscriptionSelectedEventCategoryCheckTransferCreated, EventSubscriptionSelectedEventCategoryCheckTransferUpdated, EventSubscriptionSelectedEventCategoryDeclinedTransactionCreated, EventSubscriptionSelectedEventCategoryDigitalWalletTokenCreated, EventSubscriptionSelectedEventCategoryDigitalWalletTokenUpdated, EventSubscriptionSelectedEventCategoryDocumentCreated, EventSubscriptionSelectedEventCategoryEntityCreated, EventSubscriptionSelectedEventCategoryEntityUpdated, EventSubscriptionSelectedEventCategoryEventSubscriptionCreated, EventSubscriptionSelectedEventCategoryEventSubscriptionUpdated, EventSubscriptionSelectedEventCategoryExportCreated, EventSubscriptionSelectedEventCategoryExportUpdated, EventSubscriptionSelectedEventCategoryExternalAccountCreated, EventSubscriptionSelectedEventCategoryExternalAccountUpdated, EventSubscriptionSelectedEventCategoryFileCreated, EventSubscriptionSelectedEventCategoryGroupUpdated, EventSubscriptionSelectedEventCategoryGroupHeartbeat, EventSubscriptionSelectedEventCategoryInboundACHTransferCreated, EventSubscriptionSelectedEventCategoryInboundACHTransferUpdated, EventSubscriptionSelectedEventCategoryInboundACHTransferReturnCreated, EventSubscriptionSelectedEventCategoryInboundACHTransferReturnUpdated, EventSubscriptionSelectedEventCategoryInboundWireDrawdownRequestCreated, EventSubscriptionSelectedEventCategoryIntrafiAccountEnrollmentCreated, EventSubscriptionSelectedEventCategoryIntrafiAccountEnrollmentUpdated, EventSubscriptionSelectedEventCategoryIntrafiExclusionCreated, EventSubscriptionSelectedEventCategoryIntrafiExclusionUpdated, EventSubscriptionSelectedEventCategoryOauthConnectionCreated, EventSubscriptionSelectedEventCategoryOauthConnectionDeactivated, EventSubscriptionSelectedEventCategoryPendingTransactionCreated, EventSubscriptionSelectedEventCategoryPendingTransactionUpdated, EventSubscriptionSelectedEventCategoryPhysicalCardCreated, EventSubscriptionSelectedEventCategoryPhysicalCardUpdated, EventSubscriptionSelectedEventCategoryRealTimeDecisionCardAuthorizationRequested, EventSubscriptionSelectedEventCategoryRealTimeDecisionDigitalWalletTokenRequested, EventSubscriptionSelectedEventCategoryRealTimeDecisionDigitalWalletAuthenticationRequested, EventSubscriptionSelectedEventCategoryRealTimePaymentsTransferCreated, EventSubscriptionSelectedEventCategoryRealTimePaymentsTransferUpdated, EventSubscriptionSelectedEventCategoryRealTimePaymentsRequestForPaymentCreated, EventSubscriptionSelectedEventCategoryRealTimePaymentsRequestForPaymentUpdated, EventSubscriptionSelectedEventCategoryTransactionCreated, EventSubscriptionSelectedEventCategoryWireDrawdownRequestCreated, EventSubscriptionSelectedEventCategoryWireDrawdownRequestUpdated, EventSubscriptionSelectedEventCategoryWireTransferCreated, EventSubscriptionSelectedEventCategoryWireTransferUpdated:
+		return true
+	}
+	return false
+}
+
 // This indicates if we'll send notifications to this subscription.
 type EventSubscriptionStatus string
 
@@ -285,6 +304,14 @@ const (
 	EventSubscriptionStatusRequiresAttention EventSubscriptionStatus = "requires_attention"
 )
 
+func (r EventSubscriptionStatus) IsKnown() bool {
+	switch r {
+	case EventSubscriptionStatusActive, EventSubscriptionStatusDisabled, EventSubscriptionStatusDeleted, EventSubscriptionStatusRequiresAttention:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `event_subscription`.
 type EventSubscriptionType string
@@ -293,6 +320,14 @@ const (
 	EventSubscriptionTypeEventSubscription EventSubscriptionType = "event_subscription"
 )
 
+func (r EventSubscriptionType) IsKnown() bool {
+	switch r {
+	case EventSubscriptionTypeEventSubscription:
+		return true
+	}
+	return false
+}
+
 type EventSubscriptionNewParams struct {
 	// The URL you'd like us to send webhooks to.
 	URL param.Field[string] `json:"url,required"`
@@ -455,6 +490,14 @@ const (
 	EventSubscriptionNewParamsSelectedEventCategoryWireTransferUpdated EventSubscriptionNewParamsSelectedEventCategory = "wire_transfer.updated"
 )
 
+func (r EventSubscriptionNewParamsSelectedEventCategory) IsKnown() bool {
+	switch r {
+	case EventSubscriptionNewParamsSelectedEventCategoryAccountCreated, EventSubscriptionNewParamsSelectedEventCategoryAccountUpdated, EventSubscriptionNewParamsSelectedEventCategoryAccountNumberCreated, EventSubscriptionNewParamsSelectedEventCategoryAccountNumberUpdated, EventSubscriptionNewParamsSelectedEventCategoryAccountStatementCreated, EventSubscriptionNewParamsSelectedEventCategoryAccountTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryAccountTransferUpdated, EventSubscriptionNewParamsSelectedEventCategoryACHPrenotificationCreated, EventSubscriptionNewParamsSelectedEventCategoryACHPrenotificationUpdated, EventSubscriptionNewParamsSelectedEventCategoryACHTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryACHTransferUpdated, EventSubscriptionNewParamsSelectedEventCategoryBookkeepingAccountCreated, EventSubscriptionNewParamsSelectedEventCategoryBookkeepingAccountUpdated, EventSubscriptionNewParamsSelectedEventCategoryBookkeepingEntrySetUpdated, EventSubscriptionNewParamsSelectedEventCategoryCardCreated, EventSubscriptionNewParamsSelectedEventCategoryCardUpdated, EventSubscriptionNewParamsSelectedEventCategoryCardPaymentCreated, EventSubscriptionNewParamsSelectedEventCategoryCardPaymentUpdated, EventSubscriptionNewParamsSelectedEventCategoryCardProfileCreated, EventSubscriptionNewParamsSelectedEventCategoryCardProfileUpdated, EventSubscriptionNewParamsSelectedEventCategoryCardDisputeCreated, EventSubscriptionNewParamsSelectedEventCategoryCardDisputeUpdated, EventSubscriptionNewParamsSelectedEventCategoryCheckDepositCreated, EventSubscriptionNewParamsSelectedEventCategoryCheckDepositUpdated, EventSubscriptionNewParamsSelectedEventCategoryCheckTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryCheckTransferUpdated, EventSubscriptionNewParamsSelectedEventCategoryDeclinedTransactionCreated, EventSubscriptionNewParamsSelectedEventCategoryDigitalWalletTokenCreated, EventSubscriptionNewParamsSelectedEventCategoryDigitalWalletTokenUpdated, EventSubscriptionNewParamsSelectedEventCategoryDocumentCreated, EventSubscriptionNewParamsSelectedEventCategoryEntityCreated, EventSubscriptionNewParamsSelectedEventCategoryEntityUpdated, EventSubscriptionNewParamsSelectedEventCategoryEventSubscriptionCreated, EventSubscriptionNewParamsSelectedEventCategoryEventSubscriptionUpdated, EventSubscriptionNewParamsSelectedEventCategoryExportCreated, EventSubscriptionNewParamsSelectedEventCategoryExportUpdated, EventSubscriptionNewParamsSelectedEventCategoryExternalAccountCreated, EventSubscriptionNewParamsSelectedEventCategoryExternalAccountUpdated, EventSubscriptionNewParamsSelectedEventCategoryFileCreated, EventSubscriptionNewParamsSelectedEventCategoryGroupUpdated, EventSubscriptionNewParamsSelectedEventCategoryGroupHeartbeat, EventSubscriptionNewParamsSelectedEventCategoryInboundACHTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryInboundACHTransferUpdated, EventSubscriptionNewParamsSelectedEventCategoryInboundACHTransferReturnCreated, EventSubscriptionNewParamsSelectedEventCategoryInboundACHTransferReturnUpdated, EventSubscriptionNewParamsSelectedEventCategoryInboundWireDrawdownRequestCreated, EventSubscriptionNewParamsSelectedEventCategoryIntrafiAccountEnrollmentCreated, EventSubscriptionNewParamsSelectedEventCategoryIntrafiAccountEnrollmentUpdated, EventSubscriptionNewParamsSelectedEventCategoryIntrafiExclusionCreated, EventSubscriptionNewParamsSelectedEventCategoryIntrafiExclusionUpdated, EventSubscriptionNewParamsSelectedEventCategoryOauthConnectionCreated, EventSubscriptionNewParamsSelectedEventCategoryOauthConnectionDeactivated, EventSubscriptionNewParamsSelectedEventCategoryPendingTransactionCreated, EventSubscriptionNewParamsSelectedEventCategoryPendingTransactionUpdated, EventSubscriptionNewParamsSelectedEventCategoryPhysicalCardCreated, EventSubscriptionNewParamsSelectedEventCategoryPhysicalCardUpdated, EventSubscriptionNewParamsSelectedEventCategoryRealTimeDecisionCardAuthorizationRequested, EventSubscriptionNewParamsSelectedEventCategoryRealTimeDecisionDigitalWalletTokenRequested, EventSubscriptionNewParamsSelectedEventCategoryRealTimeDecisionDigitalWalletAuthenticationRequested, EventSubscriptionNewParamsSelectedEventCategoryRealTimePaymentsTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryRealTimePaymentsTransferUpdated, EventSubscriptionNewParamsSelectedEventCategoryRealTimePaymentsRequestForPaymentCreated, EventSubscriptionNewParamsSelectedEventCategoryRealTimePaymentsRequestForPaymentUpdated, EventSubscriptionNewParamsSelectedEventCategoryTransactionCreated, EventSubscriptionNewParamsSelectedEventCategoryWireDrawdownRequestCreated, EventSubscriptionNewParamsSelectedEventCategoryWireDrawdownRequestUpdated, EventSubscriptionNewParamsSelectedEventCategoryWireTransferCreated, EventSubscriptionNewParamsSelectedEventCategoryWireTransferUpdated:
+		return true
+	}
+	return false
+}
+
 type EventSubscriptionUpdateParams struct {
 	// The status to update the Event Subscription with.
 	Status param.Field[EventSubscriptionUpdateParamsStatus] `json:"status"`
@@ -476,6 +519,14 @@ const (
 	EventSubscriptionUpdateParamsStatusDeleted EventSubscriptionUpdateParamsStatus = "deleted"
 )
 
+func (r EventSubscriptionUpdateParamsStatus) IsKnown() bool {
+	switch r {
+	case EventSubscriptionUpdateParamsStatusActive, EventSubscriptionUpdateParamsStatusDisabled, EventSubscriptionUpdateParamsStatusDeleted:
+		return true
+	}
+	return false
+}
+
 type EventSubscriptionListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
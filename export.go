@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/increase/increase-go/internal/apijson"
@@ -37,6 +38,7 @@ func NewExportService(opts ...option.RequestOption) (r *ExportService) {
 // Create an Export
 func (r *ExportService) New(ctx context.Context, body ExportNewParams, opts ...option.RequestOption) (res *Export, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Exports.New")}, opts...)
 	path := "exports"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -45,16 +47,69 @@ func (r *ExportService) New(ctx context.Context, body ExportNewParams, opts ...o
 // Retrieve an Export
 func (r *ExportService) Get(ctx context.Context, exportID string, opts ...option.RequestOption) (res *Export, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Exports.Get")}, opts...)
 	path := fmt.Sprintf("exports/%s", exportID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// The first and longest intervals between checks of an export's status in
+// NewAndWait.
+const (
+	exportPollInterval    = 500 * time.Millisecond
+	exportMaxPollInterval = 10 * time.Second
+)
+
+// Create an Export, and wait until it is complete. Its status is checked with
+// an interval that starts at half a second and doubles up to ten seconds,
+// until ctx is done. If the export fails, it is returned with an error which
+// wraps [ErrExportFailed]. The contents of a complete export can be read with
+// [FileService.DownloadReader] and its FileID, or, for CSV exports, with
+// [ExportService.NewAndReadCSV].
+func (r *ExportService) NewAndWait(ctx context.Context, body ExportNewParams, opts ...option.RequestOption) (res *Export, err error) {
+	res, err = r.New(ctx, body, opts...)
+	interval := exportPollInterval
+	for err == nil && res.Status == ExportStatusPending {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(interval*2, exportMaxPollInterval)
+		res, err = r.Get(ctx, res.ID, opts...)
+	}
+	if err == nil && res.Status == ExportStatusFailed {
+		err = fmt.Errorf("%w: %s", ErrExportFailed, res.ID)
+	}
+	return
+}
+
+// Create a CSV Export, wait until it is complete as [ExportService.NewAndWait]
+// does, and return an iterator over the rows of its file. The file is streamed
+// from [FileService.DownloadReader] as the rows are read, and the rows must be
+// closed when they are no longer needed.
+func (r *ExportService) NewAndReadCSV(ctx context.Context, body ExportNewParams, opts ...option.RequestOption) (res *Export, rows *ExportRows, err error) {
+	res, err = r.NewAndWait(ctx, body, opts...)
+	if err != nil {
+		return res, nil, err
+	}
+	if !strings.HasSuffix(string(res.Category), "_csv") {
+		return res, nil, fmt.Errorf("increase: export %s is not a CSV export: %s", res.ID, res.Category)
+	}
+	contents, err := NewFileService(r.Options...).DownloadReader(ctx, res.FileID, opts...)
+	if err != nil {
+		return res, nil, err
+	}
+	return res, ParseExportCSV(contents), nil
+}
+
 // List Exports
 func (r *ExportService) List(ctx context.Context, query ExportListParams, opts ...option.RequestOption) (res *shared.Page[Export], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Exports.List")}, opts...)
 	path := "exports"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -117,6 +172,14 @@ func (r *Export) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Export) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r exportJSON) RawJSON() string {
+	return r.raw
+}
+
 // The category of the Export. We may add additional possible values for this enum
 // over time; your application should be able to handle that gracefully.
 type ExportCategory string
@@ -135,6 +198,14 @@ const (
 	ExportCategoryEntityCsv ExportCategory = "entity_csv"
 )
 
+func (r ExportCategory) IsKnown() bool {
+	switch r {
+	case ExportCategoryAccountStatementOfx, ExportCategoryTransactionCsv, ExportCategoryBalanceCsv, ExportCategoryBookkeepingAccountBalanceCsv, ExportCategoryEntityCsv:
+		return true
+	}
+	return false
+}
+
 // The status of the Export.
 type ExportStatus string
 
@@ -148,6 +219,14 @@ const (
 	ExportStatusFailed ExportStatus = "failed"
 )
 
+func (r ExportStatus) IsKnown() bool {
+	switch r {
+	case ExportStatusPending, ExportStatusComplete, ExportStatusFailed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `export`.
 type ExportType string
@@ -156,6 +235,14 @@ const (
 	ExportTypeExport ExportType = "export"
 )
 
+func (r ExportType) IsKnown() bool {
+	switch r {
+	case ExportTypeExport:
+		return true
+	}
+	return false
+}
+
 type ExportNewParams struct {
 	// The type of Export to create.
 	Category param.Field[ExportNewParamsCategory] `json:"category,required"`
@@ -196,6 +283,14 @@ const (
 	ExportNewParamsCategoryEntityCsv ExportNewParamsCategory = "entity_csv"
 )
 
+func (r ExportNewParamsCategory) IsKnown() bool {
+	switch r {
+	case ExportNewParamsCategoryAccountStatementOfx, ExportNewParamsCategoryTransactionCsv, ExportNewParamsCategoryBalanceCsv, ExportNewParamsCategoryBookkeepingAccountBalanceCsv, ExportNewParamsCategoryEntityCsv:
+		return true
+	}
+	return false
+}
+
 // Options for the created export. Required if `category` is equal to
 // `account_statement_ofx`.
 type ExportNewParamsAccountStatementOfx struct {
@@ -327,6 +422,14 @@ const (
 	ExportNewParamsEntityCsvStatusInDisabled ExportNewParamsEntityCsvStatusIn = "disabled"
 )
 
+func (r ExportNewParamsEntityCsvStatusIn) IsKnown() bool {
+	switch r {
+	case ExportNewParamsEntityCsvStatusInActive, ExportNewParamsEntityCsvStatusInArchived, ExportNewParamsEntityCsvStatusInDisabled:
+		return true
+	}
+	return false
+}
+
 // Options for the created export. Required if `category` is equal to
 // `transaction_csv`.
 type ExportNewParamsTransactionCsv struct {
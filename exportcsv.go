@@ -0,0 +1,345 @@
+package increase
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportRow is a row of a CSV Export. It maps the header of each column, as it
+// appears in the file, to the row's value in that column. Values are left as
+// they appear in the file; the rows of each category can be decoded into typed
+// structs with functions such as [ParseTransactionCSV].
+type ExportRow map[string]string
+
+// ParseExportCSV returns an iterator over the rows of a CSV Export, such as
+// the contents returned by [FileService.DownloadReader] for the export's FileID.
+// The first line of the file is its header. If r is an [io.Closer], it is closed
+// by [ExportRows.Close].
+func ParseExportCSV(r io.Reader) *ExportRows {
+	reader := csv.NewReader(r)
+	rows := &ExportRows{reader: reader}
+	if closer, ok := r.(io.Closer); ok {
+		rows.closer = closer
+	}
+	return rows
+}
+
+// ExportRows is an iterator over the rows of a CSV Export, which are read as
+// they are needed, so exports of any size can be streamed.
+//
+//	rows := increase.ParseExportCSV(body)
+//	for rows.Next() {
+//		row := rows.Current()
+//		fmt.Println(row["Transaction ID"])
+//	}
+//	if err := rows.Err(); err != nil {
+//		panic(err.Error())
+//	}
+type ExportRows struct {
+	reader  *csv.Reader
+	closer  io.Closer
+	headers []string
+	cur     ExportRow
+	line    int
+	err     error
+}
+
+// Next reads the next row, returning false when there are no more rows or
+// there was an error.
+func (r *ExportRows) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	if r.headers == nil {
+		if r.err = r.readHeader(); r.err != nil {
+			return false
+		}
+	}
+	record, err := r.reader.Read()
+	if err != nil {
+		if err != io.EOF {
+			r.err = fmt.Errorf("increase: export CSV: %w", err)
+		}
+		return false
+	}
+	r.line, _ = r.reader.FieldPos(0)
+	row := make(ExportRow, len(record))
+	for i, value := range record {
+		row[r.headers[i]] = value
+	}
+	r.cur = row
+	return true
+}
+
+// Current returns the row read by the last call to Next.
+func (r *ExportRows) Current() ExportRow {
+	return r.cur
+}
+
+// Header returns the headers of the columns, in the order they appear in the
+// file. It is nil until the first call to Next.
+func (r *ExportRows) Header() []string {
+	return r.headers
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (r *ExportRows) Err() error {
+	return r.err
+}
+
+// Line returns the line of the CSV that the current row starts on.
+func (r *ExportRows) Line() int {
+	return r.line
+}
+
+// Close closes the reader the rows are read from, if it is an [io.Closer].
+func (r *ExportRows) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+func (r *ExportRows) readHeader() error {
+	record, err := r.reader.Read()
+	if err == io.EOF {
+		return fmt.Errorf("increase: export CSV has no header")
+	}
+	if err != nil {
+		return fmt.Errorf("increase: export CSV: %w", err)
+	}
+	headers := make([]string, len(record))
+	seen := make(map[string]bool, len(record))
+	for i, header := range record {
+		if i == 0 {
+			header = strings.TrimPrefix(header, "\ufeff")
+		}
+		if seen[header] {
+			return fmt.Errorf("increase: export CSV has more than one column %q", header)
+		}
+		seen[header] = true
+		headers[i] = header
+	}
+	r.headers = headers
+	return nil
+}
+
+// TransactionCSVRow is a row of a `transaction_csv` Export.
+type TransactionCSVRow struct {
+	// The identifier of the Transaction.
+	ID string `csv:"id"`
+	// The identifier of the Account the Transaction belongs to.
+	AccountID string `csv:"account_id"`
+	// The time at which the Transaction occurred.
+	CreatedAt time.Time `csv:"created_at"`
+	// The Transaction amount in the minor unit of its currency.
+	Amount int64 `csv:"amount"`
+	// The ISO 4217 code for the Transaction's currency.
+	Currency string `csv:"currency"`
+	// A description of the Transaction.
+	Description string `csv:"description"`
+	// The identifier of the Account Number or Card the Transaction came through.
+	RouteID string `csv:"route_id"`
+	// The type of the route the Transaction came through.
+	RouteType string `csv:"route_type"`
+	// Columns which are not one of the above, by header.
+	Extra map[string]string `csv:"-"`
+}
+
+// BalanceCSVRow is a row of a `balance_csv` Export.
+type BalanceCSVRow struct {
+	// The identifier of the Account.
+	AccountID string `csv:"account_id"`
+	// The date of the balance.
+	Date time.Time `csv:"date"`
+	// The Account's current balance in the minor unit of its currency.
+	CurrentBalance int64 `csv:"current_balance"`
+	// The Account's available balance in the minor unit of its currency.
+	AvailableBalance int64 `csv:"available_balance"`
+	// The ISO 4217 code for the Account's currency.
+	Currency string `csv:"currency"`
+	// Columns which are not one of the above, by header.
+	Extra map[string]string `csv:"-"`
+}
+
+// BookkeepingAccountBalanceCSVRow is a row of a
+// `bookkeeping_account_balance_csv` Export.
+type BookkeepingAccountBalanceCSVRow struct {
+	// The identifier of the Bookkeeping Account.
+	BookkeepingAccountID string `csv:"bookkeeping_account_id"`
+	// The date of the balance.
+	Date time.Time `csv:"date"`
+	// The Bookkeeping Account's balance in the minor unit of its currency.
+	Balance int64 `csv:"balance"`
+	// The ISO 4217 code for the balance's currency.
+	Currency string `csv:"currency"`
+	// Columns which are not one of the above, by header.
+	Extra map[string]string `csv:"-"`
+}
+
+// EntityCSVRow is a row of an `entity_csv` Export.
+type EntityCSVRow struct {
+	// The identifier of the Entity.
+	ID string `csv:"id"`
+	// The name of the Entity.
+	Name string `csv:"name"`
+	// The legal structure of the Entity.
+	Structure EntityStructure `csv:"structure"`
+	// The status of the Entity.
+	Status EntityStatus `csv:"status"`
+	// The time at which the Entity was created.
+	CreatedAt time.Time `csv:"created_at"`
+	// Columns which are not one of the above, by header.
+	Extra map[string]string `csv:"-"`
+}
+
+// ParseTransactionCSV returns an iterator over the rows of a `transaction_csv`
+// Export, such as the contents returned by [FileService.DownloadReader] for the
+// export's FileID.
+func ParseTransactionCSV(r io.Reader) *CSVRows[TransactionCSVRow] {
+	return newCSVRows[TransactionCSVRow](r)
+}
+
+// ParseBalanceCSV returns an iterator over the rows of a `balance_csv` Export.
+func ParseBalanceCSV(r io.Reader) *CSVRows[BalanceCSVRow] {
+	return newCSVRows[BalanceCSVRow](r)
+}
+
+// ParseBookkeepingAccountBalanceCSV returns an iterator over the rows of a
+// `bookkeeping_account_balance_csv` Export.
+func ParseBookkeepingAccountBalanceCSV(r io.Reader) *CSVRows[BookkeepingAccountBalanceCSVRow] {
+	return newCSVRows[BookkeepingAccountBalanceCSVRow](r)
+}
+
+// ParseEntityCSV returns an iterator over the rows of an `entity_csv` Export.
+func ParseEntityCSV(r io.Reader) *CSVRows[EntityCSVRow] {
+	return newCSVRows[EntityCSVRow](r)
+}
+
+// CSVRows is an iterator over the typed rows of a CSV Export, which are read as
+// they are needed. Each column is decoded into the field of the row named by
+// its header, ignoring case and treating spaces as underscores, so "Account ID"
+// and "account_id" are the same column. Columns which are not fields of the row
+// are kept in its Extra map, and fields without a column are left empty.
+//
+//	rows := increase.ParseTransactionCSV(body)
+//	for rows.Next() {
+//		row := rows.Current()
+//		fmt.Println(row.ID, row.Amount)
+//	}
+//	if err := rows.Err(); err != nil {
+//		panic(err.Error())
+//	}
+type CSVRows[T any] struct {
+	rows *ExportRows
+	cur  T
+	err  error
+}
+
+func newCSVRows[T any](r io.Reader) *CSVRows[T] {
+	return &CSVRows[T]{rows: ParseExportCSV(r)}
+}
+
+// Next reads the next row, returning false when there are no more rows or
+// there was an error.
+func (r *CSVRows[T]) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+	var row T
+	if err := decodeCSVRow(reflect.ValueOf(&row).Elem(), r.rows.Header(), r.rows.Current()); err != nil {
+		r.err = fmt.Errorf("increase: line %d, %w", r.rows.Line(), err)
+		return false
+	}
+	r.cur = row
+	return true
+}
+
+// Current returns the row read by the last call to Next.
+func (r *CSVRows[T]) Current() T {
+	return r.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (r *CSVRows[T]) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Line returns the line of the CSV that the current row starts on.
+func (r *CSVRows[T]) Line() int {
+	return r.rows.Line()
+}
+
+// Close closes the reader the rows are read from, if it is an [io.Closer].
+func (r *CSVRows[T]) Close() error {
+	return r.rows.Close()
+}
+
+// decodeCSVRow sets the fields of v, a row struct, from the values of row.
+func decodeCSVRow(v reflect.Value, headers []string, row ExportRow) error {
+	fields := map[string]int{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if name := t.Field(i).Tag.Get("csv"); name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	extra := map[string]string{}
+	for _, header := range headers {
+		value := row[header]
+		field, ok := fields[csvColumnName(header)]
+		if !ok {
+			extra[header] = value
+			continue
+		}
+		if err := setCSVField(v.Field(field), value); err != nil {
+			return fmt.Errorf("column %q: %w", header, err)
+		}
+	}
+	if len(extra) > 0 {
+		v.FieldByName("Extra").Set(reflect.ValueOf(extra))
+	}
+	return nil
+}
+
+// csvColumnName normalizes a CSV header such as "Account ID" to "account_id".
+func csvColumnName(header string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(header)), " ", "_")
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func setCSVField(v reflect.Value, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch {
+	case v.Type() == timeType:
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if t, err := time.Parse(layout, value); err == nil {
+				v.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid time %q", value)
+	case v.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		v.SetInt(n)
+	case v.Kind() == reflect.String:
+		v.SetString(value)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
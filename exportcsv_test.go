@@ -0,0 +1,204 @@
+package increase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+func TestParseExportCSV(t *testing.T) {
+	csv := "\ufeffTransaction ID,Created At,Amount,Description\n" +
+		"transaction_uyrp7fld2ium70oa7oi,2020-01-31T23:59:59Z,1.00,\"Frederick S. Holmes, Inc.\"\n" +
+		"transaction_vc9kl2ev0n3c1dhx9yrb,2020-02-01T00:00:00Z,-25.00,Card payment\n"
+
+	rows := increase.ParseExportCSV(strings.NewReader(csv))
+	var got []increase.ExportRow
+	for rows.Next() {
+		got = append(got, rows.Current())
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if fmt.Sprint(rows.Header()) != "[Transaction ID Created At Amount Description]" {
+		t.Errorf("expected the header without its byte order mark, got %q", rows.Header())
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(got))
+	}
+	if got[0]["Transaction ID"] != "transaction_uyrp7fld2ium70oa7oi" || got[0]["Amount"] != "1.00" || got[0]["Description"] != "Frederick S. Holmes, Inc." {
+		t.Errorf("unexpected first row %v", got[0])
+	}
+	if got[1]["Amount"] != "-25.00" || rows.Line() != 3 {
+		t.Errorf("unexpected second row %v on line %d", got[1], rows.Line())
+	}
+}
+
+func TestParseExportCSVInvalid(t *testing.T) {
+	for name, csv := range map[string]string{
+		"missing column":   "id,name\nentity_n8y8tnk2p9339ti393yi\n",
+		"duplicate header": "id,id\nentity_n8y8tnk2p9339ti393yi,entity_n8y8tnk2p9339ti393yi\n",
+		"empty":            "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			rows := increase.ParseExportCSV(strings.NewReader(csv))
+			if rows.Next() {
+				t.Fatalf("expected no rows, got %v", rows.Current())
+			}
+			if rows.Err() == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseTransactionCSV(t *testing.T) {
+	csv := "id,account_id,created_at,amount,currency,description,route_id,route_type,memo\n" +
+		"transaction_uyrp7fld2ium70oa7oi,account_in71c4amph0vgo2qllky,2020-01-31T23:59:59Z,100,USD,\"Frederick S. Holmes, Inc.\",account_number_v18nkfqm6afpsrvy82b2,account_number,rent\n"
+
+	rows := increase.ParseTransactionCSV(strings.NewReader(csv))
+	if !rows.Next() {
+		t.Fatalf("expected a row, got %v", rows.Err())
+	}
+	row := rows.Current()
+	if row.ID != "transaction_uyrp7fld2ium70oa7oi" || row.AccountID != "account_in71c4amph0vgo2qllky" || row.Amount != 100 || row.Currency != "USD" || row.Description != "Frederick S. Holmes, Inc." || row.RouteID != "account_number_v18nkfqm6afpsrvy82b2" || row.RouteType != "account_number" {
+		t.Errorf("unexpected row %+v", row)
+	}
+	if !row.CreatedAt.Equal(time.Date(2020, 1, 31, 23, 59, 59, 0, time.UTC)) {
+		t.Errorf("expected the created at time to be decoded, got %v", row.CreatedAt)
+	}
+	if fmt.Sprint(row.Extra) != "map[memo:rent]" {
+		t.Errorf("expected the memo column in Extra, got %v", row.Extra)
+	}
+	if rows.Next() || rows.Err() != nil {
+		t.Errorf("expected a single row, got %+v and %v", rows.Current(), rows.Err())
+	}
+}
+
+func TestParseBalanceCSV(t *testing.T) {
+	csv := "Account ID,Date,Current Balance,Available Balance,Currency\n" +
+		"account_in71c4amph0vgo2qllky,2020-01-31,-2500,1000,USD\n"
+
+	rows := increase.ParseBalanceCSV(strings.NewReader(csv))
+	if !rows.Next() {
+		t.Fatalf("expected a row, got %v", rows.Err())
+	}
+	row := rows.Current()
+	if row.AccountID != "account_in71c4amph0vgo2qllky" || row.CurrentBalance != -2500 || row.AvailableBalance != 1000 || row.Currency != "USD" || row.Extra != nil {
+		t.Errorf("unexpected row %+v", row)
+	}
+	if !row.Date.Equal(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the date to be decoded, got %v", row.Date)
+	}
+}
+
+func TestParseBookkeepingAccountBalanceCSV(t *testing.T) {
+	csv := "bookkeeping_account_id,date,balance,currency\n" +
+		"bookkeeping_account_e37p1f1iuocw5intf35v,2020-01-31,5000,USD\n"
+
+	rows := increase.ParseBookkeepingAccountBalanceCSV(strings.NewReader(csv))
+	if !rows.Next() {
+		t.Fatalf("expected a row, got %v", rows.Err())
+	}
+	row := rows.Current()
+	if row.BookkeepingAccountID != "bookkeeping_account_e37p1f1iuocw5intf35v" || row.Balance != 5000 || row.Currency != "USD" || !row.Date.Equal(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected row %+v", row)
+	}
+}
+
+func TestParseEntityCSV(t *testing.T) {
+	csv := "id,name,structure,status,created_at\n" +
+		"entity_n8y8tnk2p9339ti393yi,National Phonograph Company,corporation,active,2020-01-31T23:59:59Z\n" +
+		"entity_n8y8tnk2p9339ti393yi,National Phonograph Company,corporation,active,yesterday\n"
+
+	rows := increase.ParseEntityCSV(strings.NewReader(csv))
+	if !rows.Next() {
+		t.Fatalf("expected a row, got %v", rows.Err())
+	}
+	row := rows.Current()
+	if row.ID != "entity_n8y8tnk2p9339ti393yi" || row.Name != "National Phonograph Company" || row.Structure != increase.EntityStructureCorporation || row.Status != increase.EntityStatusActive {
+		t.Errorf("unexpected row %+v", row)
+	}
+	if rows.Next() {
+		t.Fatalf("expected the invalid row to stop the iteration, got %+v", rows.Current())
+	}
+	if err := rows.Err(); err == nil || !strings.Contains(err.Error(), `line 3, column "created_at"`) {
+		t.Errorf("expected an error for the created_at column on line 3, got %v", err)
+	}
+}
+
+func TestExportNewAndWait(t *testing.T) {
+	for _, status := range []string{"complete", "failed"} {
+		t.Run(status, func(t *testing.T) {
+			gets := 0
+			transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+				if req.Method == http.MethodPost {
+					return jsonResponse(req, 200, `{"id":"export_8s4m48qz3bclzje0zwh9","category":"transaction_csv","status":"pending","type":"export"}`), nil
+				}
+				gets += 1
+				return jsonResponse(req, 200, `{"id":"export_8s4m48qz3bclzje0zwh9","category":"transaction_csv","status":"`+status+`","file_id":"file_makxrc67oh9l6sg7w9yc","type":"export"}`), nil
+			})
+			client := increase.NewClient(
+				option.WithBaseURL("http://localhost:4010"),
+				option.WithAPIKey("My API Key"),
+				option.WithHTTPClient(&http.Client{Transport: transport}),
+			)
+			export, err := client.Exports.NewAndWait(context.Background(), increase.ExportNewParams{
+				Category: increase.F(increase.ExportNewParamsCategoryTransactionCsv),
+			})
+			if gets != 1 {
+				t.Errorf("expected the export to be checked once, got %d", gets)
+			}
+			if export == nil || string(export.Status) != status {
+				t.Fatalf("expected the %s export to be returned, got %+v", status, export)
+			}
+			if status == "failed" && !errors.Is(err, increase.ErrExportFailed) {
+				t.Errorf("expected ErrExportFailed, got %v", err)
+			}
+			if status == "complete" && err != nil {
+				t.Errorf("err should be nil: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestExportNewAndReadCSV(t *testing.T) {
+	contents := "Transaction ID,Amount\ntransaction_uyrp7fld2ium70oa7oi,1.00\n"
+	client := downloadClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode:    200,
+			Header:        http.Header{"Content-Type": {"text/csv"}},
+			ContentLength: int64(len(contents)),
+			Body:          io.NopCloser(strings.NewReader(contents)),
+			Request:       req,
+		}
+	})
+	export, rows, err := client.Exports.NewAndReadCSV(context.Background(), increase.ExportNewParams{
+		Category: increase.F(increase.ExportNewParamsCategoryTransactionCsv),
+	}, option.WithMiddleware(func(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+		if req.URL.Path == "/exports" {
+			return jsonResponse(req, 200, `{"id":"export_8s4m48qz3bclzje0zwh9","category":"transaction_csv","status":"complete","file_id":"file_makxrc67oh9l6sg7w9yc","type":"export"}`), nil
+		}
+		return next(req)
+	}))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer rows.Close()
+	if export.ID != "export_8s4m48qz3bclzje0zwh9" {
+		t.Errorf("expected the export to be returned, got %+v", export)
+	}
+	if !rows.Next() || rows.Current()["Transaction ID"] != "transaction_uyrp7fld2ium70oa7oi" {
+		t.Fatalf("expected the row of the export's file, got %v and %v", rows.Current(), rows.Err())
+	}
+	if rows.Next() || rows.Err() != nil {
+		t.Errorf("expected a single row, got %v and %v", rows.Current(), rows.Err())
+	}
+}
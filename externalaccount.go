@@ -38,6 +38,7 @@ func NewExternalAccountService(opts ...option.RequestOption) (r *ExternalAccount
 // Create an External Account
 func (r *ExternalAccountService) New(ctx context.Context, body ExternalAccountNewParams, opts ...option.RequestOption) (res *ExternalAccount, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ExternalAccounts.New")}, opts...)
 	path := "external_accounts"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *ExternalAccountService) New(ctx context.Context, body ExternalAccountNe
 // Retrieve an External Account
 func (r *ExternalAccountService) Get(ctx context.Context, externalAccountID string, opts ...option.RequestOption) (res *ExternalAccount, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ExternalAccounts.Get")}, opts...)
 	path := fmt.Sprintf("external_accounts/%s", externalAccountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -54,6 +56,7 @@ func (r *ExternalAccountService) Get(ctx context.Context, externalAccountID stri
 // Update an External Account
 func (r *ExternalAccountService) Update(ctx context.Context, externalAccountID string, body ExternalAccountUpdateParams, opts ...option.RequestOption) (res *ExternalAccount, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("ExternalAccounts.Update")}, opts...)
 	path := fmt.Sprintf("external_accounts/%s", externalAccountID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -63,7 +66,7 @@ func (r *ExternalAccountService) Update(ctx context.Context, externalAccountID s
 func (r *ExternalAccountService) List(ctx context.Context, query ExternalAccountListParams, opts ...option.RequestOption) (res *shared.Page[ExternalAccount], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("ExternalAccounts.List")}, opts...)
 	path := "external_accounts"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -127,6 +130,14 @@ func (r *ExternalAccount) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ExternalAccount) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r externalAccountJSON) RawJSON() string {
+	return r.raw
+}
+
 // The type of the account to which the transfer will be sent.
 type ExternalAccountFunding string
 
@@ -139,6 +150,14 @@ const (
 	ExternalAccountFundingOther ExternalAccountFunding = "other"
 )
 
+func (r ExternalAccountFunding) IsKnown() bool {
+	switch r {
+	case ExternalAccountFundingChecking, ExternalAccountFundingSavings, ExternalAccountFundingOther:
+		return true
+	}
+	return false
+}
+
 // The External Account's status.
 type ExternalAccountStatus string
 
@@ -149,6 +168,14 @@ const (
 	ExternalAccountStatusArchived ExternalAccountStatus = "archived"
 )
 
+func (r ExternalAccountStatus) IsKnown() bool {
+	switch r {
+	case ExternalAccountStatusActive, ExternalAccountStatusArchived:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `external_account`.
 type ExternalAccountType string
@@ -157,6 +184,14 @@ const (
 	ExternalAccountTypeExternalAccount ExternalAccountType = "external_account"
 )
 
+func (r ExternalAccountType) IsKnown() bool {
+	switch r {
+	case ExternalAccountTypeExternalAccount:
+		return true
+	}
+	return false
+}
+
 // If you have verified ownership of the External Account.
 type ExternalAccountVerificationStatus string
 
@@ -169,6 +204,14 @@ const (
 	ExternalAccountVerificationStatusVerified ExternalAccountVerificationStatus = "verified"
 )
 
+func (r ExternalAccountVerificationStatus) IsKnown() bool {
+	switch r {
+	case ExternalAccountVerificationStatusUnverified, ExternalAccountVerificationStatusPending, ExternalAccountVerificationStatusVerified:
+		return true
+	}
+	return false
+}
+
 type ExternalAccountNewParams struct {
 	// The account number for the destination account.
 	AccountNumber param.Field[string] `json:"account_number,required"`
@@ -197,6 +240,14 @@ const (
 	ExternalAccountNewParamsFundingOther ExternalAccountNewParamsFunding = "other"
 )
 
+func (r ExternalAccountNewParamsFunding) IsKnown() bool {
+	switch r {
+	case ExternalAccountNewParamsFundingChecking, ExternalAccountNewParamsFundingSavings, ExternalAccountNewParamsFundingOther:
+		return true
+	}
+	return false
+}
+
 type ExternalAccountUpdateParams struct {
 	// The description you choose to give the external account.
 	Description param.Field[string] `json:"description"`
@@ -218,6 +269,14 @@ const (
 	ExternalAccountUpdateParamsStatusArchived ExternalAccountUpdateParamsStatus = "archived"
 )
 
+func (r ExternalAccountUpdateParamsStatus) IsKnown() bool {
+	switch r {
+	case ExternalAccountUpdateParamsStatusActive, ExternalAccountUpdateParamsStatusArchived:
+		return true
+	}
+	return false
+}
+
 type ExternalAccountListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
@@ -262,3 +321,11 @@ const (
 	// The External Account is archived and won't appear in the dashboard.
 	ExternalAccountListParamsStatusInArchived ExternalAccountListParamsStatusIn = "archived"
 )
+
+func (r ExternalAccountListParamsStatusIn) IsKnown() bool {
+	switch r {
+	case ExternalAccountListParamsStatusInActive, ExternalAccountListParamsStatusInArchived:
+		return true
+	}
+	return false
+}
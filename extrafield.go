@@ -0,0 +1,42 @@
+package increase
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/increase/increase-go/internal/apijson"
+)
+
+// ExtraField decodes a property of a response model which the model has no
+// field for, typically because it was added to the API after this version of
+// the SDK. It is decoded from the JSON the model was decoded from, following the
+// same rules as the model's own fields. The path is a dot-separated list of
+// property names and array indexes, relative to the model, such as
+// "new_field", "source.card_settlement.new_field" or "elements[0].new_field".
+// A dot within a property name is escaped with a backslash.
+//
+// ok reports whether the property is present and not null. An error is
+// returned if model is not a response model, or if the property cannot be
+// decoded into a T.
+//
+//	tier, ok, err := increase.ExtraField[string](account, "loyalty_tier")
+func ExtraField[T any](model any, path string) (value T, ok bool, err error) {
+	v := reflect.ValueOf(model)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	var meta interface{ RawJSON() string }
+	if v.Kind() == reflect.Struct {
+		if field := v.FieldByName("JSON"); field.IsValid() {
+			meta, _ = field.Interface().(interface{ RawJSON() string })
+		}
+	}
+	if meta == nil {
+		return value, false, fmt.Errorf("increase: expected a response model, got %T", model)
+	}
+	ok, err = apijson.UnmarshalPath([]byte(meta.RawJSON()), path, &value)
+	if err != nil {
+		return value, ok, fmt.Errorf("increase: error decoding %s: %w", path, err)
+	}
+	return value, ok, nil
+}
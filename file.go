@@ -43,6 +43,7 @@ func NewFileService(opts ...option.RequestOption) (r *FileService) {
 // upload, as well as the parameters for creating a file.
 func (r *FileService) New(ctx context.Context, body FileNewParams, opts ...option.RequestOption) (res *File, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Files.New")}, opts...)
 	path := "files"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -51,6 +52,7 @@ func (r *FileService) New(ctx context.Context, body FileNewParams, opts ...optio
 // Retrieve a File
 func (r *FileService) Get(ctx context.Context, fileID string, opts ...option.RequestOption) (res *File, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Files.Get")}, opts...)
 	path := fmt.Sprintf("files/%s", fileID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -60,7 +62,7 @@ func (r *FileService) Get(ctx context.Context, fileID string, opts ...option.Req
 func (r *FileService) List(ctx context.Context, query FileListParams, opts ...option.RequestOption) (res *shared.Page[File], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Files.List")}, opts...)
 	path := "files"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -127,6 +129,14 @@ func (r *File) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r File) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r fileJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether the File was generated by Increase or by you and sent to Increase.
 type FileDirection string
 
@@ -137,6 +147,14 @@ const (
 	FileDirectionFromIncrease FileDirection = "from_increase"
 )
 
+func (r FileDirection) IsKnown() bool {
+	switch r {
+	case FileDirectionToIncrease, FileDirectionFromIncrease:
+		return true
+	}
+	return false
+}
+
 // What the File will be used for. We may add additional possible values for this
 // enum over time; your application should be able to handle such additions
 // gracefully.
@@ -183,6 +201,14 @@ const (
 	FilePurposeExport FilePurpose = "export"
 )
 
+func (r FilePurpose) IsKnown() bool {
+	switch r {
+	case FilePurposeCheckImageFront, FilePurposeCheckImageBack, FilePurposeMailedCheckImage, FilePurposeForm1099Int, FilePurposeFormSS4, FilePurposeIdentityDocument, FilePurposeIncreaseStatement, FilePurposeOther, FilePurposeTrustFormationDocument, FilePurposeDigitalWalletArtwork, FilePurposeDigitalWalletAppIcon, FilePurposePhysicalCardFront, FilePurposePhysicalCardBack, FilePurposePhysicalCardCarrier, FilePurposeDocumentRequest, FilePurposeEntitySupplementalDocument, FilePurposeExport:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `file`.
 type FileType string
@@ -191,6 +217,14 @@ const (
 	FileTypeFile FileType = "file"
 )
 
+func (r FileType) IsKnown() bool {
+	switch r {
+	case FileTypeFile:
+		return true
+	}
+	return false
+}
+
 type FileNewParams struct {
 	// The file contents. This should follow the specifications of
 	// [RFC 7578](https://datatracker.ietf.org/doc/html/rfc7578) which defines file
@@ -253,6 +287,14 @@ const (
 	FileNewParamsPurposeEntitySupplementalDocument FileNewParamsPurpose = "entity_supplemental_document"
 )
 
+func (r FileNewParamsPurpose) IsKnown() bool {
+	switch r {
+	case FileNewParamsPurposeCheckImageFront, FileNewParamsPurposeCheckImageBack, FileNewParamsPurposeMailedCheckImage, FileNewParamsPurposeFormSS4, FileNewParamsPurposeIdentityDocument, FileNewParamsPurposeOther, FileNewParamsPurposeTrustFormationDocument, FileNewParamsPurposeDigitalWalletArtwork, FileNewParamsPurposeDigitalWalletAppIcon, FileNewParamsPurposePhysicalCardFront, FileNewParamsPurposePhysicalCardCarrier, FileNewParamsPurposeDocumentRequest, FileNewParamsPurposeEntitySupplementalDocument:
+		return true
+	}
+	return false
+}
+
 type FileListParams struct {
 	CreatedAt param.Field[FileListParamsCreatedAt] `query:"created_at"`
 	// Return the page of entries after this one.
@@ -351,3 +393,11 @@ const (
 	// The results of an Export you requested via the dashboard or API.
 	FileListParamsPurposeInExport FileListParamsPurposeIn = "export"
 )
+
+func (r FileListParamsPurposeIn) IsKnown() bool {
+	switch r {
+	case FileListParamsPurposeInCheckImageFront, FileListParamsPurposeInCheckImageBack, FileListParamsPurposeInMailedCheckImage, FileListParamsPurposeInForm1099Int, FileListParamsPurposeInFormSS4, FileListParamsPurposeInIdentityDocument, FileListParamsPurposeInIncreaseStatement, FileListParamsPurposeInOther, FileListParamsPurposeInTrustFormationDocument, FileListParamsPurposeInDigitalWalletArtwork, FileListParamsPurposeInDigitalWalletAppIcon, FileListParamsPurposeInPhysicalCardFront, FileListParamsPurposeInPhysicalCardBack, FileListParamsPurposeInPhysicalCardCarrier, FileListParamsPurposeInDocumentRequest, FileListParamsPurposeInEntitySupplementalDocument, FileListParamsPurposeInExport:
+		return true
+	}
+	return false
+}
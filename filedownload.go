@@ -0,0 +1,243 @@
+package increase
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/increase/increase-go/internal/requestconfig"
+	"github.com/increase/increase-go/option"
+)
+
+// ErrDownloadIncomplete is returned when a downloaded file does not have the
+// length or checksum that its download response said it would.
+var ErrDownloadIncomplete = errors.New("increase: downloaded file is incomplete")
+
+// Download writes the contents of a File to w. It resolves the file's download
+// URL and streams it using the client's HTTP client, middleware and retries. A
+// download URL on another host than the API is requested without the API key,
+// and, with [option.WithIsolatedDownloads], without the client's circuit
+// breaker and rate limiter. If the download fails part way
+// through, it is resumed from where it stopped with a Range request, up to the
+// client's maximum number of retries. The length of the download, and its MD5
+// checksum when the response has one, are checked once it completes, and
+// [ErrDownloadIncomplete] is returned if they do not match.
+func (r *FileService) Download(ctx context.Context, fileID string, w io.Writer, opts ...option.RequestOption) (res *File, err error) {
+	res, body, err := r.download(ctx, "Files.Download", fileID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	if _, err = io.Copy(w, body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadTo downloads the contents of a File, as with [FileService.Download],
+// to the file at path. The contents are written to a temporary file in the same
+// directory, which replaces path once the download is complete, so path is left
+// untouched if the download fails.
+func (r *FileService) DownloadTo(ctx context.Context, fileID string, path string, opts ...option.RequestOption) (res *File, err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.download")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	res, err = r.Download(ctx, fileID, tmp, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadReader returns the contents of a File as a reader, which is resumed
+// and checked as with [FileService.Download]. The download has started, and
+// its response headers have been received, when DownloadReader returns. The
+// reader must be closed.
+func (r *FileService) DownloadReader(ctx context.Context, fileID string, opts ...option.RequestOption) (io.ReadCloser, error) {
+	_, body, err := r.download(ctx, "Files.DownloadReader", fileID, opts...)
+	return body, err
+}
+
+// download gets the File and starts downloading its contents, in requests
+// named after the given operation.
+func (r *FileService) download(ctx context.Context, operation string, fileID string, opts ...option.RequestOption) (*File, io.ReadCloser, error) {
+	file, err := r.Get(ctx, fileID, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if file.DownloadURL == "" {
+		return nil, nil, fmt.Errorf("increase: file %s has no download URL", file.ID)
+	}
+	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation(operation)}, opts...)
+	body := &downloadReader{ctx: ctx, url: file.DownloadURL, opts: opts, total: -1}
+	if err := body.open(); err != nil {
+		return nil, nil, err
+	}
+	return file, body, nil
+}
+
+// downloadReader reads a download, sending a Range request for the rest of it
+// if reading its body fails.
+type downloadReader struct {
+	ctx  context.Context
+	url  string
+	opts []option.RequestOption
+
+	body    io.ReadCloser
+	read    int64
+	total   int64
+	resumes int
+	// The client's maximum number of retries, which also limits the number of
+	// times the download is resumed.
+	maxResumes int
+	checksum   hash.Hash
+	expected   []byte
+	err        error
+}
+
+func (r *downloadReader) open() error {
+	var res *http.Response
+	opts := r.opts
+	if r.read > 0 {
+		opts = append(opts[:len(opts):len(opts)], option.WithHeader("Range", fmt.Sprintf("bytes=%d-", r.read)))
+	}
+	cfg, err := requestconfig.NewRequestConfig(r.ctx, http.MethodGet, r.url, nil, &res, opts...)
+	if err != nil {
+		return err
+	}
+	// Download URLs are signed, and may be served by another host, which
+	// should not be sent the API key.
+	if u, err := url.Parse(r.url); err == nil && u.IsAbs() && cfg.BaseURL != nil && u.Host != cfg.BaseURL.Host {
+		cfg.Request.Header.Del("Authorization")
+		if cfg.IsolateDownloads {
+			cfg.CircuitBreaker = nil
+			cfg.RateLimiter = nil
+		}
+	}
+	cfg.Request.Header.Set("Accept", "*/*")
+	r.maxResumes = cfg.MaxRetries
+	if err = cfg.Execute(); err != nil {
+		return err
+	}
+
+	if r.read == 0 {
+		r.total = res.ContentLength
+		if sum, err := base64.StdEncoding.DecodeString(res.Header.Get("Content-MD5")); err == nil && len(sum) == md5.Size {
+			r.checksum, r.expected = md5.New(), sum
+		}
+		r.body = res.Body
+		return nil
+	}
+
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+		if start, ok := contentRangeStart(res.Header.Get("Content-Range")); !ok || start != r.read {
+			res.Body.Close()
+			return fmt.Errorf("increase: resumed download starts at the wrong offset, Content-Range is %q", res.Header.Get("Content-Range"))
+		}
+	default:
+		// The server ignored the Range header, so skip what has already been
+		// read.
+		if _, err := io.CopyN(io.Discard, res.Body, r.read); err != nil {
+			res.Body.Close()
+			return err
+		}
+	}
+	r.body = res.Body
+	return nil
+}
+
+func (r *downloadReader) Read(p []byte) (int, error) {
+	for {
+		if r.err != nil {
+			return 0, r.err
+		}
+		n, err := r.body.Read(p)
+		r.read += int64(n)
+		if r.checksum != nil {
+			r.checksum.Write(p[:n])
+		}
+		switch {
+		case err == io.EOF:
+			r.err = r.verify()
+			if r.err == nil {
+				r.err = io.EOF
+			}
+			return n, r.err
+		case err != nil:
+			if r.ctx.Err() != nil || r.resumes >= r.maxResumes {
+				r.err = err
+				return n, err
+			}
+			r.body.Close()
+			r.resumes += 1
+			if err := r.open(); err != nil {
+				r.err = err
+				return n, err
+			}
+			if n > 0 {
+				return n, nil
+			}
+		default:
+			return n, nil
+		}
+	}
+}
+
+// verify checks the download once its body has been read to the end.
+func (r *downloadReader) verify() error {
+	if r.total >= 0 && r.read != r.total {
+		return fmt.Errorf("%w: read %d of %d bytes", ErrDownloadIncomplete, r.read, r.total)
+	}
+	if r.checksum != nil && !bytes.Equal(r.checksum.Sum(nil), r.expected) {
+		return fmt.Errorf("%w: MD5 checksum does not match Content-MD5", ErrDownloadIncomplete)
+	}
+	return nil
+}
+
+func (r *downloadReader) Close() error {
+	if r.err == nil {
+		r.err = os.ErrClosed
+	}
+	return r.body.Close()
+}
+
+// contentRangeStart returns the first byte position of a Content-Range header
+// such as "bytes 100-199/200".
+func contentRangeStart(header string) (int64, bool) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	start, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	return n, err == nil
+}
@@ -0,0 +1,200 @@
+package increase_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+const fileContents = "The quick brown fox jumps over the lazy dog."
+
+// failingBody returns its contents and then fails, as if the connection was
+// dropped.
+type failingBody struct {
+	io.Reader
+}
+
+func (b failingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (b failingBody) Close() error { return nil }
+
+// downloadClient serves a file whose download URL is on another host, and
+// sends requests for it to serve.
+func downloadClient(serve func(req *http.Request) *http.Response) *increase.Client {
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "localhost:4010" {
+			return jsonResponse(req, 200, `{"id":"file_makxrc67oh9l6sg7w9yc","type":"file","download_url":"https://files.example.com/file_makxrc67oh9l6sg7w9yc?signature=abc"}`), nil
+		}
+		return serve(req), nil
+	})
+	return increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+}
+
+func TestFileDownloadResumes(t *testing.T) {
+	var ranges []string
+	client := downloadClient(func(req *http.Request) *http.Response {
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header for the download host, got %q", auth)
+		}
+		ranges = append(ranges, req.Header.Get("Range"))
+		header := http.Header{"Content-Type": {"text/plain"}}
+		if len(ranges) == 1 {
+			sum := md5.Sum([]byte(fileContents))
+			header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+			return &http.Response{
+				StatusCode:    200,
+				Header:        header,
+				ContentLength: int64(len(fileContents)),
+				Body:          failingBody{strings.NewReader(fileContents[:10])},
+				Request:       req,
+			}
+		}
+		header.Set("Content-Range", fmt.Sprintf("bytes 10-%d/%d", len(fileContents)-1, len(fileContents)))
+		return &http.Response{
+			StatusCode: 206,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(fileContents[10:])),
+			Request:    req,
+		}
+	})
+
+	var buf strings.Builder
+	file, err := client.Files.Download(context.Background(), "file_makxrc67oh9l6sg7w9yc", &buf)
+	if err != nil {
+		t.Fatalf("expected download to succeed, got %v", err)
+	}
+	if file.ID != "file_makxrc67oh9l6sg7w9yc" {
+		t.Errorf("expected the file to be returned, got %q", file.ID)
+	}
+	if buf.String() != fileContents {
+		t.Errorf("expected the file contents, got %q", buf.String())
+	}
+	if len(ranges) != 2 || ranges[0] != "" || ranges[1] != "bytes=10-" {
+		t.Errorf("expected the download to be resumed from byte 10, got ranges %q", ranges)
+	}
+}
+
+func TestFileDownloadIncomplete(t *testing.T) {
+	client := downloadClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode:    200,
+			Header:        http.Header{},
+			ContentLength: int64(len(fileContents)) + 5,
+			Body:          io.NopCloser(strings.NewReader(fileContents)),
+			Request:       req,
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "statement.txt")
+	_, err := client.Files.DownloadTo(context.Background(), "file_makxrc67oh9l6sg7w9yc", path)
+	if !errors.Is(err, increase.ErrDownloadIncomplete) {
+		t.Fatalf("expected ErrDownloadIncomplete, got %v", err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 0 {
+		t.Errorf("expected no files to be left behind, got %d", len(entries))
+	}
+}
+
+func TestFileDownloadTo(t *testing.T) {
+	client := downloadClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode:    200,
+			Header:        http.Header{},
+			ContentLength: int64(len(fileContents)),
+			Body:          io.NopCloser(strings.NewReader(fileContents)),
+			Request:       req,
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "statement.txt")
+	if _, err := client.Files.DownloadTo(context.Background(), "file_makxrc67oh9l6sg7w9yc", path); err != nil {
+		t.Fatalf("expected download to succeed, got %v", err)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if string(contents) != fileContents {
+		t.Errorf("expected the file contents, got %q", contents)
+	}
+}
+
+func TestFileDownloadFromStorageHost(t *testing.T) {
+	var downloads int
+	transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "localhost:4010" {
+			return jsonResponse(req, 200, `{"id":"file_makxrc67oh9l6sg7w9yc","type":"file","download_url":"https://files.example.com/file_makxrc67oh9l6sg7w9yc?signature=abc"}`), nil
+		}
+		downloads++
+		status := 503
+		if downloads > 3 {
+			status = 403
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": {"application/xml"}, "Retry-After": {"0"}},
+			Body:       io.NopCloser(strings.NewReader("<Error><Code>AccessDenied</Code></Error>")),
+			Request:    req,
+		}, nil
+	})
+	var middlewareHosts []string
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithMiddleware(func(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+			if req.URL.Host != "localhost:4010" {
+				middlewareHosts = append(middlewareHosts, req.URL.Host)
+				if auth := req.Header.Get("Authorization"); auth != "" {
+					t.Errorf("expected no Authorization header for the storage host, got %q", auth)
+				}
+			}
+			return next(req)
+		}),
+		option.WithCircuitBreaker(option.CircuitBreakerConfig{MinimumAttempts: 1, OpenDuration: time.Minute}),
+		option.WithIsolatedDownloads(),
+	)
+
+	// The failures of the storage host are retried, and passed through the
+	// client's middleware, but do not open the circuit for the API.
+	_, err := client.Files.DownloadReader(context.Background(), "file_makxrc67oh9l6sg7w9yc")
+	var apierr *increase.Error
+	if !errors.As(err, &apierr) || apierr.StatusCode != 503 {
+		t.Fatalf("expected a 503 status error, got %v", err)
+	}
+	if downloads != 3 {
+		t.Errorf("expected the download to be retried, got %d attempts", downloads)
+	}
+	if len(middlewareHosts) != 3 || middlewareHosts[0] != "files.example.com" {
+		t.Errorf("expected each attempt to be passed through the middleware, got %v", middlewareHosts)
+	}
+	_, err = client.Files.DownloadReader(context.Background(), "file_makxrc67oh9l6sg7w9yc")
+	if !errors.As(err, &apierr) || apierr.StatusCode != 403 {
+		t.Fatalf("expected a 403 status error for the non-JSON body, got %v", err)
+	}
+	if errors.Is(err, increase.ErrCircuitOpen) {
+		t.Errorf("expected the storage host's failures not to open the circuit")
+	}
+}
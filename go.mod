@@ -1,11 +1,14 @@
 module github.com/increase/increase-go
 
-go 1.19
+go 1.21
+
+require (
+	github.com/google/uuid v1.3.0
+	github.com/tidwall/gjson v1.14.4
+	github.com/tidwall/sjson v1.2.5
+)
 
 require (
-	github.com/google/uuid v1.3.0 // indirect
-	github.com/tidwall/gjson v1.14.4 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
 	github.com/tidwall/pretty v1.2.1 // indirect
-	github.com/tidwall/sjson v1.2.5 // indirect
 )
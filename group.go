@@ -32,6 +32,7 @@ func NewGroupService(opts ...option.RequestOption) (r *GroupService) {
 // Returns details for the currently authenticated Group.
 func (r *GroupService) GetDetails(ctx context.Context, opts ...option.RequestOption) (res *Group, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Groups.GetDetails")}, opts...)
 	path := "groups/current"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -71,6 +72,14 @@ func (r *Group) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Group) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r groupJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the Group is allowed to create ACH debits.
 type GroupACHDebitStatus string
 
@@ -81,6 +90,14 @@ const (
 	GroupACHDebitStatusEnabled GroupACHDebitStatus = "enabled"
 )
 
+func (r GroupACHDebitStatus) IsKnown() bool {
+	switch r {
+	case GroupACHDebitStatusDisabled, GroupACHDebitStatusEnabled:
+		return true
+	}
+	return false
+}
+
 // If the Group is activated or not.
 type GroupActivationStatus string
 
@@ -91,6 +108,14 @@ const (
 	GroupActivationStatusActivated GroupActivationStatus = "activated"
 )
 
+func (r GroupActivationStatus) IsKnown() bool {
+	switch r {
+	case GroupActivationStatusUnactivated, GroupActivationStatusActivated:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `group`.
 type GroupType string
@@ -98,3 +123,11 @@ type GroupType string
 const (
 	GroupTypeGroup GroupType = "group"
 )
+
+func (r GroupType) IsKnown() bool {
+	switch r {
+	case GroupTypeGroup:
+		return true
+	}
+	return false
+}
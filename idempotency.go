@@ -0,0 +1,312 @@
+package increase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/increase/increase-go/option"
+)
+
+// IdempotencyStore persists the idempotency key and final response of each
+// operation, so that an operation retried after a crash or restart is not
+// executed twice. Configure one with [option.WithIdempotencyStore] and identify
+// operations with [option.WithOperationID].
+type IdempotencyStore = option.IdempotencyStore
+
+// IdempotencyRecord is the entry an [IdempotencyStore] keeps for one operation.
+type IdempotencyRecord = option.IdempotencyRecord
+
+// MemoryIdempotencyStore is an [IdempotencyStore] that keeps records in memory.
+// Records do not survive a restart, so it is mostly useful for tests and
+// short-lived processes. Use [NewMemoryIdempotencyStore] to create one.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]IdempotencyRecord
+}
+
+// NewMemoryIdempotencyStore returns an empty [MemoryIdempotencyStore].
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{records: map[string]IdempotencyRecord{}}
+}
+
+func (s *MemoryIdempotencyStore) Load(ctx context.Context, operationID string) (IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[operationID]
+	return record, ok, nil
+}
+
+func (s *MemoryIdempotencyStore) Save(ctx context.Context, record IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[record.OperationID] = record
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Delete(ctx context.Context, operationID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, operationID)
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) SaveIfAbsent(ctx context.Context, record IdempotencyRecord) (IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[record.OperationID]; ok {
+		return existing, false, nil
+	}
+	s.records[record.OperationID] = record
+	return record, true, nil
+}
+
+// FileIdempotencyStore is an [IdempotencyStore] backed by an append-only
+// journal file, with one JSON record per line. Every save is synced to disk
+// before it returns. Records are also kept in memory, so the journal is only
+// read when it is opened, and a journal must only be opened by one
+// FileIdempotencyStore at a time. Use [OpenFileIdempotencyStore] to create one.
+//
+// As operations complete, the journal is compacted by rewriting it with only
+// the latest record of each operation.
+type FileIdempotencyStore struct {
+	// Retention, if positive, is how long the record of a completed operation
+	// is kept. Expired records are removed when the journal is compacted, after
+	// which the operation is treated as a new one. Set it before the store is
+	// used.
+	Retention time.Duration
+
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	records map[string]IdempotencyRecord
+	// The size of the journal up to the end of its last complete line.
+	size int64
+	// The number of lines in the journal, and the number at which it is next
+	// compacted.
+	lines     int
+	compactAt int
+}
+
+// minIdempotencyJournalCompaction is the fewest lines a journal has before it
+// is compacted.
+const minIdempotencyJournalCompaction = 1024
+
+// OpenFileIdempotencyStore opens the journal at the given path, creating it if
+// it does not exist. A truncated final line, as left by a crash during a write,
+// is discarded.
+func OpenFileIdempotencyStore(path string) (*FileIdempotencyStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	records, lines, size, err := readIdempotencyJournal(file)
+	if err == nil {
+		err = file.Truncate(size)
+	}
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error reading idempotency journal %s: %w", path, err)
+	}
+	s := &FileIdempotencyStore{path: path, file: file, records: records, size: size, lines: lines}
+	s.compactAt = max(2*len(records), lines, minIdempotencyJournalCompaction)
+	return s, nil
+}
+
+// idempotencyJournalEntry is a line of an idempotency journal, which is either a
+// record or, if Deleted is true, the deletion of the operation's record.
+type idempotencyJournalEntry struct {
+	IdempotencyRecord
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// readIdempotencyJournal returns the records in the journal, and the number of
+// lines and size of the journal up to the end of its last complete line.
+func readIdempotencyJournal(r io.Reader) (records map[string]IdempotencyRecord, lines int, size int64, err error) {
+	records = map[string]IdempotencyRecord{}
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// A final line without a newline was not completely written.
+			return records, lines, size, nil
+		}
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		size += int64(len(line))
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var entry idempotencyJournalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, 0, 0, err
+		}
+		if entry.Deleted {
+			delete(records, entry.OperationID)
+		} else {
+			records[entry.OperationID] = entry.IdempotencyRecord
+		}
+		lines++
+	}
+}
+
+func (s *FileIdempotencyStore) Load(ctx context.Context, operationID string) (IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[operationID]
+	return record, ok, nil
+}
+
+func (s *FileIdempotencyStore) Save(ctx context.Context, record IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.append(record)
+}
+
+func (s *FileIdempotencyStore) Delete(ctx context.Context, operationID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[operationID]; !ok {
+		return nil
+	}
+	entry := idempotencyJournalEntry{IdempotencyRecord: IdempotencyRecord{OperationID: operationID}, Deleted: true}
+	if err := s.write(entry); err != nil {
+		return err
+	}
+	delete(s.records, operationID)
+	return nil
+}
+
+func (s *FileIdempotencyStore) SaveIfAbsent(ctx context.Context, record IdempotencyRecord) (IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[record.OperationID]; ok {
+		return existing, false, nil
+	}
+	if err := s.append(record); err != nil {
+		return IdempotencyRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// append writes record to the journal, compacting the journal once enough of
+// its lines have been replaced by later records. s.mu must be held.
+func (s *FileIdempotencyStore) append(record IdempotencyRecord) error {
+	if err := s.write(idempotencyJournalEntry{IdempotencyRecord: record}); err != nil {
+		return err
+	}
+	s.records[record.OperationID] = record
+	if record.Completed && s.lines >= s.compactAt {
+		// The record is already durable, so a failed compaction is tried
+		// again after a later save.
+		s.compact()
+	}
+	return nil
+}
+
+// write appends a line to the journal and syncs it. If the line cannot be
+// written completely, the journal is truncated to where it started, so that
+// the next line is not written onto the end of it. s.mu must be held.
+func (s *FileIdempotencyStore) write(entry idempotencyJournalEntry) (err error) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	defer func() {
+		if err != nil {
+			s.file.Truncate(s.size)
+			s.file.Seek(s.size, io.SeekStart)
+		}
+	}()
+	if _, err = s.file.Write(line); err != nil {
+		return err
+	}
+	if err = s.file.Sync(); err != nil {
+		return err
+	}
+	s.size += int64(len(line))
+	s.lines++
+	return nil
+}
+
+// compact replaces the journal with one holding the latest record of each
+// operation whose record has not expired. s.mu must be held.
+func (s *FileIdempotencyStore) compact() (err error) {
+	now := time.Now()
+	ids := make([]string, 0, len(s.records))
+	for id, record := range s.records {
+		if s.Retention > 0 && record.Completed && now.Sub(record.CompletedAt) > s.Retention {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var buf bytes.Buffer
+	for _, id := range ids {
+		line, err := json.Marshal(s.records[id])
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if _, err = tmp.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+	// Later records are appended to the compacted journal, which is still
+	// open at its end.
+	s.file.Close()
+	s.file = tmp
+	s.size = int64(buf.Len())
+
+	records := make(map[string]IdempotencyRecord, len(ids))
+	for _, id := range ids {
+		records[id] = s.records[id]
+	}
+	s.records = records
+	s.lines = len(ids)
+	s.compactAt = max(2*s.lines, minIdempotencyJournalCompaction)
+
+	// Sync the directory, so that the rename survives a crash.
+	dir, err := os.Open(filepath.Dir(s.path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// Close closes the journal file.
+func (s *FileIdempotencyStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
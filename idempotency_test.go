@@ -0,0 +1,280 @@
+package increase_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+func TestFileIdempotencyStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	params := increase.AccountNewParams{Name: increase.F("My First Increase Account")}
+
+	// The first process fails to hear back from the API.
+	store, err := increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	transport := &recordingTransport{statuses: []int{500, 200}}
+	client := increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithIdempotencyStore(store),
+		option.WithMaxRetries(0),
+	)
+	_, err = client.Accounts.New(context.Background(), params, option.WithOperationID("op_1"))
+	if err == nil {
+		t.Fatal("expected an error from the first attempt")
+	}
+	store.Close()
+
+	// After a restart, the retried operation reuses the original key.
+	store, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	client = increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithIdempotencyStore(store),
+	)
+	_, err = client.Accounts.New(context.Background(), params, option.WithOperationID("op_1"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if len(transport.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(transport.requests))
+	}
+	first := transport.requests[0].Header.Get("Idempotency-Key")
+	if second := transport.requests[1].Header.Get("Idempotency-Key"); first == "" || first != second {
+		t.Errorf("expected the same idempotency key after restart, got %q and %q", first, second)
+	}
+	store.Close()
+
+	// Once completed, the recorded response is returned without a request.
+	store, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer store.Close()
+	client = increase.NewClient(
+		option.WithBaseURL("http://localhost:4010"),
+		option.WithAPIKey("My API Key"),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithIdempotencyStore(store),
+	)
+	var meta increase.ResponseMeta
+	res, err := client.Accounts.New(context.Background(), params, option.WithOperationID("op_1"), option.WithResponseMeta(&meta))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if res == nil || len(transport.requests) != 2 {
+		t.Errorf("expected the recorded response without a new request, made %d requests", len(transport.requests))
+	}
+	if !meta.IdempotentReplayed || meta.Attempts != 0 || meta.IdempotencyKey != first {
+		t.Errorf("expected a replayed response with no attempts, got %+v", meta)
+	}
+}
+
+func TestFileIdempotencyStoreTruncatedJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	store, err := increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	err = store.Save(context.Background(), increase.IdempotencyRecord{OperationID: "op_1", IdempotencyKey: "key_1"})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	store.Close()
+
+	// Simulate a crash in the middle of writing a second record.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	f.WriteString(`{"operation_id":"op_2","idem`)
+	f.Close()
+
+	store, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	err = store.Save(context.Background(), increase.IdempotencyRecord{OperationID: "op_3", IdempotencyKey: "key_3"})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	store.Close()
+
+	store, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer store.Close()
+	for id, want := range map[string]bool{"op_1": true, "op_2": false, "op_3": true} {
+		if _, ok, _ := store.Load(context.Background(), id); ok != want {
+			t.Errorf("Load(%q) found = %v, want %v", id, ok, want)
+		}
+	}
+}
+
+func TestIdempotencyStoreConcurrentOperation(t *testing.T) {
+	fileStore, err := increase.OpenFileIdempotencyStore(filepath.Join(t.TempDir(), "journal.jsonl"))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer fileStore.Close()
+	stores := map[string]increase.IdempotencyStore{
+		"memory": increase.NewMemoryIdempotencyStore(),
+		"file":   fileStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			keys := map[string]bool{}
+			transport := funcTransport(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				keys[req.Header.Get("Idempotency-Key")] = true
+				mu.Unlock()
+				return jsonResponse(req, 500, "{}"), nil
+			})
+			client := increase.NewClient(
+				option.WithBaseURL("http://localhost:4010"),
+				option.WithAPIKey("My API Key"),
+				option.WithHTTPClient(&http.Client{Transport: transport}),
+				option.WithIdempotencyStore(store),
+				option.WithMaxRetries(0),
+			)
+
+			// Workers given the same operation, such as by a redelivered
+			// message, all send the key of whichever recorded it first.
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					client.Accounts.New(context.Background(), increase.AccountNewParams{Name: increase.F("My First Increase Account")}, option.WithOperationID("op_1"))
+				}()
+			}
+			wg.Wait()
+			if len(keys) != 1 {
+				t.Errorf("expected every worker to send the same idempotency key, got %v", keys)
+			}
+		})
+	}
+}
+
+func TestFileIdempotencyStoreCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	store, err := increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	store.Retention = time.Hour
+	ctx := context.Background()
+	err = store.Save(ctx, increase.IdempotencyRecord{OperationID: "op_expired", IdempotencyKey: "key_expired", Completed: true, CompletedAt: time.Now().Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	for i := 0; i < 600; i++ {
+		record := increase.IdempotencyRecord{OperationID: fmt.Sprintf("op_%d", i), IdempotencyKey: fmt.Sprintf("key_%d", i)}
+		if _, _, err := store.SaveIfAbsent(ctx, record); err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		record.Completed = true
+		record.CompletedAt = time.Now()
+		if err := store.Save(ctx, record); err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+	}
+	store.Close()
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if lines := bytes.Count(contents, []byte("\n")); lines >= 1024 {
+		t.Errorf("expected the journal to have been compacted, got %d lines", lines)
+	}
+	store, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer store.Close()
+	if _, ok, _ := store.Load(ctx, "op_expired"); ok {
+		t.Errorf("expected the expired record to be removed")
+	}
+	for i := 0; i < 600; i++ {
+		record, ok, _ := store.Load(ctx, fmt.Sprintf("op_%d", i))
+		if !ok || !record.Completed {
+			t.Fatalf("expected op_%d to be completed, got %+v", i, record)
+		}
+	}
+}
+
+func TestIdempotencyStoreRefusedOperation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	fileStore, err := increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	stores := map[string]increase.IdempotencyStore{
+		"memory": increase.NewMemoryIdempotencyStore(),
+		"file":   fileStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			transport := &recordingTransport{statuses: []int{400, 200}}
+			client := increase.NewClient(
+				option.WithBaseURL("http://localhost:4010"),
+				option.WithAPIKey("My API Key"),
+				option.WithHTTPClient(&http.Client{Transport: transport}),
+				option.WithIdempotencyStore(store),
+			)
+			params := increase.AccountNewParams{Name: increase.F("My First Increase Account")}
+			_, err := client.Accounts.New(context.Background(), params, option.WithOperationID("op_1"))
+			if err == nil {
+				t.Fatal("expected an error from the refused request")
+			}
+			if _, ok, _ := store.Load(context.Background(), "op_1"); ok {
+				t.Fatal("expected the refused operation to be forgotten")
+			}
+
+			// The corrected request is sent as a new operation.
+			_, err = client.Accounts.New(context.Background(), params, option.WithOperationID("op_1"))
+			if err != nil {
+				t.Fatalf("err should be nil: %s", err.Error())
+			}
+			if len(transport.requests) != 2 {
+				t.Fatalf("expected 2 requests, got %d", len(transport.requests))
+			}
+			first := transport.requests[0].Header.Get("Idempotency-Key")
+			if second := transport.requests[1].Header.Get("Idempotency-Key"); first == second {
+				t.Errorf("expected a new idempotency key after the refused request, got %q twice", first)
+			}
+		})
+	}
+
+	// The deletion is journaled, so the record stays deleted after a restart.
+	fileStore.Delete(context.Background(), "op_1")
+	fileStore.Close()
+	fileStore, err = increase.OpenFileIdempotencyStore(path)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer fileStore.Close()
+	if _, ok, _ := fileStore.Load(context.Background(), "op_1"); ok {
+		t.Errorf("expected the deleted record to stay deleted after a restart")
+	}
+}
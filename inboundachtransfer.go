@@ -38,6 +38,7 @@ func NewInboundACHTransferService(opts ...option.RequestOption) (r *InboundACHTr
 // Retrieve an Inbound ACH Transfer
 func (r *InboundACHTransferService) Get(ctx context.Context, inboundACHTransferID string, opts ...option.RequestOption) (res *InboundACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("InboundACHTransfers.Get")}, opts...)
 	path := fmt.Sprintf("inbound_ach_transfers/%s", inboundACHTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *InboundACHTransferService) Get(ctx context.Context, inboundACHTransferI
 func (r *InboundACHTransferService) List(ctx context.Context, query InboundACHTransferListParams, opts ...option.RequestOption) (res *shared.Page[InboundACHTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("InboundACHTransfers.List")}, opts...)
 	path := "inbound_ach_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -69,6 +70,7 @@ func (r *InboundACHTransferService) ListAutoPaging(ctx context.Context, query In
 // Decline an Inbound ACH Transfer
 func (r *InboundACHTransferService) Decline(ctx context.Context, inboundACHTransferID string, opts ...option.RequestOption) (res *InboundACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("InboundACHTransfers.Decline")}, opts...)
 	path := fmt.Sprintf("inbound_ach_transfers/%s/decline", inboundACHTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -77,6 +79,7 @@ func (r *InboundACHTransferService) Decline(ctx context.Context, inboundACHTrans
 // Create a notification of change for an Inbound ACH Transfer
 func (r *InboundACHTransferService) NotificationOfChange(ctx context.Context, inboundACHTransferID string, body InboundACHTransferNotificationOfChangeParams, opts ...option.RequestOption) (res *InboundACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("InboundACHTransfers.NotificationOfChange")}, opts...)
 	path := fmt.Sprintf("inbound_ach_transfers/%s/notification_of_change", inboundACHTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -85,6 +88,7 @@ func (r *InboundACHTransferService) NotificationOfChange(ctx context.Context, in
 // Return an Inbound ACH Transfer
 func (r *InboundACHTransferService) TransferReturn(ctx context.Context, inboundACHTransferID string, body InboundACHTransferTransferReturnParams, opts ...option.RequestOption) (res *InboundACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("InboundACHTransfers.TransferReturn")}, opts...)
 	path := fmt.Sprintf("inbound_ach_transfers/%s/transfer_return", inboundACHTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -173,6 +177,14 @@ func (r *InboundACHTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your transfer is accepted, this will contain details of the acceptance.
 type InboundACHTransferAcceptance struct {
 	// The time at which the transfer was accepted.
@@ -195,6 +207,14 @@ func (r *InboundACHTransferAcceptance) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional information sent from the originator.
 type InboundACHTransferAddenda struct {
 	// The type of addendum.
@@ -217,6 +237,14 @@ func (r *InboundACHTransferAddenda) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferAddenda) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferAddendaJSON) RawJSON() string {
+	return r.raw
+}
+
 // The type of addendum.
 type InboundACHTransferAddendaCategory string
 
@@ -225,6 +253,14 @@ const (
 	InboundACHTransferAddendaCategoryFreeform InboundACHTransferAddendaCategory = "freeform"
 )
 
+func (r InboundACHTransferAddendaCategory) IsKnown() bool {
+	switch r {
+	case InboundACHTransferAddendaCategoryFreeform:
+		return true
+	}
+	return false
+}
+
 // Unstructured `payment_related_information` passed through by the originator.
 type InboundACHTransferAddendaFreeform struct {
 	// Each entry represents an addendum received from the originator.
@@ -244,6 +280,14 @@ func (r *InboundACHTransferAddendaFreeform) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferAddendaFreeform) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferAddendaFreeformJSON) RawJSON() string {
+	return r.raw
+}
+
 type InboundACHTransferAddendaFreeformEntry struct {
 	// The payment related information passed in the addendum.
 	PaymentRelatedInformation string                                     `json:"payment_related_information,required"`
@@ -262,6 +306,14 @@ func (r *InboundACHTransferAddendaFreeformEntry) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferAddendaFreeformEntry) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferAddendaFreeformEntryJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your transfer is declined, this will contain details of the decline.
 type InboundACHTransferDecline struct {
 	// The time at which the transfer was declined.
@@ -287,6 +339,14 @@ func (r *InboundACHTransferDecline) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason for the transfer decline.
 type InboundACHTransferDeclineReason string
 
@@ -323,6 +383,14 @@ const (
 	InboundACHTransferDeclineReasonUserInitiated InboundACHTransferDeclineReason = "user_initiated"
 )
 
+func (r InboundACHTransferDeclineReason) IsKnown() bool {
+	switch r {
+	case InboundACHTransferDeclineReasonACHRouteCanceled, InboundACHTransferDeclineReasonACHRouteDisabled, InboundACHTransferDeclineReasonBreachesLimit, InboundACHTransferDeclineReasonCreditEntryRefusedByReceiver, InboundACHTransferDeclineReasonDuplicateReturn, InboundACHTransferDeclineReasonEntityNotActive, InboundACHTransferDeclineReasonGroupLocked, InboundACHTransferDeclineReasonInsufficientFunds, InboundACHTransferDeclineReasonMisroutedReturn, InboundACHTransferDeclineReasonReturnOfErroneousOrReversingDebit, InboundACHTransferDeclineReasonNoACHRoute, InboundACHTransferDeclineReasonOriginatorRequest, InboundACHTransferDeclineReasonTransactionNotAllowed, InboundACHTransferDeclineReasonUserInitiated:
+		return true
+	}
+	return false
+}
+
 // The direction of the transfer.
 type InboundACHTransferDirection string
 
@@ -333,6 +401,14 @@ const (
 	InboundACHTransferDirectionDebit InboundACHTransferDirection = "debit"
 )
 
+func (r InboundACHTransferDirection) IsKnown() bool {
+	switch r {
+	case InboundACHTransferDirectionCredit, InboundACHTransferDirectionDebit:
+		return true
+	}
+	return false
+}
+
 // If you initiate a notification of change in response to the transfer, this will
 // contain its details.
 type InboundACHTransferNotificationOfChange struct {
@@ -356,6 +432,14 @@ func (r *InboundACHTransferNotificationOfChange) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferNotificationOfChange) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferNotificationOfChangeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The status of the transfer.
 type InboundACHTransferStatus string
 
@@ -371,6 +455,14 @@ const (
 	InboundACHTransferStatusReturned InboundACHTransferStatus = "returned"
 )
 
+func (r InboundACHTransferStatus) IsKnown() bool {
+	switch r {
+	case InboundACHTransferStatusPending, InboundACHTransferStatusDeclined, InboundACHTransferStatusAccepted, InboundACHTransferStatusReturned:
+		return true
+	}
+	return false
+}
+
 // If your transfer is returned, this will contain details of the return.
 type InboundACHTransferTransferReturn struct {
 	// The reason for the transfer return.
@@ -396,6 +488,14 @@ func (r *InboundACHTransferTransferReturn) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundACHTransferTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundACHTransferTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason for the transfer return.
 type InboundACHTransferTransferReturnReason string
 
@@ -427,6 +527,14 @@ const (
 	InboundACHTransferTransferReturnReasonCorporateCustomerAdvisedNotAuthorized InboundACHTransferTransferReturnReason = "corporate_customer_advised_not_authorized"
 )
 
+func (r InboundACHTransferTransferReturnReason) IsKnown() bool {
+	switch r {
+	case InboundACHTransferTransferReturnReasonReturnedPerOdfiRequest, InboundACHTransferTransferReturnReasonAuthorizationRevokedByCustomer, InboundACHTransferTransferReturnReasonPaymentStopped, InboundACHTransferTransferReturnReasonCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, InboundACHTransferTransferReturnReasonRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, InboundACHTransferTransferReturnReasonBeneficiaryOrAccountHolderDeceased, InboundACHTransferTransferReturnReasonCreditEntryRefusedByReceiver, InboundACHTransferTransferReturnReasonDuplicateEntry, InboundACHTransferTransferReturnReasonCorporateCustomerAdvisedNotAuthorized:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_ach_transfer`.
 type InboundACHTransferType string
@@ -435,6 +543,14 @@ const (
 	InboundACHTransferTypeInboundACHTransfer InboundACHTransferType = "inbound_ach_transfer"
 )
 
+func (r InboundACHTransferType) IsKnown() bool {
+	switch r {
+	case InboundACHTransferTypeInboundACHTransfer:
+		return true
+	}
+	return false
+}
+
 type InboundACHTransferListParams struct {
 	// Filter Inbound ACH Tranfers to ones belonging to the specified Account.
 	AccountID param.Field[string]                                `query:"account_id"`
@@ -496,6 +612,14 @@ const (
 	InboundACHTransferListParamsStatusReturned InboundACHTransferListParamsStatus = "returned"
 )
 
+func (r InboundACHTransferListParamsStatus) IsKnown() bool {
+	switch r {
+	case InboundACHTransferListParamsStatusPending, InboundACHTransferListParamsStatusDeclined, InboundACHTransferListParamsStatusAccepted, InboundACHTransferListParamsStatusReturned:
+		return true
+	}
+	return false
+}
+
 type InboundACHTransferNotificationOfChangeParams struct {
 	// The updated account number to send in the notification of change.
 	UpdatedAccountNumber param.Field[string] `json:"updated_account_number"`
@@ -548,3 +672,11 @@ const (
 	// code is R29.
 	InboundACHTransferTransferReturnParamsReasonCorporateCustomerAdvisedNotAuthorized InboundACHTransferTransferReturnParamsReason = "corporate_customer_advised_not_authorized"
 )
+
+func (r InboundACHTransferTransferReturnParamsReason) IsKnown() bool {
+	switch r {
+	case InboundACHTransferTransferReturnParamsReasonReturnedPerOdfiRequest, InboundACHTransferTransferReturnParamsReasonAuthorizationRevokedByCustomer, InboundACHTransferTransferReturnParamsReasonPaymentStopped, InboundACHTransferTransferReturnParamsReasonCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, InboundACHTransferTransferReturnParamsReasonRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, InboundACHTransferTransferReturnParamsReasonBeneficiaryOrAccountHolderDeceased, InboundACHTransferTransferReturnParamsReasonCreditEntryRefusedByReceiver, InboundACHTransferTransferReturnParamsReasonDuplicateEntry, InboundACHTransferTransferReturnParamsReasonCorporateCustomerAdvisedNotAuthorized:
+		return true
+	}
+	return false
+}
@@ -37,6 +37,7 @@ func NewInboundWireDrawdownRequestService(opts ...option.RequestOption) (r *Inbo
 // Retrieve an Inbound Wire Drawdown Request
 func (r *InboundWireDrawdownRequestService) Get(ctx context.Context, inboundWireDrawdownRequestID string, opts ...option.RequestOption) (res *InboundWireDrawdownRequest, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("InboundWireDrawdownRequests.Get")}, opts...)
 	path := fmt.Sprintf("inbound_wire_drawdown_requests/%s", inboundWireDrawdownRequestID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -46,7 +47,7 @@ func (r *InboundWireDrawdownRequestService) Get(ctx context.Context, inboundWire
 func (r *InboundWireDrawdownRequestService) List(ctx context.Context, query InboundWireDrawdownRequestListParams, opts ...option.RequestOption) (res *shared.Page[InboundWireDrawdownRequest], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("InboundWireDrawdownRequests.List")}, opts...)
 	path := "inbound_wire_drawdown_requests"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -156,6 +157,14 @@ func (r *InboundWireDrawdownRequest) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InboundWireDrawdownRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r inboundWireDrawdownRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_wire_drawdown_request`.
 type InboundWireDrawdownRequestType string
@@ -164,6 +173,14 @@ const (
 	InboundWireDrawdownRequestTypeInboundWireDrawdownRequest InboundWireDrawdownRequestType = "inbound_wire_drawdown_request"
 )
 
+func (r InboundWireDrawdownRequestType) IsKnown() bool {
+	switch r {
+	case InboundWireDrawdownRequestTypeInboundWireDrawdownRequest:
+		return true
+	}
+	return false
+}
+
 type InboundWireDrawdownRequestListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
@@ -93,3 +93,45 @@ func TestNewIdempotentlyIntegration(t *testing.T) {
 		t.Errorf("Unique account ID seen in created records %d times, want exactly 1 time", accountIDSeen)
 	}
 }
+
+// Test that a caller-supplied idempotency key lets a create be safely repeated
+// by the caller, with the server replaying the original result.
+func TestWithIdempotencyKeyIntegration(t *testing.T) {
+	baseURL := "http://localhost:8077"
+	apiKey := "sk_test_1234567890"
+	if !testutil.CheckIntegrationServer(t, baseURL) {
+		return
+	}
+	client := increase.NewClient(
+		option.WithBaseURL(baseURL),
+		option.WithAPIKey(apiKey),
+	)
+	key := fmt.Sprintf("deposit_%08d", rand.Int())
+	params := increase.CheckDepositNewParams{
+		AccountID:        increase.F(fmt.Sprintf("account_%08d", rand.Int())),
+		Amount:           increase.F(int64(42)),
+		Currency:         increase.F("USD"),
+		FrontImageFileID: increase.F(fmt.Sprintf("file_%08d", rand.Int())),
+		BackImageFileID:  increase.F(fmt.Sprintf("file_%08d", rand.Int())),
+	}
+	var firstMeta, secondMeta increase.ResponseMeta
+	first, err := client.CheckDeposits.New(context.TODO(), params, option.WithIdempotencyKey(key), option.WithResponseMeta(&firstMeta))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	second, err := client.CheckDeposits.New(context.TODO(), params, option.WithIdempotencyKey(key), option.WithResponseMeta(&secondMeta))
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if first.ID != second.ID {
+		t.Errorf("Check Deposit IDs differ (%s, %s), want the same deposit replayed", first.ID, second.ID)
+	}
+	// The integration server fails the first attempt after applying it, so even
+	// the first call is served by a replay on its retry.
+	if firstMeta.Attempts != 2 || !firstMeta.IdempotentReplayed {
+		t.Errorf("first call meta = %+v, want 2 attempts with a replayed response", firstMeta)
+	}
+	if secondMeta.Attempts != 1 || !secondMeta.IdempotentReplayed {
+		t.Errorf("second call meta = %+v, want 1 attempt with a replayed response", secondMeta)
+	}
+}
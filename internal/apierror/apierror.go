@@ -4,7 +4,6 @@ package apierror
 
 import (
 	"fmt"
-	"io"
 	"net/http"
 	"net/http/httputil"
 
@@ -17,13 +16,13 @@ import (
 type Error struct {
 	Detail string `json:"detail,required,nullable"`
 	// All errors related to parsing the request parameters.
-	Errors     []interface{} `json:"errors,required"`
-	ResourceID string        `json:"resource_id,required"`
-	Status     ErrorStatus   `json:"status,required"`
-	Title      string        `json:"title,required"`
-	Type       ErrorType     `json:"type,required"`
-	RetryAfter int64         `json:"retry_after,nullable"`
-	JSON       errorJSON     `json:"-"`
+	Errors     []FieldError `json:"errors,required"`
+	ResourceID string       `json:"resource_id,required"`
+	Status     ErrorStatus  `json:"status,required"`
+	Title      string       `json:"title,required"`
+	Type       ErrorType    `json:"type,required"`
+	RetryAfter int64        `json:"retry_after,nullable"`
+	JSON       errorJSON    `json:"-"`
 	StatusCode int
 	Request    *http.Request
 	Response   *http.Response
@@ -46,9 +45,17 @@ func (r *Error) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Error) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r errorJSON) RawJSON() string {
+	return r.raw
+}
+
 func (r *Error) Error() string {
-	body, _ := io.ReadAll(r.Response.Body)
-	return fmt.Sprintf("%s \"%s\": %d %s %s", r.Request.Method, r.Request.URL, r.Response.StatusCode, http.StatusText(r.Response.StatusCode), string(body))
+	// Use the decoded body, as reading the response body would consume it.
+	return fmt.Sprintf("%s \"%s\": %d %s %s", r.Request.Method, r.Request.URL, r.Response.StatusCode, http.StatusText(r.Response.StatusCode), r.JSON.raw)
 }
 
 func (r *Error) DumpRequest(body bool) []byte {
@@ -64,14 +71,132 @@ func (r *Error) DumpResponse(body bool) []byte {
 	return out
 }
 
+// Is reports whether the error matches target, which is one of the sentinel
+// errors of this package such as [ErrObjectNotFound]. This allows matching on
+// the type of an API error with [errors.Is].
+func (r *Error) Is(target error) bool {
+	s, ok := target.(*sentinel)
+	return ok && r.Type == s.errorType
+}
+
+// FieldError describes a single request parameter that could not be parsed, as
+// listed in the Errors of an invalid_parameters_error.
+type FieldError struct {
+	// The request parameter the error relates to.
+	Field string `json:"field"`
+	// A description of what is wrong with the parameter.
+	Message string         `json:"message"`
+	JSON    fieldErrorJSON `json:"-"`
+}
+
+// fieldErrorJSON contains the JSON metadata for the struct [FieldError]
+type fieldErrorJSON struct {
+	Field       apijson.Field
+	Message     apijson.Field
+	raw         string
+	ExtraFields map[string]apijson.Field
+}
+
+func (r *FieldError) UnmarshalJSON(data []byte) (err error) {
+	return apijson.UnmarshalRoot(data, r)
+}
+
+func (r FieldError) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r fieldErrorJSON) RawJSON() string {
+	return r.raw
+}
+
 type ErrorStatus int64
 
 const (
+	ErrorStatus400 ErrorStatus = 400
+	ErrorStatus401 ErrorStatus = 401
+	ErrorStatus403 ErrorStatus = 403
+	ErrorStatus404 ErrorStatus = 404
 	ErrorStatus409 ErrorStatus = 409
+	ErrorStatus422 ErrorStatus = 422
+	ErrorStatus429 ErrorStatus = 429
+	ErrorStatus500 ErrorStatus = 500
 )
 
+func (r ErrorStatus) IsKnown() bool {
+	switch r {
+	case ErrorStatus400, ErrorStatus401, ErrorStatus403, ErrorStatus404, ErrorStatus409, ErrorStatus422, ErrorStatus429, ErrorStatus500:
+		return true
+	}
+	return false
+}
+
 type ErrorType string
 
 const (
+	// 400: The request parameters could not be parsed; see Errors for details.
+	ErrorTypeInvalidParametersError ErrorType = "invalid_parameters_error"
+	// 400: The request body could not be parsed.
+	ErrorTypeMalformedRequestError ErrorType = "malformed_request_error"
+	// 401: The API key is missing or invalid.
+	ErrorTypeInvalidAPIKeyError ErrorType = "invalid_api_key_error"
+	// 403: The API key belongs to a different environment than the one requested.
+	ErrorTypeEnvironmentMismatchError ErrorType = "environment_mismatch_error"
+	// 403: The API key is not allowed to perform the request.
+	ErrorTypeInsufficientPermissionsError ErrorType = "insufficient_permissions_error"
+	// 403: The request uses a feature that is not enabled for the Group.
+	ErrorTypePrivateFeatureError ErrorType = "private_feature_error"
+	// 404: No API method exists at the requested path.
+	ErrorTypeAPIMethodNotFoundError ErrorType = "api_method_not_found_error"
+	// 404: The requested object does not exist.
+	ErrorTypeObjectNotFoundError ErrorType = "object_not_found_error"
+	// 409: The idempotency key was already used by another request; see
+	// ResourceID.
+	ErrorTypeIdempotencyKeyAlreadyUsedError ErrorType = "idempotency_key_already_used_error"
+	// 409: The request is not valid for the current state of the object.
+	ErrorTypeInvalidOperationError ErrorType = "invalid_operation_error"
+	// 409: An object with the given unique identifier already exists; see
+	// ResourceID.
 	ErrorTypeUniqueIdentifierAlreadyExistsError ErrorType = "unique_identifier_already_exists_error"
+	// 422: The idempotency key was already used for a different request.
+	ErrorTypeIdempotencyUnprocessableError ErrorType = "idempotency_unprocessable_error"
+	// 429: Too many requests were made; see RetryAfter.
+	ErrorTypeRateLimitedError ErrorType = "rate_limited_error"
+	// 500: Increase encountered an internal error.
+	ErrorTypeInternalServerError ErrorType = "internal_server_error"
+)
+
+func (r ErrorType) IsKnown() bool {
+	switch r {
+	case ErrorTypeInvalidParametersError, ErrorTypeMalformedRequestError, ErrorTypeInvalidAPIKeyError, ErrorTypeEnvironmentMismatchError, ErrorTypeInsufficientPermissionsError, ErrorTypePrivateFeatureError, ErrorTypeAPIMethodNotFoundError, ErrorTypeObjectNotFoundError, ErrorTypeIdempotencyKeyAlreadyUsedError, ErrorTypeInvalidOperationError, ErrorTypeUniqueIdentifierAlreadyExistsError, ErrorTypeIdempotencyUnprocessableError, ErrorTypeRateLimitedError, ErrorTypeInternalServerError:
+		return true
+	}
+	return false
+}
+
+// sentinel is an error value which matches any [*Error] of its type when used
+// as the target of [errors.Is].
+type sentinel struct {
+	errorType ErrorType
+}
+
+func (s *sentinel) Error() string {
+	return "increase: " + string(s.errorType)
+}
+
+// Sentinel errors for each [ErrorType], for use with [errors.Is].
+var (
+	ErrInvalidParameters             error = &sentinel{ErrorTypeInvalidParametersError}
+	ErrMalformedRequest              error = &sentinel{ErrorTypeMalformedRequestError}
+	ErrInvalidAPIKey                 error = &sentinel{ErrorTypeInvalidAPIKeyError}
+	ErrEnvironmentMismatch           error = &sentinel{ErrorTypeEnvironmentMismatchError}
+	ErrInsufficientPermissions       error = &sentinel{ErrorTypeInsufficientPermissionsError}
+	ErrPrivateFeature                error = &sentinel{ErrorTypePrivateFeatureError}
+	ErrAPIMethodNotFound             error = &sentinel{ErrorTypeAPIMethodNotFoundError}
+	ErrObjectNotFound                error = &sentinel{ErrorTypeObjectNotFoundError}
+	ErrIdempotencyKeyAlreadyUsed     error = &sentinel{ErrorTypeIdempotencyKeyAlreadyUsedError}
+	ErrInvalidOperation              error = &sentinel{ErrorTypeInvalidOperationError}
+	ErrUniqueIdentifierAlreadyExists error = &sentinel{ErrorTypeUniqueIdentifierAlreadyExistsError}
+	ErrIdempotencyUnprocessable      error = &sentinel{ErrorTypeIdempotencyUnprocessableError}
+	ErrRateLimited                   error = &sentinel{ErrorTypeRateLimitedError}
+	ErrInternalServer                error = &sentinel{ErrorTypeInternalServerError}
 )
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
-	"path"
 	"reflect"
 	"sort"
 	"strconv"
@@ -305,13 +304,11 @@ func (e encoder) newInterfaceEncoder() encoderFunc {
 func (e *encoder) newReaderTypeEncoder() encoderFunc {
 	return func(key string, value reflect.Value, writer *multipart.Writer) error {
 		reader := value.Convert(reflect.TypeOf((*io.Reader)(nil)).Elem()).Interface().(io.Reader)
-		filename := "anonymous_file"
-		if named, ok := reader.(interface{ Name() string }); ok {
-			filename = path.Base(named.Name())
+		file, ok := reader.(*File)
+		if !ok {
+			file = &File{Reader: reader, ContentType: "application/octet-stream"}
 		}
-		filewriter, err := writer.CreateFormFile(key, filename)
-		io.Copy(filewriter, reader)
-		return err
+		return file.writePart(key, writer)
 	}
 }
 
@@ -0,0 +1,156 @@
+package apiform
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"strings"
+)
+
+// File is a file in a multipart body, with the filename and content type of its
+// part. Files which are plain readers are sent as "application/octet-stream",
+// named after the reader's Name method if it has one.
+type File struct {
+	io.Reader
+	// The filename of the part. If empty, the reader's Name method is used if it
+	// has one.
+	Filename string
+	// The content type of the part. If empty, it is inferred from the extension
+	// of the filename, or else from the first 512 bytes of the file.
+	ContentType string
+	// If Progress is not nil, it is called as the file is sent with the number
+	// of bytes sent so far, and the size of the file, or -1 if it is unknown.
+	// It starts again from zero if the file is sent again for a retry.
+	Progress func(sent int64, total int64)
+
+	// buffered holds the contents of Reader, if it cannot be read again, once
+	// they have been read into memory to be sent for retries.
+	buffered *bytes.Reader
+}
+
+// reader returns the reader the file is sent from.
+func (f *File) reader() io.Reader {
+	if f.buffered != nil {
+		return f.buffered
+	}
+	return f.Reader
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func (f *File) writePart(key string, writer *multipart.Writer) error {
+	filename := f.Filename
+	if filename == "" {
+		filename = "anonymous_file"
+		if named, ok := f.Reader.(interface{ Name() string }); ok {
+			filename = path.Base(named.Name())
+		}
+	}
+	reader := f.reader()
+	// The size must be found before any of the file is read for sniffing.
+	total := int64(-1)
+	if f.Progress != nil {
+		total = readerSize(reader)
+	}
+	contentType := f.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	if contentType == "" {
+		buffered := bufio.NewReaderSize(reader, 512)
+		head, _ := buffered.Peek(512)
+		contentType = http.DetectContentType(head)
+		reader = buffered
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(key), quoteEscaper.Replace(filename)))
+	header.Set("Content-Type", contentType)
+	var part io.Writer
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if f.Progress != nil {
+		part = &progressWriter{Writer: part, total: total, progress: f.Progress}
+	}
+	_, err = io.Copy(part, reader)
+	return err
+}
+
+// rewinder returns a function which prepares the file to be sent again. If its
+// reader cannot be read again, it is read into memory, leaving Reader as it
+// was given, so that the file can still be streamed and its progress reported
+// as it is sent.
+func (f *File) rewinder() func() error {
+	if rewind, ok := rewinder(f.Reader); ok {
+		return rewind
+	}
+	contents, err := io.ReadAll(f.Reader)
+	if err != nil {
+		return func() error { return err }
+	}
+	f.buffered = bytes.NewReader(contents)
+	return func() error {
+		_, err := f.buffered.Seek(0, io.SeekStart)
+		return err
+	}
+}
+
+// readerSize returns the number of bytes left to read from reader, or -1 if it
+// is not known.
+func readerSize(reader io.Reader) int64 {
+	switch reader := reader.(type) {
+	case interface{ Len() int }:
+		return int64(reader.Len())
+	case io.Seeker:
+		current, err := reader.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		end, err := reader.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
+		}
+		if _, err := reader.Seek(current, io.SeekStart); err != nil {
+			return -1
+		}
+		return end - current
+	default:
+		return -1
+	}
+}
+
+// progressChunkSize is the most bytes a progressWriter writes before reporting
+// its progress.
+const progressChunkSize = 32 << 10
+
+// progressWriter reports the number of bytes written through it, at least once
+// for each progressChunkSize bytes.
+type progressWriter struct {
+	io.Writer
+	sent     int64
+	total    int64
+	progress func(sent int64, total int64)
+}
+
+func (w *progressWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		chunk := p[:min(len(p), progressChunkSize)]
+		written, err := w.Writer.Write(chunk)
+		n += written
+		w.sent += int64(written)
+		w.progress(w.sent, w.total)
+		if err != nil {
+			return n, err
+		}
+		p = p[written:]
+	}
+	return n, nil
+}
@@ -0,0 +1,228 @@
+package apiform
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"reflect"
+	"sync"
+)
+
+// Rewinder is implemented by readers which can be read again from the start.
+type Rewinder interface {
+	io.Reader
+	Rewind() error
+}
+
+// NewStream returns a function which creates a multipart body for value, and
+// the content type of the bodies. Each body encodes value as it is read, so the
+// files in value are never held in memory. Since a new body is created for each
+// attempt of a request, every file must be able to be read again from the
+// start, by being a [Rewinder] or an [io.Seeker], or by being a [File], whose
+// reader is read into memory if it cannot; if one cannot, or value has no
+// files, ok is false.
+func NewStream(value interface{}) (newBody func() (io.ReadCloser, error), contentType string, ok bool) {
+	var readers []io.Reader
+	collectReaders(reflect.ValueOf(value), &readers)
+	if len(readers) == 0 {
+		return nil, "", false
+	}
+	s := &stream{value: value}
+	for _, reader := range readers {
+		rewind, ok := rewinder(reader)
+		if !ok {
+			return nil, "", false
+		}
+		s.rewinds = append(s.rewinds, rewind)
+	}
+	writer := multipart.NewWriter(io.Discard)
+	s.boundary = writer.Boundary()
+	return s.newBody, writer.FormDataContentType(), true
+}
+
+// collectReaders appends the readers within v to readers.
+func collectReaders(v reflect.Value, readers *[]io.Reader) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+	case reflect.Invalid:
+		return
+	}
+	if v.CanInterface() {
+		if file, ok := v.Interface().(*File); ok {
+			*readers = append(*readers, file)
+			return
+		}
+		if reader, ok := v.Interface().(io.Reader); ok {
+			*readers = append(*readers, reader)
+			return
+		}
+	}
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		collectReaders(v.Elem(), readers)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				collectReaders(v.Field(i), readers)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectReaders(v.Index(i), readers)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			collectReaders(iter.Value(), readers)
+		}
+	}
+}
+
+// rewinder returns a function which prepares reader to be read again from its
+// current position.
+func rewinder(reader io.Reader) (func() error, bool) {
+	if file, ok := reader.(*File); ok {
+		return file.rewinder(), true
+	}
+	if rewinder, ok := reader.(Rewinder); ok {
+		return rewinder.Rewind, true
+	}
+	if seeker, ok := reader.(io.Seeker); ok {
+		// Seeking fails for files such as pipes, which cannot be rewound.
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, false
+		}
+		return func() error {
+			_, err := seeker.Seek(start, io.SeekStart)
+			return err
+		}, true
+	}
+	return nil, false
+}
+
+// errStreamReplaced is returned by reads of a body after the next body of the
+// same stream has been created.
+var errStreamReplaced = errors.New("apiform: multipart body replaced by a newer one")
+
+// stream creates the multipart bodies of a value. Bodies are written one at a
+// time, since they read the same files, so creating a body stops the last one.
+type stream struct {
+	value    interface{}
+	boundary string
+	rewinds  []func() error
+	mu       sync.Mutex
+	last     *streamBody
+}
+
+func (s *stream) newBody() (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	body := &streamBody{PipeReader: reader, done: make(chan struct{})}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var previous <-chan struct{}
+	if s.last != nil {
+		previous = s.last.stop()
+	}
+	body.start = func() { go s.write(writer, previous, body.done) }
+	s.last = body
+	return body, nil
+}
+
+// write writes the body to pipe, after the writer of the previous body, if
+// any, has stopped, and closes done when it is finished.
+func (s *stream) write(pipe *io.PipeWriter, previous <-chan struct{}, done chan struct{}) {
+	defer close(done)
+	if previous != nil {
+		<-previous
+	}
+	var err error
+	for _, rewind := range s.rewinds {
+		if err = rewind(); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		writer := multipart.NewWriter(pipe)
+		writer.SetBoundary(s.boundary)
+		err = MarshalRoot(s.value, writer)
+		if err == nil {
+			err = writer.Close()
+		}
+	}
+	pipe.CloseWithError(err)
+}
+
+// streamBody is a multipart body which starts being written when it is first
+// read, so that a body which is never sent does not leave a writer behind.
+type streamBody struct {
+	*io.PipeReader
+	once  sync.Once
+	start func()
+	// done is closed once the body's writer has stopped, or when the body is
+	// stopped before it is read.
+	done chan struct{}
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	b.once.Do(b.start)
+	return b.PipeReader.Read(p)
+}
+
+// stop closes the body, so that its writer stops at its next write, and
+// returns a channel which is closed once the writer has stopped.
+func (b *streamBody) stop() <-chan struct{} {
+	b.once.Do(func() { close(b.done) })
+	b.PipeReader.CloseWithError(errStreamReplaced)
+	return b.done
+}
+
+// Reopener is a [Rewinder] which reads the file returned by an open function,
+// and opens it again when rewound. Each file is closed once it has been read to
+// the end, or when it is rewound.
+type Reopener struct {
+	open     func() (io.ReadCloser, error)
+	filename string
+	file     io.ReadCloser
+	done     bool
+}
+
+// NewReopener returns a [Reopener] for the given open function. The filename is
+// used as the name of the file in multipart bodies.
+func NewReopener(filename string, open func() (io.ReadCloser, error)) *Reopener {
+	return &Reopener{open: open, filename: filename}
+}
+
+func (r *Reopener) Name() string { return r.filename }
+
+func (r *Reopener) Read(p []byte) (n int, err error) {
+	if r.done {
+		return 0, io.EOF
+	}
+	if r.file == nil {
+		r.file, err = r.open()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err = r.file.Read(p)
+	if err == io.EOF {
+		r.done = true
+		r.file.Close()
+		r.file = nil
+	}
+	return n, err
+}
+
+func (r *Reopener) Rewind() error {
+	r.done = false
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
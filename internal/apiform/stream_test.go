@@ -0,0 +1,218 @@
+package apiform
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+)
+
+type FileUpload struct {
+	File    io.Reader `form:"file"`
+	Purpose string    `form:"purpose"`
+}
+
+func readStream(t *testing.T, newBody func() (io.ReadCloser, error), contentType string) map[string]string {
+	t.Helper()
+	body, err := newBody()
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	defer body.Close()
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	parts := map[string]string{}
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		contents, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("err should be nil: %s", err.Error())
+		}
+		parts[part.FormName()] = string(contents)
+	}
+}
+
+func TestStreamSeeker(t *testing.T) {
+	file := strings.NewReader("xxcheck image")
+	file.Seek(2, io.SeekStart)
+	newBody, contentType, ok := NewStream(FileUpload{File: file, Purpose: "check_image_front"})
+	if !ok {
+		t.Fatalf("expected a seekable file to be streamed")
+	}
+	for i := 0; i < 2; i++ {
+		parts := readStream(t, newBody, contentType)
+		if parts["file"] != "check image" || parts["purpose"] != "check_image_front" {
+			t.Fatalf("expected the file to be read from its starting offset each time, got %v", parts)
+		}
+	}
+}
+
+func TestStreamReopener(t *testing.T) {
+	opens := 0
+	file := NewReopener("check.png", func() (io.ReadCloser, error) {
+		opens += 1
+		return io.NopCloser(strings.NewReader("check image")), nil
+	})
+	newBody, contentType, ok := NewStream(FileUpload{File: file})
+	if !ok {
+		t.Fatalf("expected a reopener to be streamed")
+	}
+	for i := 0; i < 2; i++ {
+		if parts := readStream(t, newBody, contentType); parts["file"] != "check image" {
+			t.Fatalf("expected the file to be read each time, got %v", parts)
+		}
+	}
+	if opens != 2 {
+		t.Fatalf("expected the file to be opened for each body, got %d", opens)
+	}
+}
+
+func TestStreamUnrewindable(t *testing.T) {
+	if _, _, ok := NewStream(FileUpload{File: bytes.NewBufferString("check image")}); ok {
+		t.Fatalf("expected a buffer not to be streamed")
+	}
+	if _, _, ok := NewStream(FileUpload{Purpose: "other"}); ok {
+		t.Fatalf("expected params without files not to be streamed")
+	}
+}
+
+func emptyFile() (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func TestFilePart(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("x", 1000)
+	tests := map[string]struct {
+		file        io.Reader
+		filename    string
+		contentType string
+	}{
+		"plain reader":  {strings.NewReader("data"), "anonymous_file", "application/octet-stream"},
+		"explicit":      {&File{Reader: strings.NewReader("data"), Filename: `front "1".jpg`, ContentType: "image/jpeg"}, `front "1".jpg`, "image/jpeg"},
+		"extension":     {&File{Reader: strings.NewReader("data"), Filename: "statement.pdf"}, "statement.pdf", "application/pdf"},
+		"sniffed":       {&File{Reader: strings.NewReader(png), Filename: "check"}, "check", "image/png"},
+		"reader's name": {&File{Reader: NewReopener("dir/check.png", emptyFile)}, "check.png", "image/png"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			writer := multipart.NewWriter(buf)
+			if err := MarshalRoot(FileUpload{File: test.file}, writer); err != nil {
+				t.Fatalf("err should be nil: %s", err.Error())
+			}
+			writer.Close()
+			reader := multipart.NewReader(buf, writer.Boundary())
+			part, err := reader.NextPart()
+			if err != nil {
+				t.Fatalf("err should be nil: %s", err.Error())
+			}
+			if part.FileName() != test.filename || part.Header.Get("Content-Type") != test.contentType {
+				t.Fatalf("expected %q as %s, got %q as %s", test.filename, test.contentType, part.FileName(), part.Header.Get("Content-Type"))
+			}
+			if name == "sniffed" {
+				contents, _ := io.ReadAll(part)
+				if string(contents) != png {
+					t.Fatalf("expected the sniffed bytes to still be sent")
+				}
+			}
+		})
+	}
+}
+
+func TestFileProgress(t *testing.T) {
+	var sent, total int64
+	file := &File{Reader: strings.NewReader(strings.Repeat("x", 100000)), Filename: "a.bin", Progress: func(s, t int64) { sent, total = s, t }}
+	newBody, contentType, ok := NewStream(FileUpload{File: file})
+	if !ok {
+		t.Fatalf("expected a file with a seekable reader to be streamed")
+	}
+	for i := 0; i < 2; i++ {
+		readStream(t, newBody, contentType)
+		if sent != 100000 || total != 100000 {
+			t.Fatalf("expected progress to reach 100000 of 100000, got %d of %d", sent, total)
+		}
+	}
+}
+
+func TestFileProgressUnseekable(t *testing.T) {
+	var calls []int64
+	contents := strings.Repeat("x", 100000)
+	file := &File{Reader: io.MultiReader(strings.NewReader(contents)), Filename: "a.bin", Progress: func(s, t int64) { calls = append(calls, s) }}
+	newBody, contentType, ok := NewStream(FileUpload{File: file})
+	if !ok {
+		t.Fatalf("expected a file with an unseekable reader to be streamed")
+	}
+	if len(calls) != 0 {
+		t.Fatalf("expected no progress before the body is read, got %v", calls)
+	}
+	for i := 0; i < 2; i++ {
+		calls = nil
+		parts := readStream(t, newBody, contentType)
+		if parts["file"] != contents {
+			t.Fatalf("expected the file to be sent on attempt %d", i+1)
+		}
+		if len(calls) < 2 || calls[len(calls)-1] != 100000 {
+			t.Fatalf("expected progress to be reported as the file is sent, got %v", calls)
+		}
+	}
+}
+
+func TestStreamAbandonedBody(t *testing.T) {
+	contents := strings.Repeat("x", 1<<20)
+	newBody, contentType, ok := NewStream(FileUpload{File: strings.NewReader(contents)})
+	if !ok {
+		t.Fatalf("expected a seekable file to be streamed")
+	}
+
+	// An attempt which stops reading its body, such as one which timed out,
+	// must not stop the next attempt from being sent.
+	abandoned, err := newBody()
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if _, err := abandoned.Read(make([]byte, 10)); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	done := make(chan map[string]string)
+	go func() { done <- readStream(t, newBody, contentType) }()
+	select {
+	case parts := <-done:
+		if parts["file"] != contents {
+			t.Fatalf("expected the file to be sent in full after an abandoned body")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the next body to be written while the last one is not read")
+	}
+	if _, err := io.ReadAll(abandoned); err == nil {
+		t.Errorf("expected an error reading a replaced body")
+	}
+}
+
+func TestFileKeepsReader(t *testing.T) {
+	reader := io.MultiReader(strings.NewReader("check image"))
+	file := &File{Reader: reader, Filename: "check.png"}
+	newBody, contentType, ok := NewStream(FileUpload{File: file})
+	if !ok {
+		t.Fatalf("expected a file with an unseekable reader to be streamed")
+	}
+	if file.Reader != reader {
+		t.Fatalf("expected the file's reader to be left as it was given")
+	}
+	for i := 0; i < 2; i++ {
+		if parts := readStream(t, newBody, contentType); parts["file"] != "check image" {
+			t.Fatalf("expected the file to be sent on attempt %d, got %v", i+1, parts)
+		}
+	}
+}
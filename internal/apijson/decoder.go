@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 	"unsafe"
@@ -408,7 +409,7 @@ func (d *decoder) newStructTypeDecoder(t reflect.Type) decoderFunc {
 		if extraDecoder != nil && typedExtraFields.Len() > 0 {
 			value.FieldByIndex(extraDecoder.idx).Set(typedExtraFields)
 		}
-		if metadata := getSubField(value, []int{-1}, "Extras"); metadata.IsValid() && len(untypedExtraFields) > 0 {
+		if metadata := getSubField(value, []int{-1}, "ExtraFields"); metadata.IsValid() && len(untypedExtraFields) > 0 {
 			metadata.Set(reflect.ValueOf(untypedExtraFields))
 		}
 		return nil
@@ -482,3 +483,38 @@ func (d *decoder) newTimeTypeDecoder(t reflect.Type) decoderFunc {
 func setUnexportedField(field reflect.Value, value interface{}) {
 	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(value))
 }
+
+// UnmarshalPath decodes the value at the given path within raw into to. The
+// path is a dot-separated list of property names and array indexes, such as
+// "source.card_settlement.amount" or "data[2].id". It reports whether the path
+// exists with a value other than null.
+func UnmarshalPath(raw []byte, path string, to any) (bool, error) {
+	result := gjson.GetBytes(raw, gjsonPath(path))
+	if !result.Exists() || result.Type == gjson.Null {
+		return false, nil
+	}
+	if err := Unmarshal([]byte(result.Raw), to); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// gjsonPath converts a path to gjson's syntax, where array indexes are path
+// components and wildcard and query characters must be escaped. Dots within a
+// property name are escaped with a backslash in both syntaxes.
+func gjsonPath(path string) string {
+	var b strings.Builder
+	for _, c := range path {
+		switch c {
+		case '[':
+			b.WriteByte('.')
+		case ']':
+		case '*', '?', '#', '@', '|', '!', '=', '<', '>', '%':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
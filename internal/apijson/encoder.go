@@ -256,7 +256,20 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 		return encoderFields[i].tag.name < encoderFields[j].tag.name
 	})
 
+	_, hasMetadata := t.FieldByName("JSON")
+
 	return func(value reflect.Value) (json []byte, err error) {
+		// A struct decoded by this package keeps the JSON it was decoded from,
+		// including properties it has no fields for, so it is encoded back to
+		// that JSON, with any fields which have been changed since replaced.
+		if hasMetadata {
+			if raw := decodedJSON(value); raw != "" {
+				if encoded, ok := e.encodeChanges(t, value, encoderFields, raw); ok {
+					return encoded, nil
+				}
+			}
+		}
+
 		json = []byte("{}")
 
 		for _, ef := range encoderFields {
@@ -284,6 +297,50 @@ func (e *encoder) newStructTypeEncoder(t reflect.Type) encoderFunc {
 	}
 }
 
+// decodedJSON returns the JSON a struct was decoded from, kept in the raw field
+// of its JSON metadata, or "" if it was not decoded.
+func decodedJSON(value reflect.Value) string {
+	metadata := value.FieldByName("JSON")
+	if !metadata.IsValid() || metadata.Kind() != reflect.Struct {
+		return ""
+	}
+	raw := metadata.FieldByName("raw")
+	if !raw.IsValid() || raw.Kind() != reflect.String {
+		return ""
+	}
+	return raw.String()
+}
+
+// encodeChanges returns raw, the JSON value was decoded from, with the fields
+// whose encoding differs from that of a value freshly decoded from raw
+// replaced. It returns false if raw cannot be decoded again.
+func (e *encoder) encodeChanges(t reflect.Type, value reflect.Value, fields []encoderField, raw string) ([]byte, bool) {
+	decoded := reflect.New(t)
+	if err := Unmarshal([]byte(raw), decoded.Interface()); err != nil {
+		return nil, false
+	}
+	json := []byte(raw)
+	for _, ef := range fields {
+		encoded, err := ef.fn(value.FieldByIndex(ef.idx))
+		if err != nil {
+			return nil, false
+		}
+		original, err := ef.fn(decoded.Elem().FieldByIndex(ef.idx))
+		if err != nil || bytes.Equal(encoded, original) {
+			continue
+		}
+		if encoded == nil {
+			json, err = sjson.DeleteBytes(json, ef.tag.name)
+		} else {
+			json, err = sjson.SetRawBytes(json, ef.tag.name, encoded)
+		}
+		if err != nil {
+			return nil, false
+		}
+	}
+	return json, true
+}
+
 func (e *encoder) newFieldTypeEncoder(t reflect.Type) encoderFunc {
 	f, _ := t.FieldByName("Value")
 	enc := e.typeEncoder(f.Type)
@@ -69,12 +69,12 @@ type JSONFieldStruct struct {
 }
 
 type JSONFieldStructJSON struct {
-	A      Field
-	B      Field
-	C      Field
-	D      Field
-	Extras map[string]Field
-	raw    string
+	A           Field
+	B           Field
+	C           Field
+	D           Field
+	ExtraFields map[string]Field
+	raw         string
 }
 
 type UnknownStruct struct {
@@ -252,7 +252,7 @@ var tests = map[string]struct {
 				B:   Field{raw: `"12"`, status: valid},
 				C:   Field{raw: "null", status: null},
 				D:   Field{raw: "", status: missing},
-				Extras: map[string]Field{
+				ExtraFields: map[string]Field{
 					"extra_typed": {
 						raw:    "12",
 						status: valid,
@@ -374,3 +374,21 @@ func TestEncode(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalPath(t *testing.T) {
+	raw := []byte(`{"data":[{"id":"a"},{"id":"b","tags":{"a.b":1}}],"next":null}`)
+	var id string
+	if ok, err := UnmarshalPath(raw, "data[1].id", &id); !ok || err != nil || id != "b" {
+		t.Fatalf("expected b, got %q %v %v", id, ok, err)
+	}
+	var count int64
+	if ok, err := UnmarshalPath(raw, "data[1].tags.a\\.b", &count); !ok || err != nil || count != 1 {
+		t.Fatalf("expected 1, got %d %v %v", count, ok, err)
+	}
+	if ok, err := UnmarshalPath(raw, "next", &id); ok || err != nil {
+		t.Fatalf("expected null not to be ok, got %v %v", ok, err)
+	}
+	if ok, err := UnmarshalPath(raw, "data[2].id", &id); ok || err != nil {
+		t.Fatalf("expected a missing path not to be ok, got %v %v", ok, err)
+	}
+}
@@ -0,0 +1,220 @@
+package apijson
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DecodeIssueKind is the kind of problem found in a decoded value.
+type DecodeIssueKind string
+
+const (
+	// A required field was not present.
+	DecodeIssueMissingRequired DecodeIssueKind = "missing_required"
+	// A field's value could not be decoded into its type, including a null
+	// value for a field which is not nullable.
+	DecodeIssueInvalid DecodeIssueKind = "invalid"
+	// An enum field's value is not one of the values known to the SDK.
+	DecodeIssueUnknownEnum DecodeIssueKind = "unknown_enum"
+)
+
+// DecodeIssue is a problem found in a decoded value.
+type DecodeIssue struct {
+	// The JSON path of the field, such as "source.card_settlement.amount" or
+	// "data[2].status".
+	Path string
+	Kind DecodeIssueKind
+	// The raw JSON of the field, which is empty if it is missing.
+	Raw string
+}
+
+// DecodeError is returned by strict decoding when a value does not match its
+// schema. It lists every problem found.
+type DecodeError struct {
+	Issues []DecodeIssue
+}
+
+func (e *DecodeError) Error() string {
+	problems := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		switch issue.Kind {
+		case DecodeIssueMissingRequired:
+			problems[i] = fmt.Sprintf("missing required field %s", issue.Path)
+		case DecodeIssueInvalid:
+			problems[i] = fmt.Sprintf("invalid value %s for %s", issue.Raw, issue.Path)
+		case DecodeIssueUnknownEnum:
+			problems[i] = fmt.Sprintf("unknown enum value %s for %s", issue.Raw, issue.Path)
+		default:
+			problems[i] = fmt.Sprintf("%s at %s", issue.Kind, issue.Path)
+		}
+	}
+	return "apijson: response does not match its schema: " + strings.Join(problems, "; ")
+}
+
+// knownEnum is implemented by enum types, which know their possible values.
+type knownEnum interface {
+	IsKnown() bool
+}
+
+// UnknownKind is the kind of an [Unknown].
+type UnknownKind string
+
+const (
+	UnknownEnumValue UnknownKind = "enum_value"
+	UnknownField     UnknownKind = "field"
+)
+
+// Unknown is an enum value or a property in a decoded value which is not in the
+// schema, which usually means that it was added to the API after the SDK was
+// generated.
+type Unknown struct {
+	Kind UnknownKind
+	// The Go type of the enum, or of the object with the unknown property, such
+	// as "increase.AccountStatus".
+	Type string
+	// The JSON path at which it was seen, such as "data[2].status".
+	Path string
+	// The enum value, or the name of the property.
+	Value string
+	// The raw JSON of the enum value or property.
+	Raw string
+}
+
+// Validate checks a value decoded by this package against its schema, using the
+// metadata recorded by the decoder. It reports required fields which are
+// missing, fields which could not be decoded, and enum values which are not
+// known, as a *DecodeError. It returns nil if there are no problems.
+func Validate(v any) error {
+	w := walker{}
+	w.walk(reflect.ValueOf(v), "")
+	if len(w.issues) == 0 {
+		return nil
+	}
+	return &DecodeError{Issues: w.issues}
+}
+
+// FindUnknown returns the enum values and properties in a value decoded by this
+// package which are not in its schema.
+func FindUnknown(v any) []Unknown {
+	w := walker{}
+	w.walk(reflect.ValueOf(v), "")
+	return w.unknown
+}
+
+// walker visits a decoded value, collecting the problems recorded in its
+// metadata and the unknown values in it.
+type walker struct {
+	issues  []DecodeIssue
+	unknown []Unknown
+}
+
+func (w *walker) walk(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), joinPath(path, fmt.Sprint(iter.Key().Interface())))
+		}
+	case reflect.Struct:
+		w.walkStruct(v, path)
+	case reflect.Invalid:
+	default:
+		if enum, ok := v.Interface().(knownEnum); ok && !enum.IsKnown() {
+			value, raw := formatEnum(v)
+			w.issues = append(w.issues, DecodeIssue{Path: path, Kind: DecodeIssueUnknownEnum, Raw: raw})
+			w.unknown = append(w.unknown, Unknown{Kind: UnknownEnumValue, Type: v.Type().String(), Path: path, Value: value, Raw: raw})
+		}
+	}
+}
+
+// formatEnum returns an enum value as text, and as the JSON it was decoded from.
+// Enums are strings or numbers.
+func formatEnum(v reflect.Value) (value string, raw string) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), strconv.Quote(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		value = strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		value = strconv.FormatBool(v.Bool())
+	default:
+		value = fmt.Sprint(v.Interface())
+	}
+	return value, value
+}
+
+// walkStruct checks each field of a struct against the metadata in its JSON
+// field. Structs without metadata, such as times, are not checked.
+func (w *walker) walkStruct(v reflect.Value, path string) {
+	meta := v.FieldByName("JSON")
+	if !meta.IsValid() {
+		return
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		ptag, ok := parseJSONStructTag(field)
+		if !ok || ptag.name == "-" || ptag.extras || ptag.metadata || ptag.inline {
+			continue
+		}
+		fieldPath := joinPath(path, ptag.name)
+		metaField := meta.FieldByName(field.Name)
+		if !metaField.IsValid() || metaField.Type() != reflect.TypeOf(Field{}) {
+			w.walk(v.Field(i), fieldPath)
+			continue
+		}
+		metadata := metaField.Interface().(Field)
+		switch {
+		case metadata.IsMissing():
+			if ptag.required {
+				w.issues = append(w.issues, DecodeIssue{Path: fieldPath, Kind: DecodeIssueMissingRequired})
+			}
+		case metadata.IsNull():
+			if ptag.required && !ptag.nullable {
+				w.issues = append(w.issues, DecodeIssue{Path: fieldPath, Kind: DecodeIssueInvalid, Raw: metadata.raw})
+			}
+		case metadata.IsInvalid():
+			w.issues = append(w.issues, DecodeIssue{Path: fieldPath, Kind: DecodeIssueInvalid, Raw: metadata.raw})
+		default:
+			w.walk(v.Field(i), fieldPath)
+		}
+	}
+
+	var extras map[string]Field
+	if extraFields := meta.FieldByName("ExtraFields"); extraFields.IsValid() {
+		extras, _ = extraFields.Interface().(map[string]Field)
+	}
+	names := make([]string, 0, len(extras))
+	for name := range extras {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.unknown = append(w.unknown, Unknown{Kind: UnknownField, Type: t.String(), Path: joinPath(path, name), Value: name, Raw: extras[name].raw})
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,128 @@
+package apijson
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type StrictStatus string
+
+const (
+	StrictStatusOpen   StrictStatus = "open"
+	StrictStatusClosed StrictStatus = "closed"
+)
+
+func (r StrictStatus) IsKnown() bool {
+	switch r {
+	case StrictStatusOpen, StrictStatusClosed:
+		return true
+	}
+	return false
+}
+
+type StrictCode int64
+
+const (
+	StrictCode200 StrictCode = 200
+	StrictCode404 StrictCode = 404
+)
+
+func (r StrictCode) IsKnown() bool {
+	switch r {
+	case StrictCode200, StrictCode404:
+		return true
+	}
+	return false
+}
+
+type StrictModel struct {
+	ID       string         `json:"id,required"`
+	Status   StrictStatus   `json:"status,required"`
+	ClosedAt time.Time      `json:"closed_at,required,nullable" format:"date-time"`
+	Name     string         `json:"name"`
+	Children []StrictChild  `json:"children,required"`
+	Tags     []StrictStatus `json:"tags"`
+	Code     StrictCode     `json:"code"`
+	JSON     strictModelJSON
+}
+
+type strictModelJSON struct {
+	ID       Field
+	Status   Field
+	ClosedAt Field
+	Name     Field
+	Children Field
+	Tags     Field
+	Code     Field
+	raw      string
+}
+
+func (r *StrictModel) UnmarshalJSON(data []byte) error {
+	return UnmarshalRoot(data, r)
+}
+
+type StrictChild struct {
+	Amount int64 `json:"amount,required"`
+	JSON   strictChildJSON
+}
+
+type strictChildJSON struct {
+	Amount Field
+	raw    string
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		raw      string
+		expected []DecodeIssue
+	}{
+		"valid": {
+			raw: `{"id":"a","status":"open","closed_at":null,"children":[{"amount":1}],"tags":["closed"]}`,
+		},
+		"missing_required": {
+			raw:      `{"status":"open","closed_at":null,"children":[]}`,
+			expected: []DecodeIssue{{Path: "id", Kind: DecodeIssueMissingRequired}},
+		},
+		"null_not_nullable": {
+			raw:      `{"id":null,"status":"open","closed_at":null,"children":[]}`,
+			expected: []DecodeIssue{{Path: "id", Kind: DecodeIssueInvalid, Raw: "null"}},
+		},
+		"invalid_nested": {
+			raw:      `{"id":"a","status":"open","closed_at":"yesterday","children":[{"amount":1},{"amount":{}}]}`,
+			expected: []DecodeIssue{{Path: "closed_at", Kind: DecodeIssueInvalid, Raw: `"yesterday"`}, {Path: "children[1].amount", Kind: DecodeIssueInvalid, Raw: "{}"}},
+		},
+		"unknown_enum": {
+			raw:      `{"id":"a","status":"frozen","closed_at":null,"children":[],"tags":["open","archived"]}`,
+			expected: []DecodeIssue{{Path: "status", Kind: DecodeIssueUnknownEnum, Raw: `"frozen"`}, {Path: "tags[1]", Kind: DecodeIssueUnknownEnum, Raw: `"archived"`}},
+		},
+		"unknown_int_enum": {
+			raw:      `{"id":"a","status":"open","closed_at":null,"children":[],"code":418}`,
+			expected: []DecodeIssue{{Path: "code", Kind: DecodeIssueUnknownEnum, Raw: "418"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var model StrictModel
+			if err := Unmarshal([]byte(test.raw), &model); err != nil {
+				t.Fatalf("failed to unmarshal: %s", err.Error())
+			}
+			err := Validate(&model)
+			if test.expected == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err.Error())
+				}
+				return
+			}
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected a *DecodeError, got %v", err)
+			}
+			if !reflect.DeepEqual(decodeErr.Issues, test.expected) {
+				t.Fatalf("expected issues %+v, got %+v", test.expected, decodeErr.Issues)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ const formatStructTag = "format"
 type parsedStructTag struct {
 	name     string
 	required bool
+	nullable bool
 	extras   bool
 	metadata bool
 	inline   bool
@@ -30,6 +31,8 @@ func parseJSONStructTag(field reflect.StructField) (tag parsedStructTag, ok bool
 		switch part {
 		case "required":
 			tag.required = true
+		case "nullable":
+			tag.nullable = true
 		case "extras":
 			tag.extras = true
 		case "metadata":
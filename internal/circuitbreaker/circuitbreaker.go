@@ -0,0 +1,187 @@
+// Package circuitbreaker provides the circuit breaker shared by the requests of
+// a client.
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned instead of making a request while the circuit is
+// open.
+var ErrCircuitOpen = errors.New("increase: circuit breaker is open")
+
+// buckets is the number of intervals the failure rate window is divided into.
+const buckets = 10
+
+// Config configures a Breaker. Zero fields take their default values.
+type Config struct {
+	// FailureRate is the fraction of attempts, between 0 and 1, which must fail
+	// for the circuit to open. Defaults to 0.5.
+	FailureRate float64
+	// MinimumAttempts is the number of attempts which must be made within the
+	// window before the circuit can open. Defaults to 10.
+	MinimumAttempts int
+	// Window is the period over which the failure rate is measured. Defaults
+	// to one minute.
+	Window time.Duration
+	// OpenDuration is how long the circuit stays open before a single probe
+	// attempt is allowed through. Defaults to 30 seconds.
+	OpenDuration time.Duration
+}
+
+type state int
+
+const (
+	closed state = iota
+	open
+	halfOpen
+)
+
+// Outcome is the result of an attempt, as recorded by a Breaker.
+type Outcome int
+
+const (
+	// Success is an attempt which received a response other than a server
+	// error.
+	Success Outcome = iota
+	// Failure is an attempt which failed to connect, timed out, or received a
+	// server error.
+	Failure
+	// Ignored is an attempt whose outcome says nothing about the health of the
+	// API, such as one abandoned by its caller.
+	Ignored
+)
+
+// bucket counts the attempts made during one interval of the window.
+type bucket struct {
+	interval int64
+	attempts int
+	failures int
+}
+
+// Token identifies an attempt allowed by a Breaker, so that its outcome is
+// recorded against the state of the circuit in which it was allowed.
+type Token struct {
+	generation uint64
+	probe      bool
+}
+
+// Breaker is a circuit breaker which opens when the rate of failed attempts is
+// too high, and then lets a single probe attempt through after a delay to find
+// out whether the API has recovered. It is safe for concurrent use.
+type Breaker struct {
+	mu       sync.Mutex
+	config   Config
+	state    state
+	openedAt time.Time
+	probing  bool
+	buckets  [buckets]bucket
+	// generation is incremented whenever the state changes, and each time a
+	// probe is allowed, so that attempts allowed earlier do not count.
+	generation uint64
+}
+
+// NewBreaker returns a closed Breaker with the given configuration.
+func NewBreaker(config Config) *Breaker {
+	if config.FailureRate <= 0 {
+		config.FailureRate = 0.5
+	}
+	if config.MinimumAttempts <= 0 {
+		config.MinimumAttempts = 10
+	}
+	if config.Window/buckets <= 0 {
+		config.Window = time.Minute
+	}
+	if config.OpenDuration <= 0 {
+		config.OpenDuration = 30 * time.Second
+	}
+	return &Breaker{config: config}
+}
+
+// Allow returns a Token for an attempt if one may be made now, and
+// ErrCircuitOpen otherwise. Every allowed attempt must be followed by a call to
+// Record with its Token.
+func (b *Breaker) Allow() (Token, error) {
+	return b.allow(time.Now())
+}
+
+func (b *Breaker) allow(now time.Time) (Token, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case open:
+		if now.Sub(b.openedAt) < b.config.OpenDuration {
+			return Token{}, ErrCircuitOpen
+		}
+		b.state = halfOpen
+		fallthrough
+	case halfOpen:
+		if b.probing {
+			return Token{}, ErrCircuitOpen
+		}
+		b.probing = true
+		b.generation++
+		return Token{generation: b.generation, probe: true}, nil
+	}
+	return Token{generation: b.generation}, nil
+}
+
+// Record records the outcome of the attempt allowed by Allow with the given
+// Token. Outcomes of attempts allowed before the circuit last changed state are
+// ignored, so only the probe's outcome can close or reopen a half-open
+// circuit.
+func (b *Breaker) Record(token Token, outcome Outcome) {
+	b.record(time.Now(), token, outcome)
+}
+
+func (b *Breaker) record(now time.Time, token Token, outcome Outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if token.generation != b.generation {
+		return
+	}
+	if token.probe {
+		b.probing = false
+		switch outcome {
+		case Success:
+			b.state = closed
+			b.buckets = [buckets]bucket{}
+			b.generation++
+		case Failure:
+			b.open(now)
+		}
+		return
+	}
+	if b.state != closed || outcome == Ignored {
+		return
+	}
+
+	interval := now.UnixNano() / int64(b.config.Window/buckets)
+	current := &b.buckets[interval%buckets]
+	if current.interval != interval {
+		*current = bucket{interval: interval}
+	}
+	current.attempts += 1
+	if outcome == Failure {
+		current.failures += 1
+	}
+
+	attempts, failures := 0, 0
+	for _, bucket := range b.buckets {
+		if interval-bucket.interval < buckets {
+			attempts += bucket.attempts
+			failures += bucket.failures
+		}
+	}
+	if attempts >= b.config.MinimumAttempts && float64(failures) >= b.config.FailureRate*float64(attempts) {
+		b.open(now)
+	}
+}
+
+func (b *Breaker) open(now time.Time) {
+	b.state = open
+	b.openedAt = now
+	b.generation++
+}
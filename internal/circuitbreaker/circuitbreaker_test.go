@@ -0,0 +1,116 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensOnFailureRate(t *testing.T) {
+	b := NewBreaker(Config{FailureRate: 0.5, MinimumAttempts: 4, Window: time.Minute, OpenDuration: time.Second})
+	now := time.Now()
+	for _, outcome := range []Outcome{Success, Failure, Ignored, Success, Failure} {
+		token, err := b.allow(now)
+		if err != nil {
+			t.Fatalf("expected the circuit to be closed, got %v", err)
+		}
+		b.record(now, token, outcome)
+	}
+	if _, err := b.allow(now); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to open after 2 failures in 4 attempts, got %v", err)
+	}
+}
+
+func TestBreakerForgetsOldAttempts(t *testing.T) {
+	b := NewBreaker(Config{MinimumAttempts: 2, Window: time.Minute})
+	now := time.Now()
+	b.record(now, Token{}, Failure)
+	b.record(now.Add(2*time.Minute), Token{}, Failure)
+	if _, err := b.allow(now.Add(2 * time.Minute)); err != nil {
+		t.Fatalf("expected failures outside the window to be forgotten, got %v", err)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	b := NewBreaker(Config{MinimumAttempts: 1, OpenDuration: time.Second})
+	now := time.Now()
+	b.record(now, Token{}, Failure)
+	if _, err := b.allow(now.Add(500 * time.Millisecond)); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be open, got %v", err)
+	}
+
+	// A failed probe opens the circuit again.
+	now = now.Add(time.Second)
+	probe, err := b.allow(now)
+	if err != nil {
+		t.Fatalf("expected a probe to be allowed, got %v", err)
+	}
+	if _, err := b.allow(now); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected only one probe at a time, got %v", err)
+	}
+	b.record(now, probe, Failure)
+	if _, err := b.allow(now.Add(500 * time.Millisecond)); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to open again after a failed probe, got %v", err)
+	}
+
+	// An ignored probe lets another probe through.
+	now = now.Add(time.Second)
+	probe, err = b.allow(now)
+	if err != nil {
+		t.Fatalf("expected a probe to be allowed, got %v", err)
+	}
+	b.record(now, probe, Ignored)
+	probe, err = b.allow(now)
+	if err != nil {
+		t.Fatalf("expected another probe after an ignored one, got %v", err)
+	}
+
+	// A successful probe closes the circuit.
+	b.record(now, probe, Success)
+	for i := 0; i < 3; i++ {
+		token, err := b.allow(now)
+		if err != nil {
+			t.Fatalf("expected the circuit to close after a successful probe, got %v", err)
+		}
+		b.record(now, token, Success)
+	}
+}
+
+func TestBreakerIgnoresStragglers(t *testing.T) {
+	b := NewBreaker(Config{MinimumAttempts: 1, OpenDuration: time.Second})
+	now := time.Now()
+	straggler, err := b.allow(now)
+	if err != nil {
+		t.Fatalf("expected the circuit to be closed, got %v", err)
+	}
+	failing, _ := b.allow(now)
+	b.record(now, failing, Failure)
+
+	// An attempt allowed before the circuit opened finishes while the probe
+	// is in flight, and must not close the circuit in the probe's place.
+	now = now.Add(time.Second)
+	probe, err := b.allow(now)
+	if err != nil {
+		t.Fatalf("expected a probe to be allowed, got %v", err)
+	}
+	b.record(now, straggler, Success)
+	if _, err := b.allow(now); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the straggler not to close the circuit, got %v", err)
+	}
+
+	// The probe's own outcome still decides the state of the circuit.
+	b.record(now, probe, Failure)
+	if _, err := b.allow(now.Add(500 * time.Millisecond)); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the failed probe to open the circuit again, got %v", err)
+	}
+	now = now.Add(time.Second)
+	probe, err = b.allow(now)
+	if err != nil {
+		t.Fatalf("expected a probe to be allowed, got %v", err)
+	}
+	b.record(now, straggler, Failure)
+	b.record(now, probe, Success)
+	if _, err := b.allow(now); err != nil {
+		t.Fatalf("expected the successful probe to close the circuit, got %v", err)
+	}
+}
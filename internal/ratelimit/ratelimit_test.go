@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterBurst(t *testing.T) {
+	l := NewLimiter(10, 2)
+	now := l.last
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("expected the first request to be allowed, got wait %v", d)
+	}
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("expected the second request to be allowed, got wait %v", d)
+	}
+	if d := l.reserve(now); d != 100*time.Millisecond {
+		t.Fatalf("expected to wait 100ms for the third request, got %v", d)
+	}
+	if d := l.reserve(now.Add(100 * time.Millisecond)); d != 0 {
+		t.Fatalf("expected the third request to be allowed after 100ms, got wait %v", d)
+	}
+}
+
+func TestLimiterPause(t *testing.T) {
+	l := NewLimiter(1000, 10)
+	l.Pause(50 * time.Millisecond)
+	l.Pause(time.Millisecond)
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected to wait for the pause, waited %v", elapsed)
+	}
+}
+
+func TestLimiterWaitCancel(t *testing.T) {
+	l := NewLimiter(1000, 10)
+	l.Pause(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected a deadline error, got %v", err)
+	}
+}
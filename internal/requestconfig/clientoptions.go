@@ -0,0 +1,16 @@
+package requestconfig
+
+// BeginClientOptions and EndClientOptions surround the options given to a
+// client, which are applied to each of its requests before the request's own
+// options, so that options which only make sense for a single request can
+// refuse to be given to the client.
+func BeginClientOptions(r *RequestConfig) error {
+	r.InClientOptions = true
+	return nil
+}
+
+// EndClientOptions marks the end of the options given to a client.
+func EndClientOptions(r *RequestConfig) error {
+	r.InClientOptions = false
+	return nil
+}
@@ -0,0 +1,146 @@
+package requestconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// IdempotencyRecord is the entry an [IdempotencyStore] keeps for one operation.
+type IdempotencyRecord struct {
+	// The caller's identifier for the operation, given with WithOperationID.
+	OperationID string `json:"operation_id"`
+	// The Idempotency-Key sent for every attempt of the operation.
+	IdempotencyKey string `json:"idempotency_key"`
+	// The HTTP method and path of the request, used to detect an operation ID
+	// being reused for a different request.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Whether a successful response has been received and recorded below.
+	Completed   bool        `json:"completed"`
+	CompletedAt time.Time   `json:"completed_at,omitempty"`
+	StatusCode  int         `json:"status_code,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// IdempotencyStore persists the idempotency key and final response of each
+// operation, so that an operation retried after a crash or restart reuses its
+// original key, or returns its recorded response without being sent again.
+//
+// Implementations must be safe for concurrent use.
+type IdempotencyStore interface {
+	// Load returns the record for the given operation ID, and whether one
+	// exists.
+	Load(ctx context.Context, operationID string) (IdempotencyRecord, bool, error)
+	// Save creates or replaces the record for record.OperationID. It must not
+	// return until the record is durable.
+	Save(ctx context.Context, record IdempotencyRecord) error
+	// SaveIfAbsent atomically creates the record for record.OperationID if
+	// there is none, and reports whether it did. Otherwise it returns the
+	// existing record, which is left unchanged. Like Save, it must not return
+	// until a created record is durable.
+	SaveIfAbsent(ctx context.Context, record IdempotencyRecord) (existing IdempotencyRecord, created bool, err error)
+	// Delete removes the record for the given operation ID, if there is one,
+	// so that the operation is treated as a new one.
+	Delete(ctx context.Context, operationID string) error
+}
+
+func (cfg *RequestConfig) isJournaled() bool {
+	return cfg.IdempotencyStore != nil && cfg.OperationID != "" && cfg.IdempotencyKey != ""
+}
+
+// beginOperation records the current key for the operation in the idempotency
+// store before the request is first sent. If the operation was already
+// recorded, it adopts the operation's recorded key instead, or returns the
+// recorded response if the operation already completed. The record is created
+// atomically, so that concurrent calls for the same operation send the same
+// key.
+func (cfg *RequestConfig) beginOperation() (*http.Response, error) {
+	ctx := cfg.Request.Context()
+	record, created, err := cfg.IdempotencyStore.SaveIfAbsent(ctx, IdempotencyRecord{
+		OperationID:    cfg.OperationID,
+		IdempotencyKey: cfg.IdempotencyKey,
+		Method:         cfg.Request.Method,
+		Path:           cfg.Request.URL.Path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error saving idempotency record: %w", err)
+	}
+	if created {
+		return nil, nil
+	}
+	if record.Method != cfg.Request.Method || record.Path != cfg.Request.URL.Path {
+		return nil, fmt.Errorf("operation ID %q was already used for %s %s", cfg.OperationID, record.Method, record.Path)
+	}
+	cfg.IdempotencyKey = record.IdempotencyKey
+	if !record.Completed {
+		return nil, nil
+	}
+	header := record.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Idempotent-Replayed", "true")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", record.StatusCode, http.StatusText(record.StatusCode)),
+		StatusCode:    record.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(record.Body)),
+		ContentLength: int64(len(record.Body)),
+		Request:       cfg.Request,
+	}, nil
+}
+
+// abandonOperation forgets an operation whose request was refused with a
+// response that sending it again would not change, so that a corrected request
+// with the same operation ID is sent as a new operation with a new key.
+func (cfg *RequestConfig) abandonOperation() error {
+	if err := cfg.IdempotencyStore.Delete(cfg.Request.Context(), cfg.OperationID); err != nil {
+		return fmt.Errorf("error deleting idempotency record: %w", err)
+	}
+	return nil
+}
+
+// isRefused reports whether a response status means that a request was not
+// performed, and never will be. Conflicts are not, since a 409 can mean that
+// the operation's key was already used.
+func isRefused(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests:
+		return false
+	}
+	return statusCode >= 400 && statusCode < 500
+}
+
+// completeOperation records the successful response of the operation, leaving
+// the response body readable.
+func (cfg *RequestConfig) completeOperation(res *http.Response) error {
+	contents, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(contents))
+	err = cfg.IdempotencyStore.Save(cfg.Request.Context(), IdempotencyRecord{
+		OperationID:    cfg.OperationID,
+		IdempotencyKey: cfg.IdempotencyKey,
+		Method:         cfg.Request.Method,
+		Path:           cfg.Request.URL.Path,
+		Completed:      true,
+		CompletedAt:    time.Now(),
+		StatusCode:     res.StatusCode,
+		Header:         res.Header.Clone(),
+		Body:           contents,
+	})
+	if err != nil {
+		return fmt.Errorf("error saving idempotency record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package requestconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Instrumentation receives hooks at each stage of a request, for building
+// traces and metrics. The hooks are called synchronously, so they should not
+// block.
+//
+// The contexts returned by RequestStart and AttemptStart are used for the rest
+// of the request and of the attempt respectively, which allows spans to be
+// propagated to the HTTP client and to nested hooks.
+type Instrumentation interface {
+	// RequestStart is called once per request, before the first attempt.
+	RequestStart(ctx context.Context, info RequestInfo) context.Context
+	// AttemptStart is called before each attempt is sent.
+	AttemptStart(ctx context.Context, info AttemptInfo) context.Context
+	// AttemptEnd is called once the outcome of each attempt is known, including
+	// whether it will be retried.
+	AttemptEnd(ctx context.Context, info AttemptInfo)
+	// ResponseDecoded is called after the body of a successful response is
+	// decoded.
+	ResponseDecoded(ctx context.Context, info DecodeInfo)
+	// RequestEnd is called once per request, with the error it returns if any.
+	RequestEnd(ctx context.Context, info RequestInfo, err error)
+}
+
+// RequestInfo describes a request as a whole.
+type RequestInfo struct {
+	// The name of the operation, such as "ACHTransfers.New". It may be empty for
+	// requests not made through a service method.
+	Operation string
+	// The request, without a body. Its context is not that of the hooks.
+	Request *http.Request
+	// The idempotency key of the request, if any.
+	IdempotencyKey string
+	// The number of attempts made. Only set for RequestEnd.
+	Attempts int
+	// The time since the request started. Only set for RequestEnd.
+	Duration time.Duration
+}
+
+// AttemptInfo describes one attempt of a request.
+type AttemptInfo struct {
+	Operation string
+	// The number of the attempt, starting at 1.
+	Attempt int
+	// The request sent for the attempt.
+	Request *http.Request
+	// The response received, or nil if there was none. Only set for AttemptEnd.
+	Response *http.Response
+	// The error returned by the HTTP client, if any. Only set for AttemptEnd.
+	Err error
+	// The time the attempt took. Only set for AttemptEnd.
+	Duration time.Duration
+	// If the attempt will be retried, why, such as "connection_error" or
+	// "status_503". Empty if it will not be retried. Only set for AttemptEnd.
+	RetryReason string
+}
+
+// DecodeInfo describes the decoding of a response body.
+type DecodeInfo struct {
+	Operation string
+	// The HTTP status code of the response.
+	StatusCode int
+	// The size of the response body in bytes.
+	Size int
+	// The time decoding took.
+	Duration time.Duration
+	// The decoding error, if any.
+	Err error
+}
+
+// NoopInstrumentation implements [Instrumentation] with hooks that do nothing.
+// Embed it to implement only some of the hooks.
+type NoopInstrumentation struct{}
+
+func (NoopInstrumentation) RequestStart(ctx context.Context, info RequestInfo) context.Context {
+	return ctx
+}
+
+func (NoopInstrumentation) AttemptStart(ctx context.Context, info AttemptInfo) context.Context {
+	return ctx
+}
+
+func (NoopInstrumentation) AttemptEnd(ctx context.Context, info AttemptInfo) {}
+
+func (NoopInstrumentation) ResponseDecoded(ctx context.Context, info DecodeInfo) {}
+
+func (NoopInstrumentation) RequestEnd(ctx context.Context, info RequestInfo, err error) {}
+
+// retryReason describes why an attempt is being retried.
+func retryReason(res *http.Response, err error) string {
+	if res != nil {
+		return fmt.Sprintf("status_%d", res.StatusCode)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	return "connection_error"
+}
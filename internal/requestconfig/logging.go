@@ -0,0 +1,165 @@
+package requestconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers whose values are never logged.
+var sensitiveHeaders = map[string]bool{
+	"Authorization": true,
+}
+
+// sensitiveKeys are the JSON properties whose values are never logged,
+// wherever they appear in a body.
+var sensitiveKeys = map[string]bool{
+	"primary_account_number": true,
+	"verification_code":      true,
+	"account_number":         true,
+	"debtor_account_number":  true,
+	"tax_identifier":         true,
+}
+
+// sensitiveNestedKeys are the JSON properties whose values are never logged
+// when they appear within an object of the given property.
+var sensitiveNestedKeys = map[string]map[string]bool{
+	"identification": {"number": true},
+}
+
+// maxLoggedBodySize is the size of the largest response body which is logged.
+const maxLoggedBodySize = 64 << 10
+
+// logAttempt logs the outcome of one attempt of the request. At debug level it
+// also logs the redacted headers and bodies of the request and response.
+func (cfg *RequestConfig) logAttempt(ctx context.Context, req *http.Request, res *http.Response, err error, attempt int, latency time.Duration) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", latency),
+		slog.Int("attempt", attempt),
+		slog.Int("retry_count", attempt-1),
+	}
+	if cfg.Operation != "" {
+		attrs = append(attrs, slog.String("operation", cfg.Operation))
+	}
+	if cfg.IdempotencyKey != "" {
+		attrs = append(attrs, slog.String("idempotency_key", cfg.IdempotencyKey))
+	}
+	level := slog.LevelInfo
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+		if requestID := res.Header.Get("X-Request-Id"); requestID != "" {
+			attrs = append(attrs, slog.String("request_id", requestID))
+		}
+		if res.StatusCode >= 400 {
+			level = slog.LevelWarn
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		level = slog.LevelWarn
+	}
+
+	if cfg.Logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs,
+			slog.Any("request_headers", redactHeaders(req.Header)),
+			slog.String("request_body", redactBody(req.Header.Get("Content-Type"), cfg.Buffer)),
+		)
+		if res != nil {
+			attrs = append(attrs,
+				slog.Any("response_headers", redactHeaders(res.Header)),
+				slog.String("response_body", cfg.peekResponseBody(res)),
+			)
+		}
+	}
+
+	cfg.Logger.LogAttrs(ctx, level, "increase: request attempt", attrs...)
+}
+
+// peekResponseBody returns the redacted response body to log, leaving the body
+// readable. Only JSON bodies of up to maxLoggedBodySize are read, and never a
+// response which is returned to the caller unread, such as a file download.
+func (cfg *RequestConfig) peekResponseBody(res *http.Response) string {
+	if _, ok := cfg.ResponseBodyInto.(**http.Response); ok {
+		return "[streamed body omitted]"
+	}
+	contentType := res.Header.Get("Content-Type")
+	if !strings.Contains(contentType, "application/json") {
+		return "[" + contentType + " body omitted]"
+	}
+	if res.ContentLength > maxLoggedBodySize {
+		return "[large body omitted]"
+	}
+	contents, err := io.ReadAll(io.LimitReader(res.Body, maxLoggedBodySize+1))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(contents), res.Body), res.Body}
+	if err != nil {
+		return "[unreadable body omitted]"
+	}
+	if len(contents) > maxLoggedBodySize {
+		return "[large body omitted]"
+	}
+	return redactBody(contentType, contents)
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for key, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			out[key] = redacted
+		} else {
+			out[key] = strings.Join(values, ", ")
+		}
+	}
+	return out
+}
+
+// redactBody returns the body with the values of sensitive properties replaced,
+// or a placeholder if the body is not JSON.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if !strings.Contains(contentType, "application/json") {
+		return "[" + contentType + " body omitted]"
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "[invalid JSON body omitted]"
+	}
+	out, err := json.Marshal(redactValue(value, nil))
+	if err != nil {
+		return "[invalid JSON body omitted]"
+	}
+	return string(out)
+}
+
+func redactValue(value interface{}, nested map[string]bool) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if sensitiveKeys[key] || nested[key] {
+				value[key] = redacted
+			} else {
+				value[key] = redactValue(item, sensitiveNestedKeys[key])
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item, nil)
+		}
+	}
+	return value
+}
@@ -0,0 +1,10 @@
+package requestconfig
+
+// WithOperation names the service method making a request, such as
+// "ACHTransfers.New". It is set by each service method.
+func WithOperation(name string) func(*RequestConfig) error {
+	return func(r *RequestConfig) error {
+		r.Operation = name
+		return nil
+	}
+}
@@ -8,12 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math"
-	"math/rand"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"runtime"
-	"strconv"
 	"strings"
 	"time"
 
@@ -21,7 +19,10 @@ import (
 	"github.com/increase/increase-go/internal"
 	"github.com/increase/increase-go/internal/apierror"
 	"github.com/increase/increase-go/internal/apiform"
+	"github.com/increase/increase-go/internal/apijson"
 	"github.com/increase/increase-go/internal/apiquery"
+	"github.com/increase/increase-go/internal/circuitbreaker"
+	"github.com/increase/increase-go/internal/ratelimit"
 )
 
 func getNormalizedOS() string {
@@ -73,6 +74,7 @@ func getPlatformProperties() map[string]string {
 
 func NewRequestConfig(ctx context.Context, method string, u string, body interface{}, dst interface{}, opts ...func(*RequestConfig) error) (*RequestConfig, error) {
 	var b []byte
+	var newBody func() (io.ReadCloser, error)
 	contentType := "application/json"
 	if body, ok := body.(json.Marshaler); ok {
 		var err error
@@ -82,10 +84,16 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 		}
 	}
 	if body, ok := body.(apiform.Marshaler); ok {
-		var err error
-		b, contentType, err = body.MarshalMultipart()
-		if err != nil {
-			return nil, err
+		// Stream the body if its files can be read again for retries, and
+		// otherwise buffer it.
+		var stream bool
+		newBody, contentType, stream = apiform.NewStream(body)
+		if !stream {
+			var err error
+			b, contentType, err = body.MarshalMultipart()
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 	if body, ok := body.(apiquery.Queryer); ok {
@@ -95,9 +103,10 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 	if err != nil {
 		return nil, err
 	}
-	if b != nil {
+	if b != nil || newBody != nil {
 		req.Header.Set("Content-Type", contentType)
 	}
+	req.GetBody = newBody
 	req.Header.Set("Accept", "application/json")
 
 	for k, v := range getPlatformProperties() {
@@ -110,6 +119,11 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 		HTTPClient: http.DefaultClient,
 		Buffer:     b,
 	}
+	// Mutating requests get a single idempotency key for the lifetime of the
+	// logical call, so that every retry is recognized as the same operation.
+	if isMutating(method) {
+		cfg.IdempotencyKey = "stainless-go-" + uuid.New().String()
+	}
 	cfg.ResponseBodyInto = dst
 	err = cfg.Apply(opts...)
 	if err != nil {
@@ -123,14 +137,29 @@ func NewRequestConfig(ctx context.Context, method string, u string, body interfa
 // Editing the variables inside RequestConfig directly is unstable api. Prefer
 // composing func(\*RequestConfig) error instead if possible.
 type RequestConfig struct {
-	MaxRetries     int
+	MaxRetries int
+	// RetryPolicy decides which failed attempts are retried, and the delay
+	// before each retry. If nil, DefaultRetryPolicy is used.
+	RetryPolicy    RetryPolicy
 	RequestTimeout time.Duration
-	Context        context.Context
-	Request        *http.Request
-	BaseURL        *url.URL
-	HTTPClient     *http.Client
-	Middlewares    []middleware
-	APIKey         string
+	// If RetryDeadline is not zero, no retry is made that would start more than
+	// RetryDeadline after the first attempt.
+	RetryDeadline time.Duration
+	Context       context.Context
+	Request       *http.Request
+	BaseURL       *url.URL
+	HTTPClient    *http.Client
+	Middlewares   []middleware
+	APIKey        string
+	// If Logger is not nil, every attempt is logged to it, with redacted headers
+	// and bodies at debug level.
+	Logger *slog.Logger
+	// If RateLimiter is not nil, every attempt waits for it before being sent.
+	RateLimiter *ratelimit.Limiter
+	// If CircuitBreaker is not nil, attempts fail with
+	// circuitbreaker.ErrCircuitOpen without being sent while it is open, and the
+	// outcome of every attempt sent is recorded in it.
+	CircuitBreaker *circuitbreaker.Breaker
 	// If ResponseBodyInto not nil, then we will attempt to deserialize into
 	// ResponseBodyInto. If Destination is a []byte, then it will return the body as
 	// is.
@@ -139,6 +168,45 @@ type RequestConfig struct {
 	// given address
 	ResponseInto **http.Response
 	Buffer       []byte
+	// If ResponseMeta is not nil, it is populated with metadata about the final
+	// response, including when the API returns an error.
+	ResponseMeta *ResponseMeta
+	// IdempotencyKey is sent as the Idempotency-Key header on every attempt of
+	// the request. It is empty for requests which are not mutating.
+	IdempotencyKey string
+	// If IdempotencyStore and OperationID are set on a mutating request, the
+	// request's idempotency key and final response are journaled in the store
+	// under OperationID.
+	IdempotencyStore IdempotencyStore
+	OperationID      string
+	// Operation names the service method making the request, such as
+	// "ACHTransfers.New", for instrumentation and logging.
+	Operation string
+	// If StrictDecoding is true, a JSON response is checked against its schema
+	// after it is decoded, and any problem is returned as an
+	// *apijson.DecodeError.
+	StrictDecoding bool
+	// If OnUnknownValue is not nil, it is called with every enum value and
+	// property in a decoded response which is not in the schema.
+	OnUnknownValue func(UnknownValue)
+	// If Instrumentation is not nil, its hooks are called at each stage of the
+	// request.
+	Instrumentation Instrumentation
+	// If IsolateDownloads is true, file downloads from a host other than the
+	// API bypass CircuitBreaker and RateLimiter.
+	IsolateDownloads bool
+	// InClientOptions is true while the options given to the client are being
+	// applied. See [BeginClientOptions].
+	InClientOptions bool
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
 }
 
 // middleware is exactly the same type as the Middleware type found in the [option] package,
@@ -155,47 +223,82 @@ func applyMiddleware(middleware middleware, next middlewareNext) middlewareNext
 	}
 }
 
-func shouldRetry(req *http.Request, res *http.Response) bool {
-	// If there is no way to recover the Body, then we shouldn't retry.
-	if req.Body != nil && req.GetBody == nil {
-		return false
-	}
+// cancelOnClose releases the resources of a request attempt once its response
+// body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
 
-	// If there is no response, that indicates that there is a connection error
-	// so we retry the request.
-	if res == nil {
-		return true
-	}
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
 
-	// If the header explictly wants a retry behavior, respect that over the
-	// http status code.
-	if res.Header.Get("x-should-retry") == "true" {
-		return true
-	}
-	if res.Header.Get("x-should-retry") == "false" {
-		return false
+// sleep waits for the given duration, returning early with the context's error
+// if it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
+}
 
-	return res.StatusCode == http.StatusRequestTimeout ||
-		res.StatusCode == http.StatusConflict ||
-		res.StatusCode == http.StatusTooManyRequests ||
-		res.StatusCode >= http.StatusInternalServerError
+// canRetry reports whether the request can be sent again, which requires that
+// its body can be recovered.
+func canRetry(req *http.Request) bool {
+	return req.Body == nil || req.GetBody != nil
 }
 
-func retryDelay(res *http.Response, retryCount int) time.Duration {
-	maxDelay := 8 * time.Second
-	delay := time.Duration(0.5 * float64(time.Second) * math.Pow(2, float64(retryCount)))
-	if res != nil {
-		if parsed, err := strconv.ParseInt(res.Header.Get("Retry-After"), 10, 64); err == nil {
-			delay = time.Duration(parsed) * time.Second
-		}
+// UnknownValue is an enum value or property in a response which is not in the
+// schema, as reported to OnUnknownValue.
+type UnknownValue struct {
+	// The name of the operation whose response contained it, such as
+	// "Events.List".
+	Operation string
+	apijson.Unknown
+}
+
+// attemptOutcome classifies an attempt for the circuit breaker. Attempts which
+// fail to get a response, including by timing out, and server errors count
+// against the health of the API, but attempts abandoned by the caller do not.
+func attemptOutcome(ctx context.Context, res *http.Response) circuitbreaker.Outcome {
+	switch {
+	case ctx.Err() != nil:
+		return circuitbreaker.Ignored
+	case res == nil || res.StatusCode >= 500:
+		return circuitbreaker.Failure
+	default:
+		return circuitbreaker.Success
+	}
+}
+
+// parseAPIError decodes the error body of the response, leaving the response
+// body readable. A body which is not JSON, such as an error page from a proxy
+// or a storage host, is not decoded, so the error only has the status code.
+func parseAPIError(req *http.Request, res *http.Response) (*apierror.Error, error) {
+	aerr := apierror.Error{Request: req, Response: res, StatusCode: res.StatusCode}
+	contents, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
 	}
-	if delay > maxDelay {
-		delay = maxDelay
+	// Re-populate the response body so that debugging utilities can
+	// conveniently dump the response without issue.
+	res.Body = io.NopCloser(bytes.NewBuffer(contents))
+	if !json.Valid(contents) {
+		return &aerr, nil
 	}
-	jitter := rand.Int63n(int64(delay / 4))
-	delay -= time.Duration(jitter)
-	return delay
+	err = aerr.UnmarshalJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+	return &aerr, nil
 }
 
 func (cfg *RequestConfig) Execute() (err error) {
@@ -208,6 +311,41 @@ func (cfg *RequestConfig) Execute() (err error) {
 		cfg.Request.ContentLength = int64(len(cfg.Buffer))
 		cfg.Request.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(cfg.Buffer)), nil }
 		cfg.Request.Body, _ = cfg.Request.GetBody()
+	} else if cfg.Request.GetBody != nil && cfg.Request.Body == nil {
+		// A streamed body, whose length is not known in advance.
+		cfg.Request.Body, err = cfg.Request.GetBody()
+		if err != nil {
+			return err
+		}
+	}
+
+	// If the operation has already completed, res is its recorded response and
+	// no request is sent.
+	var res *http.Response
+	journaled := cfg.isJournaled()
+	if journaled {
+		res, err = cfg.beginOperation()
+		if err != nil {
+			return err
+		}
+	}
+	replayed := res != nil
+
+	if cfg.IdempotencyKey != "" {
+		cfg.Request.Header.Set("Idempotency-Key", cfg.IdempotencyKey)
+	}
+
+	start := time.Now()
+	attempts := 0
+	if cfg.Instrumentation != nil {
+		info := RequestInfo{Operation: cfg.Operation, Request: cfg.Request, IdempotencyKey: cfg.IdempotencyKey}
+		ctx := cfg.Instrumentation.RequestStart(cfg.Request.Context(), info)
+		cfg.Request = cfg.Request.WithContext(ctx)
+		defer func() {
+			info.Attempts = attempts
+			info.Duration = time.Since(start)
+			cfg.Instrumentation.RequestEnd(ctx, info, err)
+		}()
 	}
 
 	handler := cfg.HTTPClient.Do
@@ -215,26 +353,101 @@ func (cfg *RequestConfig) Execute() (err error) {
 		handler = applyMiddleware(cfg.Middlewares[i], handler)
 	}
 
-	var res *http.Response
-	for retryCount := 0; retryCount <= cfg.MaxRetries; retryCount += 1 {
-		ctx := cfg.Request.Context()
+	retryPolicy := cfg.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy{}
+	}
+	ctx := cfg.Request.Context()
+	for retryCount := 0; !replayed && retryCount <= cfg.MaxRetries; retryCount += 1 {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
 		if cfg.RequestTimeout != time.Duration(0) {
-			var cancel context.CancelFunc
-			ctx, cancel = context.WithTimeout(ctx, cfg.RequestTimeout)
-			defer cancel()
+			attemptCtx, cancel = context.WithTimeout(ctx, cfg.RequestTimeout)
+		}
+
+		var token circuitbreaker.Token
+		if cfg.CircuitBreaker != nil {
+			if token, err = cfg.CircuitBreaker.Allow(); err != nil {
+				cancel()
+				return err
+			}
+		}
+		if cfg.RateLimiter != nil {
+			if err = cfg.RateLimiter.Wait(ctx); err != nil {
+				if cfg.CircuitBreaker != nil {
+					cfg.CircuitBreaker.Record(token, circuitbreaker.Ignored)
+				}
+				cancel()
+				return err
+			}
 		}
 
-		req := cfg.Request.Clone(ctx)
-		req.Header.Set("Idempotency-Key", "stainless-go-"+uuid.New().String())
+		attempts += 1
+		var info AttemptInfo
+		if cfg.Instrumentation != nil {
+			info = AttemptInfo{Operation: cfg.Operation, Attempt: attempts, Request: cfg.Request}
+			attemptCtx = cfg.Instrumentation.AttemptStart(attemptCtx, info)
+		}
+		req := cfg.Request.Clone(attemptCtx)
+		attemptStart := time.Now()
 		res, err = handler(req)
-		if ctx != nil && ctx.Err() != nil {
+		if cfg.Instrumentation != nil {
+			info.Request, info.Response, info.Err = req, res, err
+			info.Duration = time.Since(attemptStart)
+		}
+		if res != nil {
+			// Release the attempt's resources once its response body is closed.
+			res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+		} else {
+			cancel()
+		}
+		if cfg.CircuitBreaker != nil {
+			cfg.CircuitBreaker.Record(token, attemptOutcome(ctx, res))
+		}
+		if cfg.Logger != nil {
+			cfg.logAttempt(ctx, req, res, err, attempts, time.Since(attemptStart))
+		}
+		if ctx.Err() != nil {
+			if cfg.Instrumentation != nil {
+				cfg.Instrumentation.AttemptEnd(attemptCtx, info)
+			}
+			if res != nil {
+				res.Body.Close()
+			}
 			return ctx.Err()
 		}
-		if !shouldRetry(cfg.Request, res) || retryCount >= cfg.MaxRetries {
+
+		attempt := RetryAttempt{Request: req, Response: res, Err: err, Attempt: attempts}
+		if res != nil && res.StatusCode >= 400 {
+			// The error is only informational here, so it is fine for the body
+			// not to be a valid error.
+			attempt.APIError, _ = parseAPIError(req, res)
+		}
+		if cfg.RateLimiter != nil && res != nil && res.StatusCode == http.StatusTooManyRequests {
+			if hint, ok := retryAfter(res, attempt.APIError); ok {
+				cfg.RateLimiter.Pause(hint)
+			}
+		}
+
+		retry := retryCount < cfg.MaxRetries && canRetry(cfg.Request) && retryPolicy.ShouldRetry(attempt)
+		var delay time.Duration
+		if retry {
+			delay = retryPolicy.Backoff(attempt)
+			retry = cfg.RetryDeadline == 0 || time.Since(start)+delay <= cfg.RetryDeadline
+		}
+		if cfg.Instrumentation != nil {
+			if retry {
+				info.RetryReason = retryReason(res, err)
+			}
+			cfg.Instrumentation.AttemptEnd(attemptCtx, info)
+		}
+		if !retry {
 			break
 		}
 
 		// Prepare next request and wait for the retry delay
+		if res != nil {
+			res.Body.Close()
+		}
 		if cfg.Request.GetBody != nil {
 			cfg.Request.Body, err = cfg.Request.GetBody()
 			if err != nil {
@@ -242,27 +455,37 @@ func (cfg *RequestConfig) Execute() (err error) {
 			}
 		}
 
-		time.Sleep(retryDelay(res, retryCount))
+		if err = sleep(ctx, delay); err != nil {
+			return err
+		}
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if res.StatusCode >= 400 {
-		aerr := apierror.Error{Request: cfg.Request, Response: res, StatusCode: res.StatusCode}
-		contents, err := io.ReadAll(res.Body)
+	if cfg.ResponseMeta != nil {
+		*cfg.ResponseMeta = newResponseMeta(res, cfg.IdempotencyKey, attempts)
+	}
+
+	if journaled && !replayed && res.StatusCode < 300 {
+		err = cfg.completeOperation(res)
 		if err != nil {
 			return err
 		}
-		// If there is an APIError, re-populate the response body so that debugging
-		// utilities can conveniently dump the response without issue.
-		res.Body = io.NopCloser(bytes.NewBuffer(contents))
-		err = aerr.UnmarshalJSON(contents)
+	}
+	if journaled && !replayed && isRefused(res.StatusCode) {
+		if err = cfg.abandonOperation(); err != nil {
+			return err
+		}
+	}
+
+	if res.StatusCode >= 400 {
+		aerr, err := parseAPIError(cfg.Request, res)
 		if err != nil {
 			return err
 		}
-		return &aerr
+		return aerr
 	}
 
 	if cfg.ResponseInto != nil {
@@ -279,6 +502,7 @@ func (cfg *RequestConfig) Execute() (err error) {
 	}
 
 	contents, err := io.ReadAll(res.Body)
+	res.Body.Close()
 	if err != nil {
 		return fmt.Errorf("error reading response body: %w", err)
 	}
@@ -300,12 +524,29 @@ func (cfg *RequestConfig) Execute() (err error) {
 		return nil
 	}
 
+	decodeStart := time.Now()
 	err = json.NewDecoder(bytes.NewReader(contents)).Decode(cfg.ResponseBodyInto)
 	if err != nil {
 		err = fmt.Errorf("error parsing response json: %w", err)
+	} else if cfg.StrictDecoding {
+		err = apijson.Validate(cfg.ResponseBodyInto)
+	}
+	if err == nil && cfg.OnUnknownValue != nil {
+		for _, unknown := range apijson.FindUnknown(cfg.ResponseBodyInto) {
+			cfg.OnUnknownValue(UnknownValue{Operation: cfg.Operation, Unknown: unknown})
+		}
+	}
+	if cfg.Instrumentation != nil {
+		cfg.Instrumentation.ResponseDecoded(ctx, DecodeInfo{
+			Operation:  cfg.Operation,
+			StatusCode: res.StatusCode,
+			Size:       len(contents),
+			Duration:   time.Since(decodeStart),
+			Err:        err,
+		})
 	}
 
-	return nil
+	return err
 }
 
 func ExecuteNewRequest(ctx context.Context, method string, u string, body interface{}, dst interface{}, opts ...func(*RequestConfig) error) error {
@@ -329,10 +570,23 @@ func (cfg *RequestConfig) Clone(ctx context.Context) *RequestConfig {
 		return nil
 	}
 	new := &RequestConfig{
-		MaxRetries: cfg.MaxRetries,
-		Context:    ctx,
-		Request:    req,
-		HTTPClient: cfg.HTTPClient,
+		MaxRetries:      cfg.MaxRetries,
+		RetryPolicy:     cfg.RetryPolicy,
+		RequestTimeout:  cfg.RequestTimeout,
+		RetryDeadline:   cfg.RetryDeadline,
+		Context:         ctx,
+		Request:         req,
+		BaseURL:         cfg.BaseURL,
+		HTTPClient:      cfg.HTTPClient,
+		Middlewares:     cfg.Middlewares,
+		APIKey:          cfg.APIKey,
+		RateLimiter:     cfg.RateLimiter,
+		CircuitBreaker:  cfg.CircuitBreaker,
+		Logger:          cfg.Logger,
+		Operation:       cfg.Operation,
+		StrictDecoding:  cfg.StrictDecoding,
+		OnUnknownValue:  cfg.OnUnknownValue,
+		Instrumentation: cfg.Instrumentation,
 	}
 	return new
 }
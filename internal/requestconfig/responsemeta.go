@@ -0,0 +1,59 @@
+package requestconfig
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta describes how a request was served by the API, independently of
+// the decoded response body.
+type ResponseMeta struct {
+	// The identifier Increase assigned to the request, useful when contacting
+	// support.
+	RequestID string
+	// The Idempotency-Key that was sent with the request, if any.
+	IdempotencyKey string
+	// Whether the API replayed the stored response of an earlier request with
+	// the same idempotency key instead of executing the request again.
+	IdempotentReplayed bool
+	// The HTTP status code of the final attempt.
+	StatusCode int
+	// The number of attempts made, including the first one.
+	Attempts int
+	// The request quota of the current rate limit window, if reported.
+	RateLimitLimit int64
+	// The number of requests remaining in the current rate limit window, if
+	// reported.
+	RateLimitRemaining int64
+	// The time until the current rate limit window resets, if reported.
+	RateLimitReset time.Duration
+}
+
+func newResponseMeta(res *http.Response, idempotencyKey string, attempts int) ResponseMeta {
+	meta := ResponseMeta{
+		IdempotencyKey: idempotencyKey,
+		Attempts:       attempts,
+	}
+	if res == nil {
+		return meta
+	}
+	meta.StatusCode = res.StatusCode
+	meta.RequestID = res.Header.Get("X-Request-Id")
+	meta.IdempotentReplayed, _ = strconv.ParseBool(res.Header.Get("Idempotent-Replayed"))
+	meta.RateLimitLimit = headerInt(res.Header, "RateLimit-Limit", "X-RateLimit-Limit")
+	meta.RateLimitRemaining = headerInt(res.Header, "RateLimit-Remaining", "X-RateLimit-Remaining")
+	meta.RateLimitReset = time.Duration(headerInt(res.Header, "RateLimit-Reset", "X-RateLimit-Reset")) * time.Second
+	return meta
+}
+
+// headerInt returns the integer value of the first of the given headers that is
+// present and well formed, or 0 if there is none.
+func headerInt(header http.Header, keys ...string) int64 {
+	for _, key := range keys {
+		if parsed, err := strconv.ParseInt(header.Get(key), 10, 64); err == nil {
+			return parsed
+		}
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package requestconfig
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/increase/increase-go/internal/apierror"
+)
+
+// RetryPolicy decides whether a failed attempt of a request is retried, and how
+// long to wait before retrying it. A request is never retried more than its
+// MaxRetries, nor when its body cannot be sent again.
+type RetryPolicy interface {
+	// ShouldRetry reports whether the request should be retried after the given
+	// attempt. It is called after every attempt but the last, including ones
+	// which succeeded.
+	ShouldRetry(attempt RetryAttempt) bool
+	// Backoff returns how long to wait before retrying after the given attempt.
+	Backoff(attempt RetryAttempt) time.Duration
+}
+
+// RetryAttempt describes the outcome of one attempt of a request.
+type RetryAttempt struct {
+	// The request that was sent.
+	Request *http.Request
+	// The response received, or nil if the request failed without one.
+	Response *http.Response
+	// The error returned by the HTTP client, if any.
+	Err error
+	// The decoded error body, if the response has an error status code and its
+	// body could be decoded.
+	APIError *apierror.Error
+	// The number of the attempt, starting at 1 for the first attempt.
+	Attempt int
+}
+
+// DefaultRetryPolicy retries connection errors, 408 Request Timeout,
+// 429 Too Many Requests and 5xx errors. A 409 Conflict is retried only when it is
+// not one of the API's error types, such as a conflict reported by a proxy.
+// The API's conflicts, an idempotency key or unique identifier that was already
+// used or an operation that is invalid for the object's state, are final.
+//
+// Retries back off exponentially from InitialDelay up to MaxDelay, with jitter,
+// unless the API asks to wait for a specific time.
+type DefaultRetryPolicy struct {
+	// The delay before the first retry. Defaults to 0.5 seconds.
+	InitialDelay time.Duration
+	// The maximum delay before any retry. Defaults to 8 seconds.
+	MaxDelay time.Duration
+}
+
+func (p DefaultRetryPolicy) ShouldRetry(attempt RetryAttempt) bool {
+	res := attempt.Response
+
+	// If there is no response, that indicates that there is a connection error
+	// so we retry the request.
+	if res == nil {
+		return true
+	}
+
+	// If the header explictly wants a retry behavior, respect that over the
+	// http status code.
+	if res.Header.Get("x-should-retry") == "true" {
+		return true
+	}
+	if res.Header.Get("x-should-retry") == "false" {
+		return false
+	}
+
+	if res.StatusCode == http.StatusConflict {
+		return attempt.APIError == nil || !attempt.APIError.Type.IsKnown()
+	}
+
+	return res.StatusCode == http.StatusRequestTimeout ||
+		res.StatusCode == http.StatusTooManyRequests ||
+		res.StatusCode >= http.StatusInternalServerError
+}
+
+func (p DefaultRetryPolicy) Backoff(attempt RetryAttempt) time.Duration {
+	initialDelay := p.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 500 * time.Millisecond
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = 8 * time.Second
+	}
+
+	delay := initialDelay
+	for i := 1; i < attempt.Attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if hint, ok := retryAfter(attempt.Response, attempt.APIError); ok {
+		delay = hint
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	if delay/4 > 0 {
+		jitter := rand.Int63n(int64(delay / 4))
+		delay -= time.Duration(jitter)
+	}
+	return delay
+}
+
+// retryAfter returns how long the API asked to wait before retrying, from the
+// Retry-After header or the retry_after of the error body.
+func retryAfter(res *http.Response, apierr *apierror.Error) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	if parsed, err := strconv.ParseInt(res.Header.Get("Retry-After"), 10, 64); err == nil {
+		return time.Duration(parsed) * time.Second, true
+	}
+	if apierr != nil && !apierr.JSON.RetryAfter.IsNull() {
+		return time.Duration(apierr.RetryAfter) * time.Second, true
+	}
+	return 0, false
+}
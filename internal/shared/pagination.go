@@ -32,6 +32,14 @@ func (r *Page[T]) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Page[T]) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pageJSON) RawJSON() string {
+	return r.raw
+}
+
 // NextPage returns the next page as defined by this pagination style. When there
 // is no next page, this function will return a 'nil' for the page value, but will
 // not return an error
@@ -0,0 +1,157 @@
+package increase_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+)
+
+const transactionJSON = `{
+  "id": "transaction_uyrp7fld2ium70oa7oi",
+  "account_id": "account_in71c4amph0vgo2qllky",
+  "amount": 100,
+  "created_at": "2020-01-31T23:59:59Z",
+  "currency": "USD",
+  "description": "INVOICE 2468",
+  "route_id": null,
+  "route_type": null,
+  "source": {
+    "category": "internal_source",
+    "internal_source": {"amount": 100, "currency": "USD", "reason": "sample_funds", "memo": "new"}
+  },
+  "type": "transaction",
+  "settlement_batch": {"id": "batch_123"}
+}`
+
+func TestModelRoundTrip(t *testing.T) {
+	var transaction increase.Transaction
+	if err := json.Unmarshal([]byte(transactionJSON), &transaction); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if transaction.JSON.RawJSON() != transactionJSON {
+		t.Errorf("expected RawJSON to return the JSON the transaction was decoded from, got %s", transaction.JSON.RawJSON())
+	}
+
+	encoded, err := json.Marshal(transaction)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	// encoding/json compacts the output of MarshalJSON, but nothing else
+	// changes.
+	var expected bytes.Buffer
+	json.Compact(&expected, []byte(transactionJSON))
+	if string(encoded) != expected.String() {
+		t.Fatalf("expected the transaction to encode to the JSON it was decoded from, got %s", encoded)
+	}
+
+	var reloaded increase.Transaction
+	if err := json.Unmarshal(encoded, &reloaded); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if reloaded.ID != transaction.ID || reloaded.Source.InternalSource.Amount != 100 {
+		t.Errorf("expected the reloaded transaction to match, got %+v", reloaded)
+	}
+	if !reloaded.JSON.RouteID.IsNull() || reloaded.JSON.RouteID.IsMissing() {
+		t.Errorf("expected route_id to still be an explicit null")
+	}
+	if !reloaded.Source.JSON.CardRefund.IsMissing() {
+		t.Errorf("expected source.card_refund to still be missing")
+	}
+	if raw := reloaded.JSON.ExtraFields["settlement_batch"].Raw(); raw != `{"id":"batch_123"}` {
+		t.Errorf("expected the unknown property to be preserved, got %q", raw)
+	}
+	if raw := reloaded.Source.InternalSource.JSON.ExtraFields["memo"].Raw(); raw != `"new"` {
+		t.Errorf("expected the nested unknown property to be preserved, got %q", raw)
+	}
+}
+
+func TestModelMarshalChanged(t *testing.T) {
+	var transaction increase.Transaction
+	if err := json.Unmarshal([]byte(transactionJSON), &transaction); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	transaction.Description = "INVOICE 1357"
+	transaction.Source.InternalSource.Amount = 200
+
+	encoded, err := json.Marshal(transaction)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	var reloaded increase.Transaction
+	if err := json.Unmarshal(encoded, &reloaded); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if reloaded.Description != "INVOICE 1357" || reloaded.Source.InternalSource.Amount != 200 {
+		t.Errorf("expected the changed fields to be encoded, got %s", encoded)
+	}
+	if reloaded.Amount != 100 || !reloaded.JSON.RouteID.IsNull() || !reloaded.Source.JSON.CardRefund.IsMissing() {
+		t.Errorf("expected the unchanged fields to be encoded as they were decoded, got %s", encoded)
+	}
+	if raw := reloaded.JSON.ExtraFields["settlement_batch"].Raw(); raw != `{"id":"batch_123"}` {
+		t.Errorf("expected the unknown property to be preserved, got %q", raw)
+	}
+	if raw := reloaded.Source.InternalSource.JSON.ExtraFields["memo"].Raw(); raw != `"new"` {
+		t.Errorf("expected the nested unknown property to be preserved, got %q", raw)
+	}
+	if transaction.JSON.RawJSON() != transactionJSON {
+		t.Errorf("expected RawJSON to still return the JSON the transaction was decoded from")
+	}
+}
+
+func TestModelMarshalConstructed(t *testing.T) {
+	account := increase.Account{
+		ID:        "account_in71c4amph0vgo2qllky",
+		CreatedAt: time.Date(2020, 1, 31, 23, 59, 59, 0, time.UTC),
+		Status:    increase.AccountStatusOpen,
+	}
+	encoded, err := json.Marshal(account)
+	if err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	for _, expected := range []string{`"id":"account_in71c4amph0vgo2qllky"`, `"created_at":"2020-01-31T23:59:59Z"`, `"status":"open"`} {
+		if !strings.Contains(string(encoded), expected) {
+			t.Errorf("expected %s to contain %s", encoded, expected)
+		}
+	}
+}
+
+func TestExtraField(t *testing.T) {
+	var transaction increase.Transaction
+	if err := json.Unmarshal([]byte(transactionJSON), &transaction); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+
+	memo, ok, err := increase.ExtraField[string](transaction, "source.internal_source.memo")
+	if err != nil || !ok || memo != "new" {
+		t.Errorf("expected the nested property, got %q %v %v", memo, ok, err)
+	}
+
+	type settlementBatch struct {
+		ID string `json:"id"`
+	}
+	batch, ok, err := increase.ExtraField[settlementBatch](&transaction, "settlement_batch")
+	if err != nil || !ok || batch.ID != "batch_123" {
+		t.Errorf("expected the object property, got %+v %v %v", batch, ok, err)
+	}
+
+	_, ok, err = increase.ExtraField[string](transaction, "route_id")
+	if err != nil || ok {
+		t.Errorf("expected a null property not to be ok, got %v %v", ok, err)
+	}
+	_, ok, err = increase.ExtraField[int64](transaction, "source.card_settlement.amount")
+	if err != nil || ok {
+		t.Errorf("expected a missing property not to be ok, got %v %v", ok, err)
+	}
+	_, _, err = increase.ExtraField[int64](transaction, "settlement_batch")
+	if err == nil {
+		t.Errorf("expected an error decoding an object into an int64")
+	}
+	_, _, err = increase.ExtraField[string]("not a model", "id")
+	if err == nil {
+		t.Errorf("expected an error for a value which is not a model")
+	}
+}
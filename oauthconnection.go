@@ -38,6 +38,7 @@ func NewOauthConnectionService(opts ...option.RequestOption) (r *OauthConnection
 // Retrieve an OAuth Connection
 func (r *OauthConnectionService) Get(ctx context.Context, oauthConnectionID string, opts ...option.RequestOption) (res *OauthConnection, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("OauthConnections.Get")}, opts...)
 	path := fmt.Sprintf("oauth_connections/%s", oauthConnectionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *OauthConnectionService) Get(ctx context.Context, oauthConnectionID stri
 func (r *OauthConnectionService) List(ctx context.Context, query OauthConnectionListParams, opts ...option.RequestOption) (res *shared.Page[OauthConnection], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("OauthConnections.List")}, opts...)
 	path := "oauth_connections"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -99,6 +100,14 @@ func (r *OauthConnection) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r OauthConnection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r oauthConnectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether the connection is active.
 type OauthConnectionStatus string
 
@@ -109,6 +118,14 @@ const (
 	OauthConnectionStatusInactive OauthConnectionStatus = "inactive"
 )
 
+func (r OauthConnectionStatus) IsKnown() bool {
+	switch r {
+	case OauthConnectionStatusActive, OauthConnectionStatusInactive:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `oauth_connection`.
 type OauthConnectionType string
@@ -117,6 +134,14 @@ const (
 	OauthConnectionTypeOauthConnection OauthConnectionType = "oauth_connection"
 )
 
+func (r OauthConnectionType) IsKnown() bool {
+	switch r {
+	case OauthConnectionTypeOauthConnection:
+		return true
+	}
+	return false
+}
+
 type OauthConnectionListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
@@ -0,0 +1,216 @@
+// Package ofx parses the OFX files produced by `account_statement_ofx` Exports.
+package ofx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Statement is a bank statement for an Account.
+type Statement struct {
+	// The ISO 4217 code for the currency of the statement's amounts.
+	Currency string
+	// The routing number of the Account.
+	BankID string
+	// The account number of the Account.
+	AccountID string
+	// The type of the account, such as "CHECKING".
+	AccountType string
+	// The start and end of the period covered by the statement.
+	Start time.Time
+	End   time.Time
+	// The Transactions in the statement, in the order they appear in the file.
+	Transactions []Transaction
+	// The Account's balance, and its available balance, at the end of the
+	// statement. They are nil if the statement does not include them.
+	LedgerBalance    *Balance
+	AvailableBalance *Balance
+}
+
+// Balance is an Account's balance at a point in time.
+type Balance struct {
+	// The balance in the minor unit of the statement's currency.
+	Amount int64
+	// The time of the balance.
+	AsOf time.Time
+}
+
+// Transaction is a transaction in a Statement.
+type Transaction struct {
+	// The OFX transaction type, such as "CREDIT" or "DEBIT".
+	Type string
+	// The time the transaction was posted.
+	Posted time.Time
+	// The amount of the transaction in the minor unit of the statement's
+	// currency.
+	Amount int64
+	// The financial institution's identifier for the transaction, which is
+	// unique within the Account.
+	FITID string
+	// The name of the payee or description of the transaction.
+	Name string
+	Memo string
+	// The identifier of the Increase Transaction that the transaction came from,
+	// if its FITID is one. See also [Reconcile].
+	TransactionID string
+}
+
+// Parse parses an OFX file, in either the SGML format of OFX 1 or the XML
+// format of OFX 2, and returns the bank statements in it.
+func Parse(r io.Reader) ([]Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	root, err := parseElements(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if root.child("OFX") == nil {
+		return nil, errors.New("ofx: no OFX element")
+	}
+
+	var statements []Statement
+	for _, msgs := range root.child("OFX").children("BANKMSGSRSV1") {
+		for _, trnrs := range msgs.children("STMTTRNRS") {
+			for _, stmtrs := range trnrs.children("STMTRS") {
+				statement, err := parseStatement(stmtrs)
+				if err != nil {
+					return nil, err
+				}
+				statements = append(statements, statement)
+			}
+		}
+	}
+	return statements, nil
+}
+
+func parseStatement(e *element) (s Statement, err error) {
+	s.Currency = e.text("CURDEF")
+	if account := e.child("BANKACCTFROM"); account != nil {
+		s.BankID = account.text("BANKID")
+		s.AccountID = account.text("ACCTID")
+		s.AccountType = account.text("ACCTTYPE")
+	}
+	exponent := minorUnitExponent(s.Currency)
+
+	if list := e.child("BANKTRANLIST"); list != nil {
+		if s.Start, err = parseOptionalDate(list, "DTSTART"); err != nil {
+			return s, err
+		}
+		if s.End, err = parseOptionalDate(list, "DTEND"); err != nil {
+			return s, err
+		}
+		for _, trn := range list.children("STMTTRN") {
+			t := Transaction{
+				Type:  trn.text("TRNTYPE"),
+				FITID: trn.text("FITID"),
+				Name:  trn.text("NAME"),
+				Memo:  trn.text("MEMO"),
+			}
+			if t.Posted, err = parseOptionalDate(trn, "DTPOSTED"); err != nil {
+				return s, err
+			}
+			if t.Amount, err = parseAmount(trn.text("TRNAMT"), exponent); err != nil {
+				return s, fmt.Errorf("ofx: TRNAMT of %s: %w", t.FITID, err)
+			}
+			if strings.HasPrefix(t.FITID, "transaction_") {
+				t.TransactionID = t.FITID
+			}
+			s.Transactions = append(s.Transactions, t)
+		}
+	}
+
+	if s.LedgerBalance, err = parseBalance(e.child("LEDGERBAL"), exponent); err != nil {
+		return s, err
+	}
+	if s.AvailableBalance, err = parseBalance(e.child("AVAILBAL"), exponent); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+func parseBalance(e *element, exponent int) (*Balance, error) {
+	if e == nil {
+		return nil, nil
+	}
+	amount, err := parseAmount(e.text("BALAMT"), exponent)
+	if err != nil {
+		return nil, fmt.Errorf("ofx: BALAMT of %s: %w", e.name, err)
+	}
+	asOf, err := parseOptionalDate(e, "DTASOF")
+	if err != nil {
+		return nil, err
+	}
+	return &Balance{Amount: amount, AsOf: asOf}, nil
+}
+
+// minorUnitExponent returns the number of decimal places in amounts of a
+// currency.
+func minorUnitExponent(currency string) int {
+	switch currency {
+	case "JPY":
+		return 0
+	default:
+		return 2
+	}
+}
+
+// parseAmount parses a decimal amount such as "-12.34" into minor units.
+func parseAmount(s string, exponent int) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("missing amount")
+	}
+	whole, fraction, _ := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > exponent {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", s, exponent)
+	}
+	fraction += strings.Repeat("0", exponent-len(fraction))
+	n, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil || strings.ContainsAny(fraction, "+-") {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return n, nil
+}
+
+func parseOptionalDate(e *element, name string) (time.Time, error) {
+	value := e.text(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := parseDate(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ofx: %s: %w", name, err)
+	}
+	return t, nil
+}
+
+// parseDate parses an OFX date such as "20240131120000.000[-5:EST]". Dates
+// without a time zone are in UTC.
+func parseDate(s string) (time.Time, error) {
+	value, zone, _ := strings.Cut(s, "[")
+	value, _, _ = strings.Cut(value, ".")
+	if len(value) < 8 || len(value) > 14 || len(value)%2 != 0 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	t, err := time.Parse("20060102150405"[:len(value)], value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	if zone == "" {
+		return t, nil
+	}
+	offset, _, _ := strings.Cut(strings.TrimSuffix(zone, "]"), ":")
+	hours, err := strconv.ParseFloat(offset, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time zone in date %q", s)
+	}
+	seconds := int(hours * 3600)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.FixedZone("", seconds)), nil
+}
@@ -0,0 +1,170 @@
+package ofx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/ofx"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:USASCII
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20200201000000<LANGUAGE>ENG</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>1
+<STATUS><CODE>0<SEVERITY>INFO</STATUS>
+<STMTRS>
+<CURDEF>USD
+<BANKACCTFROM>
+<BANKID>101050001
+<ACCTID>987654321
+<ACCTTYPE>CHECKING
+</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20200101000000.000[-5:EST]
+<DTEND>20200131235959.000[-5:EST]
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20200131120000.000[-5:EST]
+<TRNAMT>1.00
+<FITID>transaction_uyrp7fld2ium70oa7oi
+<NAME>Frederick S. Holmes
+<MEMO>Rent &amp; deposit
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20200131
+<TRNAMT>-25.5
+<FITID>3241
+<NAME>Card payment
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>1234.56<DTASOF>20200131235959</LEDGERBAL>
+<AVAILBAL><BALAMT>1200.00<DTASOF>20200131235959</AVAILBAL>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE"?>
+<OFX>
+  <BANKMSGSRSV1>
+    <STMTTRNRS>
+      <STMTRS>
+        <CURDEF>USD</CURDEF>
+        <BANKACCTFROM><BANKID>101050001</BANKID><ACCTID>987654321</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>CREDIT</TRNTYPE>
+            <DTPOSTED>20200131120000</DTPOSTED>
+            <TRNAMT>1.00</TRNAMT>
+            <FITID>transaction_uyrp7fld2ium70oa7oi</FITID>
+            <NAME>Frederick S. Holmes</NAME>
+            <MEMO/>
+          </STMTTRN>
+        </BANKTRANLIST>
+        <LEDGERBAL><BALAMT>1234.56</BALAMT><DTASOF>20200131235959</DTASOF></LEDGERBAL>
+      </STMTRS>
+    </STMTTRNRS>
+  </BANKMSGSRSV1>
+</OFX>
+`
+
+func TestParseSGML(t *testing.T) {
+	statements, err := ofx.Parse(strings.NewReader(sgmlStatement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+	s := statements[0]
+	if s.Currency != "USD" || s.BankID != "101050001" || s.AccountID != "987654321" || s.AccountType != "CHECKING" {
+		t.Errorf("unexpected statement account %+v", s)
+	}
+	est := time.FixedZone("", -5*3600)
+	if !s.Start.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, est)) {
+		t.Errorf("expected the start in EST, got %v", s.Start)
+	}
+	if len(s.Transactions) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(s.Transactions))
+	}
+	first, second := s.Transactions[0], s.Transactions[1]
+	if first.Amount != 100 || first.FITID != "transaction_uyrp7fld2ium70oa7oi" || first.TransactionID != first.FITID || first.Memo != "Rent & deposit" {
+		t.Errorf("unexpected first transaction %+v", first)
+	}
+	if second.Amount != -2550 || second.TransactionID != "" || second.Name != "Card payment" {
+		t.Errorf("unexpected second transaction %+v", second)
+	}
+	if s.LedgerBalance == nil || s.LedgerBalance.Amount != 123456 {
+		t.Errorf("unexpected ledger balance %+v", s.LedgerBalance)
+	}
+	if s.AvailableBalance == nil || s.AvailableBalance.Amount != 120000 {
+		t.Errorf("unexpected available balance %+v", s.AvailableBalance)
+	}
+}
+
+func TestParseXML(t *testing.T) {
+	statements, err := ofx.Parse(strings.NewReader(xmlStatement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(statements) != 1 || len(statements[0].Transactions) != 1 {
+		t.Fatalf("expected 1 statement with 1 transaction, got %+v", statements)
+	}
+	trn := statements[0].Transactions[0]
+	if trn.Amount != 100 || trn.Name != "Frederick S. Holmes" || trn.Memo != "" {
+		t.Errorf("unexpected transaction %+v", trn)
+	}
+	if statements[0].AvailableBalance != nil {
+		t.Errorf("expected no available balance, got %+v", statements[0].AvailableBalance)
+	}
+}
+
+func TestParseInvalidAmount(t *testing.T) {
+	_, err := ofx.Parse(strings.NewReader(strings.Replace(sgmlStatement, "<TRNAMT>-25.5", "<TRNAMT>-25.555", 1)))
+	if err == nil || !strings.Contains(err.Error(), "TRNAMT of 3241") {
+		t.Errorf("expected an error for the amount of 3241, got %v", err)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	statements, err := ofx.Parse(strings.NewReader(sgmlStatement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transactions := []increase.Transaction{
+		{ID: "transaction_vc9kl2ev0n3c1dhx9yrb", Amount: -2550, CreatedAt: time.Date(2020, 1, 31, 15, 0, 0, 0, time.UTC)},
+		{ID: "transaction_uyrp7fld2ium70oa7oi", Amount: 100, CreatedAt: time.Date(2020, 1, 31, 17, 0, 0, 0, time.UTC)},
+		{ID: "transaction_x9r4k2ev0n3c1dhx1abc", Amount: 500, CreatedAt: time.Date(2020, 1, 31, 18, 0, 0, 0, time.UTC)},
+	}
+
+	r := ofx.Reconcile(statements[0], transactions)
+	if len(r.Matched) != 2 {
+		t.Fatalf("expected 2 matches, got %+v", r.Matched)
+	}
+	for _, m := range r.Matched {
+		if m.Statement.TransactionID != m.Transaction.ID {
+			t.Errorf("expected %s to be matched with its Transaction, got %s", m.Statement.FITID, m.Transaction.ID)
+		}
+	}
+	if r.Matched[1].Statement.FITID != "3241" || r.Matched[1].Transaction.ID != "transaction_vc9kl2ev0n3c1dhx9yrb" {
+		t.Errorf("expected 3241 to be matched by amount and day, got %+v", r.Matched[1])
+	}
+	if len(r.UnmatchedStatement) != 0 {
+		t.Errorf("expected no unmatched statement transactions, got %+v", r.UnmatchedStatement)
+	}
+	if len(r.UnmatchedTransactions) != 1 || r.UnmatchedTransactions[0].ID != "transaction_x9r4k2ev0n3c1dhx1abc" {
+		t.Errorf("expected one unmatched Transaction, got %+v", r.UnmatchedTransactions)
+	}
+}
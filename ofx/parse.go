@@ -0,0 +1,100 @@
+package ofx
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// element is an OFX element. Aggregates have child elements, and other
+// elements have a value.
+type element struct {
+	name     string
+	value    string
+	elements []*element
+}
+
+func (e *element) child(name string) *element {
+	for _, child := range e.elements {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func (e *element) children(name string) []*element {
+	var children []*element
+	for _, child := range e.elements {
+		if child.name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// text returns the value of the named child, or "" if there is none.
+func (e *element) text(name string) string {
+	if child := e.child(name); child != nil {
+		return child.value
+	}
+	return ""
+}
+
+// parseElements parses the elements of an OFX file into a tree, under a root
+// element without a name. The headers of OFX 1 files, and the declarations and
+// processing instructions of OFX 2 files, are skipped. Elements with a value
+// need not have an end tag, as in OFX 1.
+func parseElements(data string) (*element, error) {
+	root := &element{}
+	stack := []*element{root}
+	for len(data) > 0 {
+		start := strings.IndexByte(data, '<')
+		if start < 0 {
+			start = len(data)
+		}
+		if text := strings.TrimSpace(data[:start]); text != "" && len(stack) > 1 {
+			top := stack[len(stack)-1]
+			top.value += html.UnescapeString(text)
+		}
+		if start == len(data) {
+			break
+		}
+		data = data[start:]
+		end := strings.IndexByte(data, '>')
+		if end < 0 {
+			return nil, fmt.Errorf("ofx: unterminated tag %q", data)
+		}
+		tag := strings.TrimSpace(data[1:end])
+		data = data[end+1:]
+
+		switch {
+		case strings.HasPrefix(tag, "?"), strings.HasPrefix(tag, "!"):
+			continue
+		case strings.HasPrefix(tag, "/"):
+			name := strings.TrimSpace(tag[1:])
+			i := len(stack) - 1
+			for i > 0 && stack[i].name != name {
+				i--
+			}
+			if i == 0 {
+				return nil, fmt.Errorf("ofx: unexpected end tag </%s>", name)
+			}
+			stack = stack[:i]
+		default:
+			// The start of an element ends the previous element if it has a
+			// value.
+			if len(stack) > 1 && stack[len(stack)-1].value != "" {
+				stack = stack[:len(stack)-1]
+			}
+			name, _, _ := strings.Cut(tag, " ")
+			e := &element{name: strings.TrimSuffix(name, "/")}
+			parent := stack[len(stack)-1]
+			parent.elements = append(parent.elements, e)
+			if !strings.HasSuffix(tag, "/") {
+				stack = append(stack, e)
+			}
+		}
+	}
+	return root, nil
+}
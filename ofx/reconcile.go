@@ -0,0 +1,80 @@
+package ofx
+
+import (
+	"github.com/increase/increase-go"
+)
+
+// Reconciliation is the result of matching the transactions in a Statement with
+// Increase Transactions.
+type Reconciliation struct {
+	Matched []Matched
+	// The transactions in the statement which match no Transaction.
+	UnmatchedStatement []Transaction
+	// The Transactions which match no transaction in the statement.
+	UnmatchedTransactions []increase.Transaction
+}
+
+// Matched is a transaction in a Statement and the Transaction it came from.
+type Matched struct {
+	Statement   Transaction
+	Transaction increase.Transaction
+}
+
+// Reconcile matches each transaction in a statement with the Transaction it
+// came from, such as one returned by [increase.TransactionService.List] for the
+// same Account and period. A statement transaction matches the Transaction
+// whose ID is its TransactionID. Those without a TransactionID match a
+// Transaction with the same amount created on the same day as they were
+// posted, if there is one which has not already been matched. The
+// TransactionID of each matched statement transaction is set to the ID of its
+// Transaction.
+func Reconcile(statement Statement, transactions []increase.Transaction) Reconciliation {
+	var r Reconciliation
+	matched := make([]bool, len(transactions))
+	byID := make(map[string]int, len(transactions))
+	for i, t := range transactions {
+		byID[t.ID] = i
+	}
+
+	var unmatched []Transaction
+	for _, s := range statement.Transactions {
+		if i, ok := byID[s.TransactionID]; ok && !matched[i] {
+			matched[i] = true
+			r.Matched = append(r.Matched, Matched{Statement: s, Transaction: transactions[i]})
+		} else {
+			unmatched = append(unmatched, s)
+		}
+	}
+
+	for _, s := range unmatched {
+		found := false
+		for i, t := range transactions {
+			if matched[i] || t.Amount != s.Amount || !sameDay(s, t) {
+				continue
+			}
+			matched[i], found = true, true
+			s.TransactionID = t.ID
+			r.Matched = append(r.Matched, Matched{Statement: s, Transaction: t})
+			break
+		}
+		if !found {
+			r.UnmatchedStatement = append(r.UnmatchedStatement, s)
+		}
+	}
+
+	for i, t := range transactions {
+		if !matched[i] {
+			r.UnmatchedTransactions = append(r.UnmatchedTransactions, t)
+		}
+	}
+	return r
+}
+
+// sameDay reports whether a Transaction was created on the day that a
+// statement transaction was posted, in the statement's time zone.
+func sameDay(s Transaction, t increase.Transaction) bool {
+	created := t.CreatedAt.In(s.Posted.Location())
+	y1, m1, d1 := s.Posted.Date()
+	y2, m2, d2 := created.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
@@ -5,10 +5,15 @@ package option
 import (
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
+	"github.com/increase/increase-go/internal/apijson"
+	"github.com/increase/increase-go/internal/circuitbreaker"
+	"github.com/increase/increase-go/internal/ratelimit"
 	"github.com/increase/increase-go/internal/requestconfig"
 	"github.com/tidwall/sjson"
 )
@@ -74,6 +79,183 @@ func WithMaxRetries(retries int) RequestOption {
 	}
 }
 
+// RetryPolicy decides whether a failed attempt of a request is retried, and how
+// long to wait before retrying it.
+type RetryPolicy = requestconfig.RetryPolicy
+
+// RetryAttempt describes the outcome of one attempt of a request, as given to a
+// [RetryPolicy].
+type RetryAttempt = requestconfig.RetryAttempt
+
+// DefaultRetryPolicy is the [RetryPolicy] used when none is given. Its zero value
+// is ready to use, and its backoff can be configured.
+type DefaultRetryPolicy = requestconfig.DefaultRetryPolicy
+
+// WithRetryPolicy returns a RequestOption that sets the [RetryPolicy] used to
+// decide which failed attempts are retried, and how long to wait before each
+// retry. The number of retries is still limited by [WithMaxRetries].
+func WithRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimit returns a RequestOption that limits requests to the given
+// average number per second, allowing bursts of up to burst requests. Retries
+// count towards the limit.
+//
+// Every request made with the returned option shares one limiter, so giving it
+// to [increase.NewClient] limits all the services of that client together. When
+// any of those requests is rate limited by the API with a hint of when to retry,
+// all of them pause until then.
+//
+// Requests made with the option fail with an error when requestsPerSecond or
+// burst is not positive.
+func WithRateLimit(requestsPerSecond float64, burst int) RequestOption {
+	limiter := ratelimit.NewLimiter(requestsPerSecond, burst)
+	return func(r *requestconfig.RequestConfig) error {
+		if requestsPerSecond <= 0 || burst <= 0 {
+			return fmt.Errorf("requestoption: rate limit and burst must be positive, got %v and %d", requestsPerSecond, burst)
+		}
+		r.RateLimiter = limiter
+		return nil
+	}
+}
+
+// CircuitBreakerConfig configures the circuit breaker of [WithCircuitBreaker].
+// Zero fields take their default values: the circuit opens when at least half
+// of at least 10 attempts within a minute fail, and is probed after 30 seconds.
+type CircuitBreakerConfig = circuitbreaker.Config
+
+// WithCircuitBreaker returns a RequestOption that stops sending requests while
+// the API appears to be unhealthy. Attempts which fail to connect, time out or
+// receive a 5xx response count as failures. Once the rate of failures reaches
+// the configured threshold, the circuit opens, and requests fail immediately
+// with [increase.ErrCircuitOpen] instead of being sent or retried. After the
+// configured delay a single probe request is let through, and the circuit
+// closes again if it succeeds.
+//
+// Every request made with the returned option shares one circuit breaker, so
+// giving it to [increase.NewClient] covers all the services of that client.
+func WithCircuitBreaker(config CircuitBreakerConfig) RequestOption {
+	breaker := circuitbreaker.NewBreaker(config)
+	return func(r *requestconfig.RequestConfig) error {
+		r.CircuitBreaker = breaker
+		return nil
+	}
+}
+
+// WithIsolatedDownloads returns a RequestOption that sends file downloads from a
+// host other than the API, such as a storage service, without the circuit
+// breaker of [WithCircuitBreaker] or the rate limiter of [WithRateLimit], so
+// that the failures of that host do not open the circuit for the API and its
+// downloads do not use up the API's rate limit. They are still sent through the
+// client's middleware, logger and instrumentation.
+func WithIsolatedDownloads() RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.IsolateDownloads = true
+		return nil
+	}
+}
+
+// WithLogger returns a RequestOption that logs every attempt of a request to the
+// given logger, with its method, path, status, latency, retry count and
+// idempotency key. At debug level the request and response headers and bodies
+// are logged too, with credentials, card numbers, account numbers and
+// identification numbers redacted.
+func WithLogger(logger *slog.Logger) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.Logger = logger
+		return nil
+	}
+}
+
+// WithStrictDecoding returns a RequestOption that checks every JSON response
+// against its schema after decoding it. Required fields which are missing,
+// fields whose values have the wrong type, and enum values unknown to this
+// version of the SDK are reported together as an [increase.DecodeError] with
+// the JSON path of each, instead of leaving the response partially populated.
+//
+// Because the API adds properties and enum values without notice, strict
+// decoding is meant for detecting drift in testing and staging environments
+// rather than for production use.
+func WithStrictDecoding() RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.StrictDecoding = true
+		return nil
+	}
+}
+
+// UnknownValue is an enum value or property in a response which this version of
+// the SDK does not know about, usually because it was added to the API since.
+// Kind says which it is, and Value holds the enum value or property name.
+type UnknownValue = requestconfig.UnknownValue
+
+// UnknownKind is the kind of an [UnknownValue].
+type UnknownKind = apijson.UnknownKind
+
+const (
+	// An enum value which is not one of the constants of its type.
+	UnknownEnumValue = apijson.UnknownEnumValue
+	// A property which is not a field of its struct, and is available in the
+	// struct's JSON.ExtraFields.
+	UnknownField = apijson.UnknownField
+)
+
+// WithUnknownValueHandler returns a RequestOption that calls handler with the
+// unknown enum values and properties in decoded responses. Each is reported only
+// once per distinct enum type and value, or struct type and property name,
+// across every request made with the returned option, so giving it to
+// [increase.NewClient] reports each API addition once per client. The handler
+// is called synchronously, so it should not block.
+func WithUnknownValueHandler(handler func(UnknownValue)) RequestOption {
+	type key struct {
+		kind  UnknownKind
+		typ   string
+		value string
+	}
+	var seen sync.Map
+	report := func(unknown UnknownValue) {
+		if _, loaded := seen.LoadOrStore(key{unknown.Kind, unknown.Type, unknown.Value}, true); !loaded {
+			handler(unknown)
+		}
+	}
+	return func(r *requestconfig.RequestConfig) error {
+		r.OnUnknownValue = report
+		return nil
+	}
+}
+
+// Instrumentation receives hooks at the start and end of each request and each
+// attempt, and after each response is decoded, for building traces and metrics.
+// Every hook is given the name of the operation, such as "ACHTransfers.New".
+type Instrumentation = requestconfig.Instrumentation
+
+// RequestInfo describes a request, as given to an [Instrumentation].
+type RequestInfo = requestconfig.RequestInfo
+
+// AttemptInfo describes one attempt of a request, as given to an
+// [Instrumentation].
+type AttemptInfo = requestconfig.AttemptInfo
+
+// DecodeInfo describes the decoding of a response, as given to an
+// [Instrumentation].
+type DecodeInfo = requestconfig.DecodeInfo
+
+// NoopInstrumentation is an [Instrumentation] whose hooks do nothing. Embed it
+// to implement only some of the hooks.
+type NoopInstrumentation = requestconfig.NoopInstrumentation
+
+// WithInstrumentation returns a RequestOption that calls the hooks of the given
+// [Instrumentation] at each stage of a request.
+func WithInstrumentation(instrumentation Instrumentation) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.Instrumentation = instrumentation
+		return nil
+	}
+}
+
 // WithHeader returns a RequestOption that sets the header value to the associated key. It overwrites
 // any value if there was one already present.
 func WithHeader(key, value string) RequestOption {
@@ -154,6 +336,59 @@ func WithJSONDel(key string) RequestOption {
 	}
 }
 
+// WithIdempotencyKey returns a RequestOption that sets the Idempotency-Key sent
+// with a mutating request, overriding the key the client generates. The same key
+// is reused across every retry of the request, so this is useful for deriving
+// keys from your own identifiers. It has no effect on GET requests.
+//
+// An idempotency key identifies a single operation, so this option must be
+// given per request. Requests made by a client given this option return an
+// error, since they would all share the key.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		if r.InClientOptions {
+			return fmt.Errorf("option: WithIdempotencyKey must be given to a request, not to the client")
+		}
+		if r.Request.Method == http.MethodGet || r.Request.Method == http.MethodHead {
+			return nil
+		}
+		r.IdempotencyKey = key
+		return nil
+	}
+}
+
+// IdempotencyStore persists the idempotency key and final response of each
+// operation given an ID with [WithOperationID].
+type IdempotencyStore = requestconfig.IdempotencyStore
+
+// IdempotencyRecord is the entry an [IdempotencyStore] keeps for one operation.
+type IdempotencyRecord = requestconfig.IdempotencyRecord
+
+// WithIdempotencyStore returns a RequestOption that journals mutating requests
+// given an ID with [WithOperationID] in the given store. This is typically
+// given to the client, while operation IDs are given per request.
+func WithIdempotencyStore(store IdempotencyStore) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.IdempotencyStore = store
+		return nil
+	}
+}
+
+// WithOperationID returns a RequestOption that identifies a mutating request by
+// an ID of your own, such as the ID of the payout a transfer is made for.
+//
+// With an [IdempotencyStore] configured, the first request for an operation
+// records its idempotency key before it is sent. Later requests for the same
+// operation, including from another process after a restart, reuse that key,
+// and once a successful response has been recorded they return it without
+// sending the request again.
+func WithOperationID(id string) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.OperationID = id
+		return nil
+	}
+}
+
 // WithResponseBodyInto returns a RequestOption that overwrites the deserialization target with
 // the given destination. If provided, we don't deserialize into the default struct.
 func WithResponseBodyInto(dst any) RequestOption {
@@ -171,9 +406,25 @@ func WithResponseInto(dst **http.Response) RequestOption {
 	}
 }
 
+// ResponseMeta describes how a request was served by the API: its request ID,
+// whether it was an idempotent replay, the number of attempts made and any
+// rate limit information.
+type ResponseMeta = requestconfig.ResponseMeta
+
+// WithResponseMeta returns a RequestOption that populates the given
+// [ResponseMeta] once the request completes. It is populated for error
+// responses as well as successful ones, but not when no response was received.
+func WithResponseMeta(dst *ResponseMeta) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.ResponseMeta = dst
+		return nil
+	}
+}
+
 // WithRequestTimeout returns a RequestOption that sets the timeout for
 // each request attempt. This should be smaller than the timeout defined in
-// the context, which spans all retries.
+// the context, which spans all retries. An attempt which times out is retried
+// like a connection error.
 func WithRequestTimeout(dur time.Duration) RequestOption {
 	return func(r *requestconfig.RequestConfig) error {
 		r.RequestTimeout = dur
@@ -181,6 +432,17 @@ func WithRequestTimeout(dur time.Duration) RequestOption {
 	}
 }
 
+// WithRetryDeadline returns a RequestOption that caps the total time spent
+// retrying a request. No retry is made, including waiting for its backoff, that
+// would start more than the given duration after the first attempt; the result
+// of the last attempt is returned instead.
+func WithRetryDeadline(dur time.Duration) RequestOption {
+	return func(r *requestconfig.RequestConfig) error {
+		r.RetryDeadline = dur
+		return nil
+	}
+}
+
 // WithEnvironmentProduction returns a RequestOption that sets the current
 // environment to be the "production" environment. An environment specifies which base URL
 // to use by default.
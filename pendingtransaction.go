@@ -38,6 +38,7 @@ func NewPendingTransactionService(opts ...option.RequestOption) (r *PendingTrans
 // Retrieve a Pending Transaction
 func (r *PendingTransactionService) Get(ctx context.Context, pendingTransactionID string, opts ...option.RequestOption) (res *PendingTransaction, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("PendingTransactions.Get")}, opts...)
 	path := fmt.Sprintf("pending_transactions/%s", pendingTransactionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *PendingTransactionService) Get(ctx context.Context, pendingTransactionI
 func (r *PendingTransactionService) List(ctx context.Context, query PendingTransactionListParams, opts ...option.RequestOption) (res *shared.Page[PendingTransaction], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("PendingTransactions.List")}, opts...)
 	path := "pending_transactions"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -131,6 +132,14 @@ func (r *PendingTransaction) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Pending
 // Transaction's currency. This will match the currency on the Pending
 // Transaction's Account.
@@ -151,6 +160,14 @@ const (
 	PendingTransactionCurrencyUsd PendingTransactionCurrency = "USD"
 )
 
+func (r PendingTransactionCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionCurrencyCad, PendingTransactionCurrencyChf, PendingTransactionCurrencyEur, PendingTransactionCurrencyGbp, PendingTransactionCurrencyJpy, PendingTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Pending Transaction came through.
 type PendingTransactionRouteType string
 
@@ -161,6 +178,14 @@ const (
 	PendingTransactionRouteTypeCard PendingTransactionRouteType = "card"
 )
 
+func (r PendingTransactionRouteType) IsKnown() bool {
+	switch r {
+	case PendingTransactionRouteTypeAccountNumber, PendingTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Pending Transaction. For example, for a card transaction this lists the
 // merchant's industry and location.
@@ -216,6 +241,14 @@ func (r *PendingTransactionSource) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_instruction`.
 type PendingTransactionSourceAccountTransferInstruction struct {
@@ -244,6 +277,14 @@ func (r *PendingTransactionSourceAccountTransferInstruction) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceAccountTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceAccountTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type PendingTransactionSourceAccountTransferInstructionCurrency string
@@ -263,6 +304,14 @@ const (
 	PendingTransactionSourceAccountTransferInstructionCurrencyUsd PendingTransactionSourceAccountTransferInstructionCurrency = "USD"
 )
 
+func (r PendingTransactionSourceAccountTransferInstructionCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceAccountTransferInstructionCurrencyCad, PendingTransactionSourceAccountTransferInstructionCurrencyChf, PendingTransactionSourceAccountTransferInstructionCurrencyEur, PendingTransactionSourceAccountTransferInstructionCurrencyGbp, PendingTransactionSourceAccountTransferInstructionCurrencyJpy, PendingTransactionSourceAccountTransferInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_instruction`.
 type PendingTransactionSourceACHTransferInstruction struct {
@@ -287,6 +336,14 @@ func (r *PendingTransactionSourceACHTransferInstruction) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceACHTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceACHTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Authorization object. This field will be present in the JSON response if
 // and only if `category` is equal to `card_authorization`.
 type PendingTransactionSourceCardAuthorization struct {
@@ -375,6 +432,14 @@ func (r *PendingTransactionSourceCardAuthorization) UnmarshalJSON(data []byte) (
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorization) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type PendingTransactionSourceCardAuthorizationCurrency string
@@ -394,6 +459,14 @@ const (
 	PendingTransactionSourceCardAuthorizationCurrencyUsd PendingTransactionSourceCardAuthorizationCurrency = "USD"
 )
 
+func (r PendingTransactionSourceCardAuthorizationCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationCurrencyCad, PendingTransactionSourceCardAuthorizationCurrencyChf, PendingTransactionSourceCardAuthorizationCurrencyEur, PendingTransactionSourceCardAuthorizationCurrencyGbp, PendingTransactionSourceCardAuthorizationCurrencyJpy, PendingTransactionSourceCardAuthorizationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The direction descibes the direction the funds will move, either from the
 // cardholder to the merchant or from the merchant to the cardholder.
 type PendingTransactionSourceCardAuthorizationDirection string
@@ -406,6 +479,14 @@ const (
 	PendingTransactionSourceCardAuthorizationDirectionRefund PendingTransactionSourceCardAuthorizationDirection = "refund"
 )
 
+func (r PendingTransactionSourceCardAuthorizationDirection) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationDirectionSettlement, PendingTransactionSourceCardAuthorizationDirectionRefund:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type PendingTransactionSourceCardAuthorizationNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -429,6 +510,14 @@ func (r *PendingTransactionSourceCardAuthorizationNetworkDetails) UnmarshalJSON(
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type PendingTransactionSourceCardAuthorizationNetworkDetailsCategory string
 
@@ -437,6 +526,14 @@ const (
 	PendingTransactionSourceCardAuthorizationNetworkDetailsCategoryVisa PendingTransactionSourceCardAuthorizationNetworkDetailsCategory = "visa"
 )
 
+func (r PendingTransactionSourceCardAuthorizationNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type PendingTransactionSourceCardAuthorizationNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -463,6 +560,14 @@ func (r *PendingTransactionSourceCardAuthorizationNetworkDetailsVisa) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -505,6 +610,14 @@ const (
 	PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorRecurring, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorInstallment, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode string
@@ -532,6 +645,14 @@ const (
 	PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeUnknown, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeManual, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactless, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, PendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type PendingTransactionSourceCardAuthorizationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -562,6 +683,14 @@ func (r *PendingTransactionSourceCardAuthorizationNetworkIdentifiers) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type PendingTransactionSourceCardAuthorizationProcessingCategory string
@@ -586,6 +715,14 @@ const (
 	PendingTransactionSourceCardAuthorizationProcessingCategoryRefund PendingTransactionSourceCardAuthorizationProcessingCategory = "refund"
 )
 
+func (r PendingTransactionSourceCardAuthorizationProcessingCategory) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationProcessingCategoryAccountFunding, PendingTransactionSourceCardAuthorizationProcessingCategoryAutomaticFuelDispenser, PendingTransactionSourceCardAuthorizationProcessingCategoryBillPayment, PendingTransactionSourceCardAuthorizationProcessingCategoryPurchase, PendingTransactionSourceCardAuthorizationProcessingCategoryQuasiCash, PendingTransactionSourceCardAuthorizationProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_authorization`.
 type PendingTransactionSourceCardAuthorizationType string
@@ -594,6 +731,14 @@ const (
 	PendingTransactionSourceCardAuthorizationTypeCardAuthorization PendingTransactionSourceCardAuthorizationType = "card_authorization"
 )
 
+func (r PendingTransactionSourceCardAuthorizationType) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationTypeCardAuthorization:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type PendingTransactionSourceCardAuthorizationVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -618,6 +763,14 @@ func (r *PendingTransactionSourceCardAuthorizationVerification) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type PendingTransactionSourceCardAuthorizationVerificationCardVerificationCode struct {
@@ -639,6 +792,14 @@ func (r *PendingTransactionSourceCardAuthorizationVerificationCardVerificationCo
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult string
 
@@ -651,6 +812,14 @@ const (
 	PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNoMatch PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNotChecked, PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultMatch, PendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type PendingTransactionSourceCardAuthorizationVerificationCardholderAddress struct {
@@ -685,6 +854,14 @@ func (r *PendingTransactionSourceCardAuthorizationVerificationCardholderAddress)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCardAuthorizationVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCardAuthorizationVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult string
 
@@ -703,6 +880,14 @@ const (
 	PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNoMatch PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult = "no_match"
 )
 
+func (r PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNotChecked, PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultMatch, PendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type PendingTransactionSourceCategory string
@@ -734,6 +919,14 @@ const (
 	PendingTransactionSourceCategoryOther PendingTransactionSourceCategory = "other"
 )
 
+func (r PendingTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCategoryAccountTransferInstruction, PendingTransactionSourceCategoryACHTransferInstruction, PendingTransactionSourceCategoryCardAuthorization, PendingTransactionSourceCategoryCheckDepositInstruction, PendingTransactionSourceCategoryCheckTransferInstruction, PendingTransactionSourceCategoryInboundFundsHold, PendingTransactionSourceCategoryRealTimePaymentsTransferInstruction, PendingTransactionSourceCategoryWireTransferInstruction, PendingTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_instruction`.
 type PendingTransactionSourceCheckDepositInstruction struct {
@@ -770,6 +963,14 @@ func (r *PendingTransactionSourceCheckDepositInstruction) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCheckDepositInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCheckDepositInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type PendingTransactionSourceCheckDepositInstructionCurrency string
@@ -789,6 +990,14 @@ const (
 	PendingTransactionSourceCheckDepositInstructionCurrencyUsd PendingTransactionSourceCheckDepositInstructionCurrency = "USD"
 )
 
+func (r PendingTransactionSourceCheckDepositInstructionCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCheckDepositInstructionCurrencyCad, PendingTransactionSourceCheckDepositInstructionCurrencyChf, PendingTransactionSourceCheckDepositInstructionCurrencyEur, PendingTransactionSourceCheckDepositInstructionCurrencyGbp, PendingTransactionSourceCheckDepositInstructionCurrencyJpy, PendingTransactionSourceCheckDepositInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_instruction`.
 type PendingTransactionSourceCheckTransferInstruction struct {
@@ -817,6 +1026,14 @@ func (r *PendingTransactionSourceCheckTransferInstruction) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceCheckTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceCheckTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type PendingTransactionSourceCheckTransferInstructionCurrency string
@@ -836,6 +1053,14 @@ const (
 	PendingTransactionSourceCheckTransferInstructionCurrencyUsd PendingTransactionSourceCheckTransferInstructionCurrency = "USD"
 )
 
+func (r PendingTransactionSourceCheckTransferInstructionCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceCheckTransferInstructionCurrencyCad, PendingTransactionSourceCheckTransferInstructionCurrencyChf, PendingTransactionSourceCheckTransferInstructionCurrencyEur, PendingTransactionSourceCheckTransferInstructionCurrencyGbp, PendingTransactionSourceCheckTransferInstructionCurrencyJpy, PendingTransactionSourceCheckTransferInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Funds Hold object. This field will be present in the JSON response if
 // and only if `category` is equal to `inbound_funds_hold`.
 type PendingTransactionSourceInboundFundsHold struct {
@@ -888,6 +1113,14 @@ func (r *PendingTransactionSourceInboundFundsHold) UnmarshalJSON(data []byte) (e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceInboundFundsHold) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceInboundFundsHoldJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the hold's
 // currency.
 type PendingTransactionSourceInboundFundsHoldCurrency string
@@ -907,6 +1140,14 @@ const (
 	PendingTransactionSourceInboundFundsHoldCurrencyUsd PendingTransactionSourceInboundFundsHoldCurrency = "USD"
 )
 
+func (r PendingTransactionSourceInboundFundsHoldCurrency) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceInboundFundsHoldCurrencyCad, PendingTransactionSourceInboundFundsHoldCurrencyChf, PendingTransactionSourceInboundFundsHoldCurrencyEur, PendingTransactionSourceInboundFundsHoldCurrencyGbp, PendingTransactionSourceInboundFundsHoldCurrencyJpy, PendingTransactionSourceInboundFundsHoldCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The status of the hold.
 type PendingTransactionSourceInboundFundsHoldStatus string
 
@@ -917,6 +1158,14 @@ const (
 	PendingTransactionSourceInboundFundsHoldStatusComplete PendingTransactionSourceInboundFundsHoldStatus = "complete"
 )
 
+func (r PendingTransactionSourceInboundFundsHoldStatus) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceInboundFundsHoldStatusHeld, PendingTransactionSourceInboundFundsHoldStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_funds_hold`.
 type PendingTransactionSourceInboundFundsHoldType string
@@ -925,6 +1174,14 @@ const (
 	PendingTransactionSourceInboundFundsHoldTypeInboundFundsHold PendingTransactionSourceInboundFundsHoldType = "inbound_funds_hold"
 )
 
+func (r PendingTransactionSourceInboundFundsHoldType) IsKnown() bool {
+	switch r {
+	case PendingTransactionSourceInboundFundsHoldTypeInboundFundsHold:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Instruction object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_instruction`.
@@ -952,6 +1209,14 @@ func (r *PendingTransactionSourceRealTimePaymentsTransferInstruction) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceRealTimePaymentsTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceRealTimePaymentsTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_instruction`.
 type PendingTransactionSourceWireTransferInstruction struct {
@@ -986,6 +1251,14 @@ func (r *PendingTransactionSourceWireTransferInstruction) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PendingTransactionSourceWireTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r pendingTransactionSourceWireTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether the Pending Transaction has been confirmed and has an associated
 // Transaction.
 type PendingTransactionStatus string
@@ -999,6 +1272,14 @@ const (
 	PendingTransactionStatusComplete PendingTransactionStatus = "complete"
 )
 
+func (r PendingTransactionStatus) IsKnown() bool {
+	switch r {
+	case PendingTransactionStatusPending, PendingTransactionStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `pending_transaction`.
 type PendingTransactionType string
@@ -1007,6 +1288,14 @@ const (
 	PendingTransactionTypePendingTransaction PendingTransactionType = "pending_transaction"
 )
 
+func (r PendingTransactionType) IsKnown() bool {
+	switch r {
+	case PendingTransactionTypePendingTransaction:
+		return true
+	}
+	return false
+}
+
 type PendingTransactionListParams struct {
 	// Filter pending transactions to those belonging to the specified Account.
 	AccountID param.Field[string]                                `query:"account_id"`
@@ -1077,6 +1366,14 @@ const (
 	PendingTransactionListParamsCategoryInOther PendingTransactionListParamsCategoryIn = "other"
 )
 
+func (r PendingTransactionListParamsCategoryIn) IsKnown() bool {
+	switch r {
+	case PendingTransactionListParamsCategoryInAccountTransferInstruction, PendingTransactionListParamsCategoryInACHTransferInstruction, PendingTransactionListParamsCategoryInCardAuthorization, PendingTransactionListParamsCategoryInCheckDepositInstruction, PendingTransactionListParamsCategoryInCheckTransferInstruction, PendingTransactionListParamsCategoryInInboundFundsHold, PendingTransactionListParamsCategoryInRealTimePaymentsTransferInstruction, PendingTransactionListParamsCategoryInWireTransferInstruction, PendingTransactionListParamsCategoryInOther:
+		return true
+	}
+	return false
+}
+
 type PendingTransactionListParamsCreatedAt struct {
 	// Return results after this [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601)
 	// timestamp.
@@ -1128,3 +1425,11 @@ const (
 	// can generally be hidden from UIs, etc.
 	PendingTransactionListParamsStatusInComplete PendingTransactionListParamsStatusIn = "complete"
 )
+
+func (r PendingTransactionListParamsStatusIn) IsKnown() bool {
+	switch r {
+	case PendingTransactionListParamsStatusInPending, PendingTransactionListParamsStatusInComplete:
+		return true
+	}
+	return false
+}
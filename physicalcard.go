@@ -38,6 +38,7 @@ func NewPhysicalCardService(opts ...option.RequestOption) (r *PhysicalCardServic
 // Create a Physical Card
 func (r *PhysicalCardService) New(ctx context.Context, body PhysicalCardNewParams, opts ...option.RequestOption) (res *PhysicalCard, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("PhysicalCards.New")}, opts...)
 	path := "physical_cards"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,6 +47,7 @@ func (r *PhysicalCardService) New(ctx context.Context, body PhysicalCardNewParam
 // Retrieve a Physical Card
 func (r *PhysicalCardService) Get(ctx context.Context, physicalCardID string, opts ...option.RequestOption) (res *PhysicalCard, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("PhysicalCards.Get")}, opts...)
 	path := fmt.Sprintf("physical_cards/%s", physicalCardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -54,6 +56,7 @@ func (r *PhysicalCardService) Get(ctx context.Context, physicalCardID string, op
 // Update a Physical Card
 func (r *PhysicalCardService) Update(ctx context.Context, physicalCardID string, body PhysicalCardUpdateParams, opts ...option.RequestOption) (res *PhysicalCard, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("PhysicalCards.Update")}, opts...)
 	path := fmt.Sprintf("physical_cards/%s", physicalCardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPatch, path, body, &res, opts...)
 	return
@@ -63,7 +66,7 @@ func (r *PhysicalCardService) Update(ctx context.Context, physicalCardID string,
 func (r *PhysicalCardService) List(ctx context.Context, query PhysicalCardListParams, opts ...option.RequestOption) (res *shared.Page[PhysicalCard], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("PhysicalCards.List")}, opts...)
 	path := "physical_cards"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -127,6 +130,14 @@ func (r *PhysicalCard) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PhysicalCard) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r physicalCardJSON) RawJSON() string {
+	return r.raw
+}
+
 // Details about the cardholder, as it appears on the printed card.
 type PhysicalCardCardholder struct {
 	// The cardholder's first name.
@@ -149,6 +160,14 @@ func (r *PhysicalCardCardholder) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PhysicalCardCardholder) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r physicalCardCardholderJSON) RawJSON() string {
+	return r.raw
+}
+
 // The details used to ship this physical card.
 type PhysicalCardShipment struct {
 	// The location to where the card's packing label is addressed.
@@ -177,6 +196,14 @@ func (r *PhysicalCardShipment) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PhysicalCardShipment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r physicalCardShipmentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The location to where the card's packing label is addressed.
 type PhysicalCardShipmentAddress struct {
 	// The city of the shipping address.
@@ -214,6 +241,14 @@ func (r *PhysicalCardShipmentAddress) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PhysicalCardShipmentAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r physicalCardShipmentAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The shipping method.
 type PhysicalCardShipmentMethod string
 
@@ -226,6 +261,14 @@ const (
 	PhysicalCardShipmentMethodFedex2Day PhysicalCardShipmentMethod = "fedex_2_day"
 )
 
+func (r PhysicalCardShipmentMethod) IsKnown() bool {
+	switch r {
+	case PhysicalCardShipmentMethodUsps, PhysicalCardShipmentMethodFedexPriorityOvernight, PhysicalCardShipmentMethodFedex2Day:
+		return true
+	}
+	return false
+}
+
 // The status of this shipment.
 type PhysicalCardShipmentStatus string
 
@@ -248,6 +291,14 @@ const (
 	PhysicalCardShipmentStatusReturned PhysicalCardShipmentStatus = "returned"
 )
 
+func (r PhysicalCardShipmentStatus) IsKnown() bool {
+	switch r {
+	case PhysicalCardShipmentStatusPending, PhysicalCardShipmentStatusCanceled, PhysicalCardShipmentStatusSubmitted, PhysicalCardShipmentStatusAcknowledged, PhysicalCardShipmentStatusRejected, PhysicalCardShipmentStatusShipped, PhysicalCardShipmentStatusReturned:
+		return true
+	}
+	return false
+}
+
 // Tracking details for the shipment.
 type PhysicalCardShipmentTracking struct {
 	// The tracking number.
@@ -275,6 +326,14 @@ func (r *PhysicalCardShipmentTracking) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r PhysicalCardShipmentTracking) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r physicalCardShipmentTrackingJSON) RawJSON() string {
+	return r.raw
+}
+
 // The status of the Physical Card.
 type PhysicalCardStatus string
 
@@ -287,6 +346,14 @@ const (
 	PhysicalCardStatusCanceled PhysicalCardStatus = "canceled"
 )
 
+func (r PhysicalCardStatus) IsKnown() bool {
+	switch r {
+	case PhysicalCardStatusActive, PhysicalCardStatusDisabled, PhysicalCardStatusCanceled:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `physical_card`.
 type PhysicalCardType string
@@ -295,6 +362,14 @@ const (
 	PhysicalCardTypePhysicalCard PhysicalCardType = "physical_card"
 )
 
+func (r PhysicalCardType) IsKnown() bool {
+	switch r {
+	case PhysicalCardTypePhysicalCard:
+		return true
+	}
+	return false
+}
+
 type PhysicalCardNewParams struct {
 	// The underlying card representing this physical card.
 	CardID param.Field[string] `json:"card_id,required"`
@@ -370,6 +445,14 @@ const (
 	PhysicalCardNewParamsShipmentMethodFedex2Day PhysicalCardNewParamsShipmentMethod = "fedex_2_day"
 )
 
+func (r PhysicalCardNewParamsShipmentMethod) IsKnown() bool {
+	switch r {
+	case PhysicalCardNewParamsShipmentMethodUsps, PhysicalCardNewParamsShipmentMethodFedexPriorityOvernight, PhysicalCardNewParamsShipmentMethodFedex2Day:
+		return true
+	}
+	return false
+}
+
 type PhysicalCardUpdateParams struct {
 	// The status to update the Physical Card to.
 	Status param.Field[PhysicalCardUpdateParamsStatus] `json:"status,required"`
@@ -391,6 +474,14 @@ const (
 	PhysicalCardUpdateParamsStatusCanceled PhysicalCardUpdateParamsStatus = "canceled"
 )
 
+func (r PhysicalCardUpdateParamsStatus) IsKnown() bool {
+	switch r {
+	case PhysicalCardUpdateParamsStatusActive, PhysicalCardUpdateParamsStatusDisabled, PhysicalCardUpdateParamsStatusCanceled:
+		return true
+	}
+	return false
+}
+
 type PhysicalCardListParams struct {
 	// Filter Physical Cards to ones belonging to the specified Card.
 	CardID    param.Field[string]                          `query:"card_id"`
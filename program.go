@@ -37,6 +37,7 @@ func NewProgramService(opts ...option.RequestOption) (r *ProgramService) {
 // Retrieve a Program
 func (r *ProgramService) Get(ctx context.Context, programID string, opts ...option.RequestOption) (res *Program, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Programs.Get")}, opts...)
 	path := fmt.Sprintf("programs/%s", programID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -46,7 +47,7 @@ func (r *ProgramService) Get(ctx context.Context, programID string, opts ...opti
 func (r *ProgramService) List(ctx context.Context, query ProgramListParams, opts ...option.RequestOption) (res *shared.Page[Program], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Programs.List")}, opts...)
 	path := "programs"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -101,6 +102,14 @@ func (r *Program) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Program) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r programJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `program`.
 type ProgramType string
@@ -109,6 +118,14 @@ const (
 	ProgramTypeProgram ProgramType = "program"
 )
 
+func (r ProgramType) IsKnown() bool {
+	switch r {
+	case ProgramTypeProgram:
+		return true
+	}
+	return false
+}
+
 type ProgramListParams struct {
 	// Return the page of entries after this one.
 	Cursor param.Field[string] `query:"cursor"`
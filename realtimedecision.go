@@ -35,6 +35,7 @@ func NewRealTimeDecisionService(opts ...option.RequestOption) (r *RealTimeDecisi
 // Retrieve a Real-Time Decision
 func (r *RealTimeDecisionService) Get(ctx context.Context, realTimeDecisionID string, opts ...option.RequestOption) (res *RealTimeDecision, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("RealTimeDecisions.Get")}, opts...)
 	path := fmt.Sprintf("real_time_decisions/%s", realTimeDecisionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -43,6 +44,7 @@ func (r *RealTimeDecisionService) Get(ctx context.Context, realTimeDecisionID st
 // Action a Real-Time Decision
 func (r *RealTimeDecisionService) Action(ctx context.Context, realTimeDecisionID string, body RealTimeDecisionActionParams, opts ...option.RequestOption) (res *RealTimeDecision, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("RealTimeDecisions.Action")}, opts...)
 	path := fmt.Sprintf("real_time_decisions/%s/action", realTimeDecisionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -97,6 +99,14 @@ func (r *RealTimeDecision) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecision) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to a card authorization.
 type RealTimeDecisionCardAuthorization struct {
 	// The identifier of the Account the authorization will debit.
@@ -180,6 +190,14 @@ func (r *RealTimeDecisionCardAuthorization) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorization) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether or not the authorization was approved.
 type RealTimeDecisionCardAuthorizationDecision string
 
@@ -190,6 +208,14 @@ const (
 	RealTimeDecisionCardAuthorizationDecisionDecline RealTimeDecisionCardAuthorizationDecision = "decline"
 )
 
+func (r RealTimeDecisionCardAuthorizationDecision) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationDecisionApprove, RealTimeDecisionCardAuthorizationDecisionDecline:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type RealTimeDecisionCardAuthorizationNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -212,6 +238,14 @@ func (r *RealTimeDecisionCardAuthorizationNetworkDetails) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type RealTimeDecisionCardAuthorizationNetworkDetailsCategory string
 
@@ -220,6 +254,14 @@ const (
 	RealTimeDecisionCardAuthorizationNetworkDetailsCategoryVisa RealTimeDecisionCardAuthorizationNetworkDetailsCategory = "visa"
 )
 
+func (r RealTimeDecisionCardAuthorizationNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type RealTimeDecisionCardAuthorizationNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -245,6 +287,14 @@ func (r *RealTimeDecisionCardAuthorizationNetworkDetailsVisa) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -287,6 +337,14 @@ const (
 	RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorRecurring, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorInstallment, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, RealTimeDecisionCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode string
@@ -314,6 +372,14 @@ const (
 	RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeUnknown, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeManual, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactless, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, RealTimeDecisionCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type RealTimeDecisionCardAuthorizationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -343,6 +409,14 @@ func (r *RealTimeDecisionCardAuthorizationNetworkIdentifiers) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type RealTimeDecisionCardAuthorizationProcessingCategory string
@@ -367,6 +441,14 @@ const (
 	RealTimeDecisionCardAuthorizationProcessingCategoryRefund RealTimeDecisionCardAuthorizationProcessingCategory = "refund"
 )
 
+func (r RealTimeDecisionCardAuthorizationProcessingCategory) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationProcessingCategoryAccountFunding, RealTimeDecisionCardAuthorizationProcessingCategoryAutomaticFuelDispenser, RealTimeDecisionCardAuthorizationProcessingCategoryBillPayment, RealTimeDecisionCardAuthorizationProcessingCategoryPurchase, RealTimeDecisionCardAuthorizationProcessingCategoryQuasiCash, RealTimeDecisionCardAuthorizationProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the type of request, such as an incremental authorization.
 type RealTimeDecisionCardAuthorizationRequestDetails struct {
 	// The type of this request (e.g., an initial authorization or an incremental
@@ -393,6 +475,14 @@ func (r *RealTimeDecisionCardAuthorizationRequestDetails) UnmarshalJSON(data []b
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationRequestDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationRequestDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The type of this request (e.g., an initial authorization or an incremental
 // authorization).
 type RealTimeDecisionCardAuthorizationRequestDetailsCategory string
@@ -404,6 +494,14 @@ const (
 	RealTimeDecisionCardAuthorizationRequestDetailsCategoryIncrementalAuthorization RealTimeDecisionCardAuthorizationRequestDetailsCategory = "incremental_authorization"
 )
 
+func (r RealTimeDecisionCardAuthorizationRequestDetailsCategory) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationRequestDetailsCategoryInitialAuthorization, RealTimeDecisionCardAuthorizationRequestDetailsCategoryIncrementalAuthorization:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the category `incremental_authorization`.
 type RealTimeDecisionCardAuthorizationRequestDetailsIncrementalAuthorization struct {
 	// The card payment for this authorization and increment.
@@ -428,6 +526,14 @@ func (r *RealTimeDecisionCardAuthorizationRequestDetailsIncrementalAuthorization
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationRequestDetailsIncrementalAuthorization) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationRequestDetailsIncrementalAuthorizationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of cardholder-provided values.
 type RealTimeDecisionCardAuthorizationVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -452,6 +558,14 @@ func (r *RealTimeDecisionCardAuthorizationVerification) UnmarshalJSON(data []byt
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type RealTimeDecisionCardAuthorizationVerificationCardVerificationCode struct {
@@ -473,6 +587,14 @@ func (r *RealTimeDecisionCardAuthorizationVerificationCardVerificationCode) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResult string
 
@@ -485,6 +607,14 @@ const (
 	RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResultNoMatch RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResultNotChecked, RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResultMatch, RealTimeDecisionCardAuthorizationVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type RealTimeDecisionCardAuthorizationVerificationCardholderAddress struct {
@@ -519,6 +649,14 @@ func (r *RealTimeDecisionCardAuthorizationVerificationCardholderAddress) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionCardAuthorizationVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionCardAuthorizationVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type RealTimeDecisionCardAuthorizationVerificationCardholderAddressResult string
 
@@ -537,6 +675,14 @@ const (
 	RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultNoMatch RealTimeDecisionCardAuthorizationVerificationCardholderAddressResult = "no_match"
 )
 
+func (r RealTimeDecisionCardAuthorizationVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultNotChecked, RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultMatch, RealTimeDecisionCardAuthorizationVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The category of the Real-Time Decision.
 type RealTimeDecisionCategory string
 
@@ -550,6 +696,14 @@ const (
 	RealTimeDecisionCategoryDigitalWalletAuthenticationRequested RealTimeDecisionCategory = "digital_wallet_authentication_requested"
 )
 
+func (r RealTimeDecisionCategory) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionCategoryCardAuthorizationRequested, RealTimeDecisionCategoryDigitalWalletTokenRequested, RealTimeDecisionCategoryDigitalWalletAuthenticationRequested:
+		return true
+	}
+	return false
+}
+
 // Fields related to a digital wallet authentication attempt.
 type RealTimeDecisionDigitalWalletAuthentication struct {
 	// The identifier of the Card that is being tokenized.
@@ -588,6 +742,14 @@ func (r *RealTimeDecisionDigitalWalletAuthentication) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionDigitalWalletAuthentication) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionDigitalWalletAuthenticationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The channel to send the card user their one-time passcode.
 type RealTimeDecisionDigitalWalletAuthenticationChannel string
 
@@ -598,6 +760,14 @@ const (
 	RealTimeDecisionDigitalWalletAuthenticationChannelEmail RealTimeDecisionDigitalWalletAuthenticationChannel = "email"
 )
 
+func (r RealTimeDecisionDigitalWalletAuthenticationChannel) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionDigitalWalletAuthenticationChannelSMS, RealTimeDecisionDigitalWalletAuthenticationChannelEmail:
+		return true
+	}
+	return false
+}
+
 // The digital wallet app being used.
 type RealTimeDecisionDigitalWalletAuthenticationDigitalWallet string
 
@@ -610,6 +780,14 @@ const (
 	RealTimeDecisionDigitalWalletAuthenticationDigitalWalletUnknown RealTimeDecisionDigitalWalletAuthenticationDigitalWallet = "unknown"
 )
 
+func (r RealTimeDecisionDigitalWalletAuthenticationDigitalWallet) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionDigitalWalletAuthenticationDigitalWalletApplePay, RealTimeDecisionDigitalWalletAuthenticationDigitalWalletGooglePay, RealTimeDecisionDigitalWalletAuthenticationDigitalWalletUnknown:
+		return true
+	}
+	return false
+}
+
 // Whether your application successfully delivered the one-time passcode.
 type RealTimeDecisionDigitalWalletAuthenticationResult string
 
@@ -620,6 +798,14 @@ const (
 	RealTimeDecisionDigitalWalletAuthenticationResultFailure RealTimeDecisionDigitalWalletAuthenticationResult = "failure"
 )
 
+func (r RealTimeDecisionDigitalWalletAuthenticationResult) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionDigitalWalletAuthenticationResultSuccess, RealTimeDecisionDigitalWalletAuthenticationResultFailure:
+		return true
+	}
+	return false
+}
+
 // Fields related to a digital wallet token provisioning attempt.
 type RealTimeDecisionDigitalWalletToken struct {
 	// The identifier of the Card that is being tokenized.
@@ -651,6 +837,14 @@ func (r *RealTimeDecisionDigitalWalletToken) UnmarshalJSON(data []byte) (err err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimeDecisionDigitalWalletToken) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimeDecisionDigitalWalletTokenJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether or not the provisioning request was approved. This will be null until
 // the real time decision is responded to.
 type RealTimeDecisionDigitalWalletTokenDecision string
@@ -662,6 +856,14 @@ const (
 	RealTimeDecisionDigitalWalletTokenDecisionDecline RealTimeDecisionDigitalWalletTokenDecision = "decline"
 )
 
+func (r RealTimeDecisionDigitalWalletTokenDecision) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionDigitalWalletTokenDecisionApprove, RealTimeDecisionDigitalWalletTokenDecisionDecline:
+		return true
+	}
+	return false
+}
+
 // The digital wallet app being used.
 type RealTimeDecisionDigitalWalletTokenDigitalWallet string
 
@@ -674,6 +876,14 @@ const (
 	RealTimeDecisionDigitalWalletTokenDigitalWalletUnknown RealTimeDecisionDigitalWalletTokenDigitalWallet = "unknown"
 )
 
+func (r RealTimeDecisionDigitalWalletTokenDigitalWallet) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionDigitalWalletTokenDigitalWalletApplePay, RealTimeDecisionDigitalWalletTokenDigitalWalletGooglePay, RealTimeDecisionDigitalWalletTokenDigitalWalletUnknown:
+		return true
+	}
+	return false
+}
+
 // The status of the Real-Time Decision.
 type RealTimeDecisionStatus string
 
@@ -686,6 +896,14 @@ const (
 	RealTimeDecisionStatusTimedOut RealTimeDecisionStatus = "timed_out"
 )
 
+func (r RealTimeDecisionStatus) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionStatusPending, RealTimeDecisionStatusResponded, RealTimeDecisionStatusTimedOut:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `real_time_decision`.
 type RealTimeDecisionType string
@@ -694,6 +912,14 @@ const (
 	RealTimeDecisionTypeRealTimeDecision RealTimeDecisionType = "real_time_decision"
 )
 
+func (r RealTimeDecisionType) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionTypeRealTimeDecision:
+		return true
+	}
+	return false
+}
+
 type RealTimeDecisionActionParams struct {
 	// If the Real-Time Decision relates to a card authorization attempt, this object
 	// contains your response to the authorization.
@@ -731,6 +957,14 @@ const (
 	RealTimeDecisionActionParamsCardAuthorizationDecisionDecline RealTimeDecisionActionParamsCardAuthorizationDecision = "decline"
 )
 
+func (r RealTimeDecisionActionParamsCardAuthorizationDecision) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionActionParamsCardAuthorizationDecisionApprove, RealTimeDecisionActionParamsCardAuthorizationDecisionDecline:
+		return true
+	}
+	return false
+}
+
 // If the Real-Time Decision relates to a digital wallet authentication attempt,
 // this object contains your response to the authentication.
 type RealTimeDecisionActionParamsDigitalWalletAuthentication struct {
@@ -752,6 +986,14 @@ const (
 	RealTimeDecisionActionParamsDigitalWalletAuthenticationResultFailure RealTimeDecisionActionParamsDigitalWalletAuthenticationResult = "failure"
 )
 
+func (r RealTimeDecisionActionParamsDigitalWalletAuthenticationResult) IsKnown() bool {
+	switch r {
+	case RealTimeDecisionActionParamsDigitalWalletAuthenticationResultSuccess, RealTimeDecisionActionParamsDigitalWalletAuthenticationResultFailure:
+		return true
+	}
+	return false
+}
+
 // If the Real-Time Decision relates to a digital wallet token provisioning
 // attempt, this object contains your response to the attempt.
 type RealTimeDecisionActionParamsDigitalWalletToken struct {
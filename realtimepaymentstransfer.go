@@ -38,6 +38,7 @@ func NewRealTimePaymentsTransferService(opts ...option.RequestOption) (r *RealTi
 // Create a Real-Time Payments Transfer
 func (r *RealTimePaymentsTransferService) New(ctx context.Context, body RealTimePaymentsTransferNewParams, opts ...option.RequestOption) (res *RealTimePaymentsTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("RealTimePaymentsTransfers.New")}, opts...)
 	path := "real_time_payments_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,16 +47,28 @@ func (r *RealTimePaymentsTransferService) New(ctx context.Context, body RealTime
 // Retrieve a Real-Time Payments Transfer
 func (r *RealTimePaymentsTransferService) Get(ctx context.Context, realTimePaymentsTransferID string, opts ...option.RequestOption) (res *RealTimePaymentsTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("RealTimePaymentsTransfers.Get")}, opts...)
 	path := fmt.Sprintf("real_time_payments_transfers/%s", realTimePaymentsTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// Create a Real-Time Payments Transfer, or if one was already created with the same
+// UniqueIdentifier, retrieve and return it with existed set to true.
+func (r *RealTimePaymentsTransferService) NewOrGet(ctx context.Context, body RealTimePaymentsTransferNewParams, opts ...option.RequestOption) (res *RealTimePaymentsTransfer, existed bool, err error) {
+	res, err = r.New(ctx, body, opts...)
+	if id, ok := existingResourceID(err); ok {
+		res, err = r.Get(ctx, id, opts...)
+		return res, err == nil, err
+	}
+	return
+}
+
 // List Real-Time Payments Transfers
 func (r *RealTimePaymentsTransferService) List(ctx context.Context, query RealTimePaymentsTransferListParams, opts ...option.RequestOption) (res *shared.Page[RealTimePaymentsTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("RealTimePaymentsTransfers.List")}, opts...)
 	path := "real_time_payments_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -162,6 +175,14 @@ func (r *RealTimePaymentsTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimePaymentsTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimePaymentsTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was approved,
 // this will contain details of the approval.
 type RealTimePaymentsTransferApproval struct {
@@ -187,6 +208,14 @@ func (r *RealTimePaymentsTransferApproval) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimePaymentsTransferApproval) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimePaymentsTransferApprovalJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was not
 // approved, this will contain details of the cancellation.
 type RealTimePaymentsTransferCancellation struct {
@@ -212,6 +241,14 @@ func (r *RealTimePaymentsTransferCancellation) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimePaymentsTransferCancellation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimePaymentsTransferCancellationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transfer's
 // currency. For real-time payments transfers this is always equal to `USD`.
 type RealTimePaymentsTransferCurrency string
@@ -231,6 +268,14 @@ const (
 	RealTimePaymentsTransferCurrencyUsd RealTimePaymentsTransferCurrency = "USD"
 )
 
+func (r RealTimePaymentsTransferCurrency) IsKnown() bool {
+	switch r {
+	case RealTimePaymentsTransferCurrencyCad, RealTimePaymentsTransferCurrencyChf, RealTimePaymentsTransferCurrencyEur, RealTimePaymentsTransferCurrencyGbp, RealTimePaymentsTransferCurrencyJpy, RealTimePaymentsTransferCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // If the transfer is rejected by Real-Time Payments or the destination financial
 // institution, this will contain supplemental details.
 type RealTimePaymentsTransferRejection struct {
@@ -260,6 +305,14 @@ func (r *RealTimePaymentsTransferRejection) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimePaymentsTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimePaymentsTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason the transfer was rejected as provided by the recipient bank or the
 // Real-Time Payments network.
 type RealTimePaymentsTransferRejectionRejectReasonCode string
@@ -329,6 +382,14 @@ const (
 	RealTimePaymentsTransferRejectionRejectReasonCodeOther RealTimePaymentsTransferRejectionRejectReasonCode = "other"
 )
 
+func (r RealTimePaymentsTransferRejectionRejectReasonCode) IsKnown() bool {
+	switch r {
+	case RealTimePaymentsTransferRejectionRejectReasonCodeAccountClosed, RealTimePaymentsTransferRejectionRejectReasonCodeAccountBlocked, RealTimePaymentsTransferRejectionRejectReasonCodeInvalidCreditorAccountType, RealTimePaymentsTransferRejectionRejectReasonCodeInvalidCreditorAccountNumber, RealTimePaymentsTransferRejectionRejectReasonCodeInvalidCreditorFinancialInstitutionIdentifier, RealTimePaymentsTransferRejectionRejectReasonCodeEndCustomerDeceased, RealTimePaymentsTransferRejectionRejectReasonCodeNarrative, RealTimePaymentsTransferRejectionRejectReasonCodeTransactionForbidden, RealTimePaymentsTransferRejectionRejectReasonCodeTransactionTypeNotSupported, RealTimePaymentsTransferRejectionRejectReasonCodeUnexpectedAmount, RealTimePaymentsTransferRejectionRejectReasonCodeAmountExceedsBankLimits, RealTimePaymentsTransferRejectionRejectReasonCodeInvalidCreditorAddress, RealTimePaymentsTransferRejectionRejectReasonCodeUnknownEndCustomer, RealTimePaymentsTransferRejectionRejectReasonCodeInvalidDebtorAddress, RealTimePaymentsTransferRejectionRejectReasonCodeTimeout, RealTimePaymentsTransferRejectionRejectReasonCodeUnsupportedMessageForRecipient, RealTimePaymentsTransferRejectionRejectReasonCodeRecipientConnectionNotAvailable, RealTimePaymentsTransferRejectionRejectReasonCodeRealTimePaymentsSuspended, RealTimePaymentsTransferRejectionRejectReasonCodeInstructedAgentSignedOff, RealTimePaymentsTransferRejectionRejectReasonCodeProcessingError, RealTimePaymentsTransferRejectionRejectReasonCodeOther:
+		return true
+	}
+	return false
+}
+
 // The lifecycle status of the transfer.
 type RealTimePaymentsTransferStatus string
 
@@ -350,6 +411,14 @@ const (
 	RealTimePaymentsTransferStatusRequiresAttention RealTimePaymentsTransferStatus = "requires_attention"
 )
 
+func (r RealTimePaymentsTransferStatus) IsKnown() bool {
+	switch r {
+	case RealTimePaymentsTransferStatusPendingApproval, RealTimePaymentsTransferStatusCanceled, RealTimePaymentsTransferStatusPendingSubmission, RealTimePaymentsTransferStatusSubmitted, RealTimePaymentsTransferStatusComplete, RealTimePaymentsTransferStatusRejected, RealTimePaymentsTransferStatusRequiresAttention:
+		return true
+	}
+	return false
+}
+
 // After the transfer is submitted to Real-Time Payments, this will contain
 // supplemental details.
 type RealTimePaymentsTransferSubmission struct {
@@ -374,6 +443,14 @@ func (r *RealTimePaymentsTransferSubmission) UnmarshalJSON(data []byte) (err err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RealTimePaymentsTransferSubmission) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r realTimePaymentsTransferSubmissionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `real_time_payments_transfer`.
 type RealTimePaymentsTransferType string
@@ -382,6 +459,14 @@ const (
 	RealTimePaymentsTransferTypeRealTimePaymentsTransfer RealTimePaymentsTransferType = "real_time_payments_transfer"
 )
 
+func (r RealTimePaymentsTransferType) IsKnown() bool {
+	switch r {
+	case RealTimePaymentsTransferTypeRealTimePaymentsTransfer:
+		return true
+	}
+	return false
+}
+
 type RealTimePaymentsTransferNewParams struct {
 	// The transfer amount in USD cents. For Real-Time Payments transfers, must be
 	// positive.
@@ -40,7 +40,7 @@ func NewRoutingNumberService(opts ...option.RequestOption) (r *RoutingNumberServ
 func (r *RoutingNumberService) List(ctx context.Context, query RoutingNumberListParams, opts ...option.RequestOption) (res *shared.Page[RoutingNumber], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("RoutingNumbers.List")}, opts...)
 	path := "routing_numbers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -96,6 +96,14 @@ func (r *RoutingNumber) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r RoutingNumber) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r routingNumberJSON) RawJSON() string {
+	return r.raw
+}
+
 // This routing number's support for ACH Transfers.
 type RoutingNumberACHTransfers string
 
@@ -106,6 +114,14 @@ const (
 	RoutingNumberACHTransfersNotSupported RoutingNumberACHTransfers = "not_supported"
 )
 
+func (r RoutingNumberACHTransfers) IsKnown() bool {
+	switch r {
+	case RoutingNumberACHTransfersSupported, RoutingNumberACHTransfersNotSupported:
+		return true
+	}
+	return false
+}
+
 // This routing number's support for Real-Time Payments Transfers.
 type RoutingNumberRealTimePaymentsTransfers string
 
@@ -116,6 +132,14 @@ const (
 	RoutingNumberRealTimePaymentsTransfersNotSupported RoutingNumberRealTimePaymentsTransfers = "not_supported"
 )
 
+func (r RoutingNumberRealTimePaymentsTransfers) IsKnown() bool {
+	switch r {
+	case RoutingNumberRealTimePaymentsTransfersSupported, RoutingNumberRealTimePaymentsTransfersNotSupported:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `routing_number`.
 type RoutingNumberType string
@@ -124,6 +148,14 @@ const (
 	RoutingNumberTypeRoutingNumber RoutingNumberType = "routing_number"
 )
 
+func (r RoutingNumberType) IsKnown() bool {
+	switch r {
+	case RoutingNumberTypeRoutingNumber:
+		return true
+	}
+	return false
+}
+
 // This routing number's support for Wire Transfers.
 type RoutingNumberWireTransfers string
 
@@ -134,6 +166,14 @@ const (
 	RoutingNumberWireTransfersNotSupported RoutingNumberWireTransfers = "not_supported"
 )
 
+func (r RoutingNumberWireTransfers) IsKnown() bool {
+	switch r {
+	case RoutingNumberWireTransfersSupported, RoutingNumberWireTransfersNotSupported:
+		return true
+	}
+	return false
+}
+
 type RoutingNumberListParams struct {
 	// Filter financial institutions by routing number.
 	RoutingNumber param.Field[string] `query:"routing_number,required"`
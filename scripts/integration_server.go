@@ -176,18 +176,6 @@ func getCheckDepositsFunc(w http.ResponseWriter, r *http.Request) {
 	check(err)
 	w.Header().Add("Content-Type", "application/json")
 	w.Write(pageB)
-
-	// Cache requests for future idempotent attempts.
-	// TODO: Discuss dropping idempotency for GET requests.
-	if ikey := r.Header.Get("Idempotency-Key"); ikey != "" {
-		cachedRequests[ikey] = &CachedRequest{
-			Method:         "GET",
-			URL:            r.URL,
-			Body:           nil,
-			ResponseStatus: 200,
-			ResponseBody:   pageB,
-		}
-	}
 }
 
 var seenBodies map[string]bool
@@ -34,6 +34,7 @@ func NewSimulationAccountStatementService(opts ...option.RequestOption) (r *Simu
 // account. In production, Account Statements are generated once per month.
 func (r *SimulationAccountStatementService) New(ctx context.Context, body SimulationAccountStatementNewParams, opts ...option.RequestOption) (res *AccountStatement, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.AccountStatements.New")}, opts...)
 	path := "simulations/account_statements"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -35,6 +35,7 @@ func NewSimulationAccountTransferService(opts ...option.RequestOption) (r *Simul
 // must first have a `status` of `pending_approval`.
 func (r *SimulationAccountTransferService) Complete(ctx context.Context, accountTransferID string, opts ...option.RequestOption) (res *AccountTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.AccountTransfers.Complete")}, opts...)
 	path := fmt.Sprintf("simulations/account_transfers/%s/complete", accountTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -44,6 +44,7 @@ func NewSimulationACHTransferService(opts ...option.RequestOption) (r *Simulatio
 // transfer is allowed.
 func (r *SimulationACHTransferService) NewInbound(ctx context.Context, body SimulationACHTransferNewInboundParams, opts ...option.RequestOption) (res *ACHTransferSimulation, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.ACHTransfers.NewInbound")}, opts...)
 	path := "simulations/inbound_ach_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -54,6 +55,7 @@ func (r *SimulationACHTransferService) NewInbound(ctx context.Context, body Simu
 // the returned funds. This transfer must first have a `status` of `submitted`.
 func (r *SimulationACHTransferService) Return(ctx context.Context, achTransferID string, body SimulationACHTransferReturnParams, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.ACHTransfers.Return")}, opts...)
 	path := fmt.Sprintf("simulations/ach_transfers/%s/return", achTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -67,6 +69,7 @@ func (r *SimulationACHTransferService) Return(ctx context.Context, achTransferID
 // delay and transition the ACH Transfer to a status of `submitted`.
 func (r *SimulationACHTransferService) Submit(ctx context.Context, achTransferID string, opts ...option.RequestOption) (res *ACHTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.ACHTransfers.Submit")}, opts...)
 	path := fmt.Sprintf("simulations/ach_transfers/%s/submit", achTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -105,6 +108,14 @@ func (r *ACHTransferSimulation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the ACH Transfer attempt fails, this will contain the resulting
 // [Declined Transaction](#declined-transactions) object. The Declined
 // Transaction's `source` will be of `category: inbound_ach_transfer`.
@@ -163,6 +174,14 @@ func (r *ACHTransferSimulationDeclinedTransaction) UnmarshalJSON(data []byte) (e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Declined
 // Transaction's currency. This will match the currency on the Declined
 // Transaction's Account.
@@ -183,6 +202,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionCurrencyUsd ACHTransferSimulationDeclinedTransactionCurrency = "USD"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionCurrencyCad, ACHTransferSimulationDeclinedTransactionCurrencyChf, ACHTransferSimulationDeclinedTransactionCurrencyEur, ACHTransferSimulationDeclinedTransactionCurrencyGbp, ACHTransferSimulationDeclinedTransactionCurrencyJpy, ACHTransferSimulationDeclinedTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Declined Transaction came through.
 type ACHTransferSimulationDeclinedTransactionRouteType string
 
@@ -193,6 +220,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionRouteTypeCard ACHTransferSimulationDeclinedTransactionRouteType = "card"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionRouteType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionRouteTypeAccountNumber, ACHTransferSimulationDeclinedTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Declined Transaction. For example, for a card transaction this lists the
 // merchant's industry and location. Note that for backwards compatibility reasons,
@@ -242,6 +277,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSource) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `ach_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceACHDecline struct {
@@ -295,6 +338,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceACHDecline) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH transfer was declined.
 type ACHTransferSimulationDeclinedTransactionSourceACHDeclineReason string
 
@@ -331,6 +382,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonUserInitiated ACHTransferSimulationDeclinedTransactionSourceACHDeclineReason = "user_initiated"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceACHDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonACHRouteCanceled, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonACHRouteDisabled, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonBreachesLimit, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonCreditEntryRefusedByReceiver, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonDuplicateReturn, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonEntityNotActive, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonGroupLocked, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonInsufficientFunds, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonMisroutedReturn, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonReturnOfErroneousOrReversingDebit, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonNoACHRoute, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonOriginatorRequest, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonTransactionNotAllowed, ACHTransferSimulationDeclinedTransactionSourceACHDeclineReasonUserInitiated:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `ach_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceACHDeclineType string
@@ -339,6 +398,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceACHDeclineTypeACHDecline ACHTransferSimulationDeclinedTransactionSourceACHDeclineType = "ach_decline"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceACHDeclineType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceACHDeclineTypeACHDecline:
+		return true
+	}
+	return false
+}
+
 // A Card Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceCardDecline struct {
@@ -419,6 +486,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDecline) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrency string
@@ -438,6 +513,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyUsd ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrency = "USD"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyCad, ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyChf, ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyEur, ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyGbp, ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyJpy, ACHTransferSimulationDeclinedTransactionSourceCardDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -461,6 +544,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetails
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory string
 
@@ -469,6 +560,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory = "visa"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -495,6 +594,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetails
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -537,6 +644,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorRecurring, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorInstallment, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode string
@@ -564,6 +679,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeUnknown, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeManual, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactless, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -594,6 +717,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkIdentif
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategory string
@@ -618,6 +749,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryRefund ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategory = "refund"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryAccountFunding, ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryAutomaticFuelDispenser, ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryBillPayment, ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryPurchase, ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryQuasiCash, ACHTransferSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // Why the transaction was declined.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineReason string
 
@@ -654,6 +793,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonSuspectedFraud ACHTransferSimulationDeclinedTransactionSourceCardDeclineReason = "suspected_fraud"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonCardNotActive, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonPhysicalCardNotActive, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonEntityNotActive, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonGroupLocked, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonInsufficientFunds, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonCvv2Mismatch, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonTransactionNotAllowed, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonBreachesLimit, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonWebhookDeclined, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonWebhookTimedOut, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonDeclinedByStandInProcessing, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonInvalidPhysicalCard, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonMissingOriginalAuthorization, ACHTransferSimulationDeclinedTransactionSourceCardDeclineReasonSuspectedFraud:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -679,6 +826,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerification)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCode struct {
@@ -700,6 +855,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCa
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult string
 
@@ -712,6 +875,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNotChecked, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultMatch, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddress struct {
@@ -746,6 +917,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCa
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult string
 
@@ -764,6 +943,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult = "no_match"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNotChecked, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultMatch, ACHTransferSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type ACHTransferSimulationDeclinedTransactionSourceCategory string
@@ -787,6 +974,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCategoryOther ACHTransferSimulationDeclinedTransactionSourceCategory = "other"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCategoryACHDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryCardDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryCheckDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryInboundRealTimePaymentsTransferDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryInternationalACHDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryWireDecline, ACHTransferSimulationDeclinedTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `check_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceCheckDecline struct {
@@ -825,6 +1020,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceCheckDecline) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCheckDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceCheckDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the check was declined.
 type ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReason string
 
@@ -856,6 +1059,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReason = "no_account_number_found"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonACHRouteDisabled, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonACHRouteCanceled, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonBreachesLimit, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonEntityNotActive, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonGroupLocked, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonInsufficientFunds, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonStopPaymentRequested, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonDuplicatePresentment, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonNotAuthorized, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonAmountMismatch, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonNotOurItem, ACHTransferSimulationDeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Decline object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_decline`.
@@ -905,6 +1116,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTr
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the declined
 // transfer's currency. This will always be "USD" for a Real-Time Payments
 // transfer.
@@ -925,6 +1144,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency = "USD"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyCad, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyChf, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyEur, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyGbp, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyJpy, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why the transfer was declined.
 type ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason string
 
@@ -943,6 +1170,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason = "real_time_payments_not_enabled"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberCanceled, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberDisabled, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountRestricted, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonGroupLocked, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonEntityNotActive, ACHTransferSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled:
+		return true
+	}
+	return false
+}
+
 // An International ACH Decline object. This field will be present in the JSON
 // response if and only if `category` is equal to `international_ach_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDecline struct {
@@ -1094,6 +1329,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceInternationalACHDecline)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceInternationalACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator string
 
@@ -1109,6 +1352,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToVariable, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorVariableToFixed, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator string
@@ -1123,6 +1374,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeRate, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode string
 
@@ -1169,6 +1428,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAnnuity, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBusinessOrCommercial, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeDeposit, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeLoan, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMiscellaneous, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMortgage, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePension, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRemittance, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRentOrLease, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSalaryOrPayroll, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTax, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAccountsReceivable, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBackOfficeConversion, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMachineTransfer, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfPurchase, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfSale, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRepresentedCheck, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSharedNetworkTransaction, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTelphoneInitiated, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -1183,6 +1450,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -1197,6 +1472,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierBicCode, ACHTransferSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // A Wire Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `wire_decline`.
 type ACHTransferSimulationDeclinedTransactionSourceWireDecline struct {
@@ -1273,6 +1556,14 @@ func (r *ACHTransferSimulationDeclinedTransactionSourceWireDecline) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationDeclinedTransactionSourceWireDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationDeclinedTransactionSourceWireDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the wire transfer was declined.
 type ACHTransferSimulationDeclinedTransactionSourceWireDeclineReason string
 
@@ -1291,6 +1582,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed ACHTransferSimulationDeclinedTransactionSourceWireDeclineReason = "transaction_not_allowed"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionSourceWireDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonAccountNumberCanceled, ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonAccountNumberDisabled, ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonEntityNotActive, ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonGroupLocked, ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonNoAccountNumber, ACHTransferSimulationDeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `declined_transaction`.
 type ACHTransferSimulationDeclinedTransactionType string
@@ -1299,6 +1598,14 @@ const (
 	ACHTransferSimulationDeclinedTransactionTypeDeclinedTransaction ACHTransferSimulationDeclinedTransactionType = "declined_transaction"
 )
 
+func (r ACHTransferSimulationDeclinedTransactionType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationDeclinedTransactionTypeDeclinedTransaction:
+		return true
+	}
+	return false
+}
+
 // If the ACH Transfer attempt succeeds, this will contain the resulting
 // [Transaction](#transactions) object. The Transaction's `source` will be of
 // `category: inbound_ach_transfer`.
@@ -1358,6 +1665,14 @@ func (r *ACHTransferSimulationTransaction) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // Transaction's currency. This will match the currency on the Transaction's
 // Account.
@@ -1378,6 +1693,14 @@ const (
 	ACHTransferSimulationTransactionCurrencyUsd ACHTransferSimulationTransactionCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionCurrencyCad, ACHTransferSimulationTransactionCurrencyChf, ACHTransferSimulationTransactionCurrencyEur, ACHTransferSimulationTransactionCurrencyGbp, ACHTransferSimulationTransactionCurrencyJpy, ACHTransferSimulationTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Transaction came through.
 type ACHTransferSimulationTransactionRouteType string
 
@@ -1388,6 +1711,14 @@ const (
 	ACHTransferSimulationTransactionRouteTypeCard ACHTransferSimulationTransactionRouteType = "card"
 )
 
+func (r ACHTransferSimulationTransactionRouteType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionRouteTypeAccountNumber, ACHTransferSimulationTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Transaction. Note that for backwards compatibility reasons, additional
 // undocumented keys may appear in this object. These should be treated as
@@ -1528,6 +1859,14 @@ func (r *ACHTransferSimulationTransactionSource) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_intention`.
 type ACHTransferSimulationTransactionSourceAccountTransferIntention struct {
@@ -1566,6 +1905,14 @@ func (r *ACHTransferSimulationTransactionSourceAccountTransferIntention) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceAccountTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceAccountTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrency string
@@ -1585,6 +1932,14 @@ const (
 	ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyUsd ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyCad, ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyChf, ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyEur, ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyGbp, ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyJpy, ACHTransferSimulationTransactionSourceAccountTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_intention`.
 type ACHTransferSimulationTransactionSourceACHTransferIntention struct {
@@ -1620,6 +1975,14 @@ func (r *ACHTransferSimulationTransactionSourceACHTransferIntention) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceACHTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceACHTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_rejection`.
 type ACHTransferSimulationTransactionSourceACHTransferRejection struct {
@@ -1641,6 +2004,14 @@ func (r *ACHTransferSimulationTransactionSourceACHTransferRejection) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceACHTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceACHTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `ach_transfer_return`.
 type ACHTransferSimulationTransactionSourceACHTransferReturn struct {
@@ -1676,6 +2047,14 @@ func (r *ACHTransferSimulationTransactionSourceACHTransferReturn) UnmarshalJSON(
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceACHTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceACHTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH Transfer was returned. This reason code is sent by the receiving
 // bank back to Increase.
 type ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode string
@@ -1868,6 +2247,14 @@ const (
 	ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode = "untimely_return"
 )
 
+func (r ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInsufficientFund, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNoAccount, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountClosed, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidAccountNumberStructure, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCreditEntryRefusedByReceiver, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePaymentStopped, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonTransactionAccount, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUncollectedFunds, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRoutingNumberCheckDigitError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAmountFieldError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAuthorizationRevokedByCustomer, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidACHRoutingNumber, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeFileRecordEditCriteria, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualName, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnedPerOdfiRequest, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeLimitedParticipationDfi, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountSoldToAnotherDfi, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAddendaError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCorrectedReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeDuplicateEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeDuplicateReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrDuplicateEnrollment, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidDfiAccountNumber, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualIDNumber, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidTransactionCode, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrReturnOfEnrEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEntryNotProcessedByGateway, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeFieldError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeIatEntryCodingError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeImproperEffectiveEntryDate, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidCompanyID, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidIndividualIDNumber, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMandatoryFieldError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMisroutedDishonoredReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMisroutedReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNoErrorsFound, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonParticipantInIatProgram, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntryNotAccepted, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRdfiNonSettlement, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnNotADuplicate, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperCreditEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperDebitEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfXckEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeSourceDocumentPresentedForPayment, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStateLawAffectingRckAcceptance, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnSourceDocument, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeTimelyOriginalReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeTraceNumberError, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyDishonoredReturn, ACHTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // A Card Dispute Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `card_dispute_acceptance`.
 type ACHTransferSimulationTransactionSourceCardDisputeAcceptance struct {
@@ -1897,6 +2284,14 @@ func (r *ACHTransferSimulationTransactionSourceCardDisputeAcceptance) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardDisputeAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardDisputeAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Refund object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_refund`.
 type ACHTransferSimulationTransactionSourceCardRefund struct {
@@ -1961,6 +2356,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefund) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefund) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type ACHTransferSimulationTransactionSourceCardRefundCurrency string
@@ -1980,6 +2383,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundCurrencyUsd ACHTransferSimulationTransactionSourceCardRefundCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundCurrencyCad, ACHTransferSimulationTransactionSourceCardRefundCurrencyChf, ACHTransferSimulationTransactionSourceCardRefundCurrencyEur, ACHTransferSimulationTransactionSourceCardRefundCurrencyGbp, ACHTransferSimulationTransactionSourceCardRefundCurrencyJpy, ACHTransferSimulationTransactionSourceCardRefundCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type ACHTransferSimulationTransactionSourceCardRefundNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -2008,6 +2419,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundNetworkIdentifiers) Unm
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetails struct {
@@ -2058,6 +2477,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetails) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -2131,6 +2558,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRenta
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges string
 
@@ -2149,6 +2584,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesNoExtraCharge, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesGas, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesExtraMileage, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesLateReturn, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator string
@@ -2160,6 +2603,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -2232,6 +2683,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges string
 
@@ -2252,6 +2711,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesNoExtraCharge, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesRestaurant, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesGiftShop, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesMiniBar, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesTelephone, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesOther, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator string
@@ -2263,6 +2730,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNotApplicable, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -2279,6 +2754,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatFreeText, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatOrderNumber, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -2332,6 +2815,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -2366,6 +2857,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAn
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -2380,6 +2879,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -2402,6 +2909,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAn
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -2456,6 +2971,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryNone, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBundledService, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCargo, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryLounge, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMedical, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryOther, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPets, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategorySeatFees, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStandby, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStore, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryTravelService, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -2474,6 +2997,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorNoCredit, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorOther, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -2484,6 +3015,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -2496,6 +3035,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNone, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -2530,6 +3077,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTr
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -2542,6 +3097,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeNone, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, ACHTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_refund`.
 type ACHTransferSimulationTransactionSourceCardRefundType string
@@ -2550,6 +3113,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRefundTypeCardRefund ACHTransferSimulationTransactionSourceCardRefundType = "card_refund"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRefundType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRefundTypeCardRefund:
+		return true
+	}
+	return false
+}
+
 // A Card Revenue Payment object. This field will be present in the JSON response
 // if and only if `category` is equal to `card_revenue_payment`.
 type ACHTransferSimulationTransactionSourceCardRevenuePayment struct {
@@ -2585,6 +3156,14 @@ func (r *ACHTransferSimulationTransactionSourceCardRevenuePayment) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardRevenuePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardRevenuePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrency string
@@ -2604,6 +3183,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyUsd ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyCad, ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyChf, ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyEur, ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyGbp, ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyJpy, ACHTransferSimulationTransactionSourceCardRevenuePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Card Settlement object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_settlement`.
 type ACHTransferSimulationTransactionSourceCardSettlement struct {
@@ -2682,6 +3269,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlement) UnmarshalJSON(dat
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's settlement currency.
 type ACHTransferSimulationTransactionSourceCardSettlementCurrency string
@@ -2701,6 +3296,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementCurrencyUsd ACHTransferSimulationTransactionSourceCardSettlementCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementCurrencyCad, ACHTransferSimulationTransactionSourceCardSettlementCurrencyChf, ACHTransferSimulationTransactionSourceCardSettlementCurrencyEur, ACHTransferSimulationTransactionSourceCardSettlementCurrencyGbp, ACHTransferSimulationTransactionSourceCardSettlementCurrencyJpy, ACHTransferSimulationTransactionSourceCardSettlementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type ACHTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -2729,6 +3332,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetails struct {
@@ -2779,6 +3390,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetails) Un
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -2852,6 +3471,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarR
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges string
 
@@ -2870,6 +3497,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesNoExtraCharge, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesGas, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesExtraMileage, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesLateReturn, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator string
@@ -2881,6 +3516,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -2953,6 +3596,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodg
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges string
 
@@ -2973,6 +3624,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesNoExtraCharge, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesRestaurant, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesGiftShop, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesMiniBar, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesTelephone, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesOther, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator string
@@ -2984,6 +3643,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNotApplicable, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -3000,6 +3667,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatFreeText, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatOrderNumber, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -3053,6 +3728,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTrav
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -3087,6 +3770,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTrav
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -3101,6 +3792,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -3123,6 +3822,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTrav
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -3177,6 +3884,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryNone, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBundledService, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCargo, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryLounge, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMedical, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryOther, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPets, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategorySeatFees, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStandby, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStore, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryTravelService, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -3195,6 +3910,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorNoCredit, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorOther, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -3205,6 +3928,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -3217,6 +3948,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNone, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -3251,6 +3990,14 @@ func (r *ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTrav
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -3263,6 +4010,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeNone, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, ACHTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_settlement`.
 type ACHTransferSimulationTransactionSourceCardSettlementType string
@@ -3271,6 +4026,14 @@ const (
 	ACHTransferSimulationTransactionSourceCardSettlementTypeCardSettlement ACHTransferSimulationTransactionSourceCardSettlementType = "card_settlement"
 )
 
+func (r ACHTransferSimulationTransactionSourceCardSettlementType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCardSettlementTypeCardSettlement:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type ACHTransferSimulationTransactionSourceCategory string
@@ -3355,6 +4118,14 @@ const (
 	ACHTransferSimulationTransactionSourceCategoryOther ACHTransferSimulationTransactionSourceCategory = "other"
 )
 
+func (r ACHTransferSimulationTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCategoryAccountTransferIntention, ACHTransferSimulationTransactionSourceCategoryACHTransferIntention, ACHTransferSimulationTransactionSourceCategoryACHTransferRejection, ACHTransferSimulationTransactionSourceCategoryACHTransferReturn, ACHTransferSimulationTransactionSourceCategoryCardDisputeAcceptance, ACHTransferSimulationTransactionSourceCategoryCardRefund, ACHTransferSimulationTransactionSourceCategoryCardSettlement, ACHTransferSimulationTransactionSourceCategoryCardRevenuePayment, ACHTransferSimulationTransactionSourceCategoryCheckDepositAcceptance, ACHTransferSimulationTransactionSourceCategoryCheckDepositReturn, ACHTransferSimulationTransactionSourceCategoryCheckTransferDeposit, ACHTransferSimulationTransactionSourceCategoryCheckTransferIntention, ACHTransferSimulationTransactionSourceCategoryCheckTransferStopPaymentRequest, ACHTransferSimulationTransactionSourceCategoryFeePayment, ACHTransferSimulationTransactionSourceCategoryInboundACHTransfer, ACHTransferSimulationTransactionSourceCategoryInboundACHTransferReturnIntention, ACHTransferSimulationTransactionSourceCategoryInboundCheck, ACHTransferSimulationTransactionSourceCategoryInboundInternationalACHTransfer, ACHTransferSimulationTransactionSourceCategoryInboundRealTimePaymentsTransferConfirmation, ACHTransferSimulationTransactionSourceCategoryInboundWireDrawdownPaymentReversal, ACHTransferSimulationTransactionSourceCategoryInboundWireDrawdownPayment, ACHTransferSimulationTransactionSourceCategoryInboundWireReversal, ACHTransferSimulationTransactionSourceCategoryInboundWireTransfer, ACHTransferSimulationTransactionSourceCategoryInterestPayment, ACHTransferSimulationTransactionSourceCategoryInternalSource, ACHTransferSimulationTransactionSourceCategoryRealTimePaymentsTransferAcknowledgement, ACHTransferSimulationTransactionSourceCategorySampleFunds, ACHTransferSimulationTransactionSourceCategoryWireTransferIntention, ACHTransferSimulationTransactionSourceCategoryWireTransferRejection, ACHTransferSimulationTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_acceptance`.
 type ACHTransferSimulationTransactionSourceCheckDepositAcceptance struct {
@@ -3398,6 +4169,14 @@ func (r *ACHTransferSimulationTransactionSourceCheckDepositAcceptance) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCheckDepositAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCheckDepositAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency string
@@ -3417,6 +4196,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyUsd ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyCad, ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyChf, ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyEur, ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyGbp, ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyJpy, ACHTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_deposit_return`.
 type ACHTransferSimulationTransactionSourceCheckDepositReturn struct {
@@ -3458,6 +4245,14 @@ func (r *ACHTransferSimulationTransactionSourceCheckDepositReturn) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCheckDepositReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCheckDepositReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type ACHTransferSimulationTransactionSourceCheckDepositReturnCurrency string
@@ -3477,6 +4272,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyUsd ACHTransferSimulationTransactionSourceCheckDepositReturnCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckDepositReturnCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyCad, ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyChf, ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyEur, ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyGbp, ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyJpy, ACHTransferSimulationTransactionSourceCheckDepositReturnCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why this check was returned by the bank holding the account it was drawn
 // against.
 type ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReason string
@@ -3508,6 +4311,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReason = "endorsement_irregular"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonACHConversionNotSupported, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonClosedAccount, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonDuplicateSubmission, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonInsufficientFunds, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonNoAccount, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonNotAuthorized, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonStaleDated, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonStopPayment, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnknownReason, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnmatchedDetails, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnreadableImage, ACHTransferSimulationTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Deposit object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_transfer_deposit`.
 type ACHTransferSimulationTransactionSourceCheckTransferDeposit struct {
@@ -3552,6 +4363,14 @@ func (r *ACHTransferSimulationTransactionSourceCheckTransferDeposit) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCheckTransferDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_deposit`.
 type ACHTransferSimulationTransactionSourceCheckTransferDepositType string
@@ -3560,6 +4379,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckTransferDepositTypeCheckTransferDeposit ACHTransferSimulationTransactionSourceCheckTransferDepositType = "check_transfer_deposit"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferDepositType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckTransferDepositTypeCheckTransferDeposit:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_intention`.
 type ACHTransferSimulationTransactionSourceCheckTransferIntention struct {
@@ -3606,6 +4433,14 @@ func (r *ACHTransferSimulationTransactionSourceCheckTransferIntention) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCheckTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrency string
@@ -3625,6 +4460,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyUsd ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyCad, ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyChf, ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyEur, ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyGbp, ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyJpy, ACHTransferSimulationTransactionSourceCheckTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Stop Payment Request object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `check_transfer_stop_payment_request`.
@@ -3657,6 +4500,14 @@ func (r *ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequest)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceCheckTransferStopPaymentRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason why this transfer was stopped.
 type ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason string
 
@@ -3670,6 +4521,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonUnknown ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason = "unknown"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonMailDeliveryFailed, ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonRejectedByIncrease, ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_stop_payment_request`.
 type ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType string
@@ -3678,6 +4537,14 @@ const (
 	ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType = "check_transfer_stop_payment_request"
 )
 
+func (r ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest:
+		return true
+	}
+	return false
+}
+
 // A Fee Payment object. This field will be present in the JSON response if and
 // only if `category` is equal to `fee_payment`.
 type ACHTransferSimulationTransactionSourceFeePayment struct {
@@ -3706,6 +4573,14 @@ func (r *ACHTransferSimulationTransactionSourceFeePayment) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceFeePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceFeePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type ACHTransferSimulationTransactionSourceFeePaymentCurrency string
@@ -3725,6 +4600,14 @@ const (
 	ACHTransferSimulationTransactionSourceFeePaymentCurrencyUsd ACHTransferSimulationTransactionSourceFeePaymentCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceFeePaymentCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceFeePaymentCurrencyCad, ACHTransferSimulationTransactionSourceFeePaymentCurrencyChf, ACHTransferSimulationTransactionSourceFeePaymentCurrencyEur, ACHTransferSimulationTransactionSourceFeePaymentCurrencyGbp, ACHTransferSimulationTransactionSourceFeePaymentCurrencyJpy, ACHTransferSimulationTransactionSourceFeePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_ach_transfer`.
 type ACHTransferSimulationTransactionSourceInboundACHTransfer struct {
@@ -3780,6 +4663,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundACHTransfer) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Check object. This field will be present in the JSON response if and
 // only if `category` is equal to `inbound_check`.
 type ACHTransferSimulationTransactionSourceInboundCheck struct {
@@ -3820,6 +4711,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundCheck) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundCheck) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundCheckJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type ACHTransferSimulationTransactionSourceInboundCheckCurrency string
@@ -3839,6 +4738,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundCheckCurrencyUsd ACHTransferSimulationTransactionSourceInboundCheckCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundCheckCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundCheckCurrencyCad, ACHTransferSimulationTransactionSourceInboundCheckCurrencyChf, ACHTransferSimulationTransactionSourceInboundCheckCurrencyEur, ACHTransferSimulationTransactionSourceInboundCheckCurrencyGbp, ACHTransferSimulationTransactionSourceInboundCheckCurrencyJpy, ACHTransferSimulationTransactionSourceInboundCheckCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound International ACH Transfer object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `inbound_international_ach_transfer`.
@@ -3991,6 +4898,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundInternationalACHTransfer)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundInternationalACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator string
 
@@ -4006,6 +4921,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToVariable, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorVariableToFixed, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator string
@@ -4020,6 +4943,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeRate, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode string
 
@@ -4066,6 +4997,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAnnuity, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBusinessOrCommercial, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeDeposit, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeLoan, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMiscellaneous, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMortgage, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePension, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRemittance, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRentOrLease, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSalaryOrPayroll, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTax, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAccountsReceivable, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBackOfficeConversion, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMachineTransfer, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfPurchase, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfSale, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRepresentedCheck, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSharedNetworkTransaction, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTelphoneInitiated, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -4080,6 +5019,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -4094,6 +5041,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierBicCode, ACHTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Confirmation object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_confirmation`.
@@ -4139,6 +5094,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferCo
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the transfer's
 // currency. This will always be "USD" for a Real-Time Payments transfer.
 type ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency string
@@ -4158,6 +5121,14 @@ const (
 	ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyCad, ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyChf, ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyEur, ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyGbp, ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyJpy, ACHTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Wire Drawdown Payment object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_wire_drawdown_payment`.
 type ACHTransferSimulationTransactionSourceInboundWireDrawdownPayment struct {
@@ -4234,6 +5205,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundWireDrawdownPayment) Unmar
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundWireDrawdownPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundWireDrawdownPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Drawdown Payment Reversal object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `inbound_wire_drawdown_payment_reversal`.
@@ -4287,6 +5266,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundWireDrawdownPaymentReversa
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundWireDrawdownPaymentReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundWireDrawdownPaymentReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Reversal object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_reversal`.
 type ACHTransferSimulationTransactionSourceInboundWireReversal struct {
@@ -4358,6 +5345,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundWireReversal) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundWireReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundWireReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Transfer object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_transfer`.
 type ACHTransferSimulationTransactionSourceInboundWireTransfer struct {
@@ -4433,6 +5428,14 @@ func (r *ACHTransferSimulationTransactionSourceInboundWireTransfer) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInboundWireTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInboundWireTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Interest Payment object. This field will be present in the JSON response if
 // and only if `category` is equal to `interest_payment`.
 type ACHTransferSimulationTransactionSourceInterestPayment struct {
@@ -4467,6 +5470,14 @@ func (r *ACHTransferSimulationTransactionSourceInterestPayment) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInterestPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInterestPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type ACHTransferSimulationTransactionSourceInterestPaymentCurrency string
@@ -4486,6 +5497,14 @@ const (
 	ACHTransferSimulationTransactionSourceInterestPaymentCurrencyUsd ACHTransferSimulationTransactionSourceInterestPaymentCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceInterestPaymentCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInterestPaymentCurrencyCad, ACHTransferSimulationTransactionSourceInterestPaymentCurrencyChf, ACHTransferSimulationTransactionSourceInterestPaymentCurrencyEur, ACHTransferSimulationTransactionSourceInterestPaymentCurrencyGbp, ACHTransferSimulationTransactionSourceInterestPaymentCurrencyJpy, ACHTransferSimulationTransactionSourceInterestPaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source object. This field will be present in the JSON response if
 // and only if `category` is equal to `internal_source`.
 type ACHTransferSimulationTransactionSourceInternalSource struct {
@@ -4515,6 +5534,14 @@ func (r *ACHTransferSimulationTransactionSourceInternalSource) UnmarshalJSON(dat
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceInternalSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceInternalSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type ACHTransferSimulationTransactionSourceInternalSourceCurrency string
@@ -4534,6 +5561,14 @@ const (
 	ACHTransferSimulationTransactionSourceInternalSourceCurrencyUsd ACHTransferSimulationTransactionSourceInternalSourceCurrency = "USD"
 )
 
+func (r ACHTransferSimulationTransactionSourceInternalSourceCurrency) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInternalSourceCurrencyCad, ACHTransferSimulationTransactionSourceInternalSourceCurrencyChf, ACHTransferSimulationTransactionSourceInternalSourceCurrencyEur, ACHTransferSimulationTransactionSourceInternalSourceCurrencyGbp, ACHTransferSimulationTransactionSourceInternalSourceCurrencyJpy, ACHTransferSimulationTransactionSourceInternalSourceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source is a transaction between you and Increase. This describes the
 // reason for the transaction.
 type ACHTransferSimulationTransactionSourceInternalSourceReason string
@@ -4567,6 +5602,14 @@ const (
 	ACHTransferSimulationTransactionSourceInternalSourceReasonSampleFundsReturn ACHTransferSimulationTransactionSourceInternalSourceReason = "sample_funds_return"
 )
 
+func (r ACHTransferSimulationTransactionSourceInternalSourceReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionSourceInternalSourceReasonAccountClosure, ACHTransferSimulationTransactionSourceInternalSourceReasonBankMigration, ACHTransferSimulationTransactionSourceInternalSourceReasonCashback, ACHTransferSimulationTransactionSourceInternalSourceReasonCheckAdjustment, ACHTransferSimulationTransactionSourceInternalSourceReasonCollectionReceivable, ACHTransferSimulationTransactionSourceInternalSourceReasonEmpyrealAdjustment, ACHTransferSimulationTransactionSourceInternalSourceReasonError, ACHTransferSimulationTransactionSourceInternalSourceReasonErrorCorrection, ACHTransferSimulationTransactionSourceInternalSourceReasonFees, ACHTransferSimulationTransactionSourceInternalSourceReasonInterest, ACHTransferSimulationTransactionSourceInternalSourceReasonNegativeBalanceForgiveness, ACHTransferSimulationTransactionSourceInternalSourceReasonSampleFunds, ACHTransferSimulationTransactionSourceInternalSourceReasonSampleFundsReturn:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Acknowledgement object. This field will be present
 // in the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_acknowledgement`.
@@ -4601,6 +5644,14 @@ func (r *ACHTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowled
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowledgement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowledgementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Sample Funds object. This field will be present in the JSON response if and
 // only if `category` is equal to `sample_funds`.
 type ACHTransferSimulationTransactionSourceSampleFunds struct {
@@ -4621,6 +5672,14 @@ func (r *ACHTransferSimulationTransactionSourceSampleFunds) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceSampleFunds) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceSampleFundsJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_intention`.
 type ACHTransferSimulationTransactionSourceWireTransferIntention struct {
@@ -4654,6 +5713,14 @@ func (r *ACHTransferSimulationTransactionSourceWireTransferIntention) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceWireTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceWireTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_rejection`.
 type ACHTransferSimulationTransactionSourceWireTransferRejection struct {
@@ -4675,6 +5742,14 @@ func (r *ACHTransferSimulationTransactionSourceWireTransferRejection) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransactionSourceWireTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransactionSourceWireTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `transaction`.
 type ACHTransferSimulationTransactionType string
@@ -4683,6 +5758,14 @@ const (
 	ACHTransferSimulationTransactionTypeTransaction ACHTransferSimulationTransactionType = "transaction"
 )
 
+func (r ACHTransferSimulationTransactionType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransactionTypeTransaction:
+		return true
+	}
+	return false
+}
+
 // The Inbound ACH Transfer.
 type ACHTransferSimulationTransfer struct {
 	// The inbound ach transfer's identifier.
@@ -4765,6 +5848,14 @@ func (r *ACHTransferSimulationTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your transfer is accepted, this will contain details of the acceptance.
 type ACHTransferSimulationTransferAcceptance struct {
 	// The time at which the transfer was accepted.
@@ -4787,6 +5878,14 @@ func (r *ACHTransferSimulationTransferAcceptance) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional information sent from the originator.
 type ACHTransferSimulationTransferAddenda struct {
 	// The type of addendum.
@@ -4809,6 +5908,14 @@ func (r *ACHTransferSimulationTransferAddenda) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferAddenda) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferAddendaJSON) RawJSON() string {
+	return r.raw
+}
+
 // The type of addendum.
 type ACHTransferSimulationTransferAddendaCategory string
 
@@ -4817,6 +5924,14 @@ const (
 	ACHTransferSimulationTransferAddendaCategoryFreeform ACHTransferSimulationTransferAddendaCategory = "freeform"
 )
 
+func (r ACHTransferSimulationTransferAddendaCategory) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferAddendaCategoryFreeform:
+		return true
+	}
+	return false
+}
+
 // Unstructured `payment_related_information` passed through by the originator.
 type ACHTransferSimulationTransferAddendaFreeform struct {
 	// Each entry represents an addendum received from the originator.
@@ -4836,6 +5951,14 @@ func (r *ACHTransferSimulationTransferAddendaFreeform) UnmarshalJSON(data []byte
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferAddendaFreeform) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferAddendaFreeformJSON) RawJSON() string {
+	return r.raw
+}
+
 type ACHTransferSimulationTransferAddendaFreeformEntry struct {
 	// The payment related information passed in the addendum.
 	PaymentRelatedInformation string                                                `json:"payment_related_information,required"`
@@ -4854,6 +5977,14 @@ func (r *ACHTransferSimulationTransferAddendaFreeformEntry) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferAddendaFreeformEntry) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferAddendaFreeformEntryJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your transfer is declined, this will contain details of the decline.
 type ACHTransferSimulationTransferDecline struct {
 	// The time at which the transfer was declined.
@@ -4879,6 +6010,14 @@ func (r *ACHTransferSimulationTransferDecline) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason for the transfer decline.
 type ACHTransferSimulationTransferDeclineReason string
 
@@ -4915,6 +6054,14 @@ const (
 	ACHTransferSimulationTransferDeclineReasonUserInitiated ACHTransferSimulationTransferDeclineReason = "user_initiated"
 )
 
+func (r ACHTransferSimulationTransferDeclineReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferDeclineReasonACHRouteCanceled, ACHTransferSimulationTransferDeclineReasonACHRouteDisabled, ACHTransferSimulationTransferDeclineReasonBreachesLimit, ACHTransferSimulationTransferDeclineReasonCreditEntryRefusedByReceiver, ACHTransferSimulationTransferDeclineReasonDuplicateReturn, ACHTransferSimulationTransferDeclineReasonEntityNotActive, ACHTransferSimulationTransferDeclineReasonGroupLocked, ACHTransferSimulationTransferDeclineReasonInsufficientFunds, ACHTransferSimulationTransferDeclineReasonMisroutedReturn, ACHTransferSimulationTransferDeclineReasonReturnOfErroneousOrReversingDebit, ACHTransferSimulationTransferDeclineReasonNoACHRoute, ACHTransferSimulationTransferDeclineReasonOriginatorRequest, ACHTransferSimulationTransferDeclineReasonTransactionNotAllowed, ACHTransferSimulationTransferDeclineReasonUserInitiated:
+		return true
+	}
+	return false
+}
+
 // The direction of the transfer.
 type ACHTransferSimulationTransferDirection string
 
@@ -4925,6 +6072,14 @@ const (
 	ACHTransferSimulationTransferDirectionDebit ACHTransferSimulationTransferDirection = "debit"
 )
 
+func (r ACHTransferSimulationTransferDirection) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferDirectionCredit, ACHTransferSimulationTransferDirectionDebit:
+		return true
+	}
+	return false
+}
+
 // If you initiate a notification of change in response to the transfer, this will
 // contain its details.
 type ACHTransferSimulationTransferNotificationOfChange struct {
@@ -4948,6 +6103,14 @@ func (r *ACHTransferSimulationTransferNotificationOfChange) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferNotificationOfChange) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferNotificationOfChangeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The status of the transfer.
 type ACHTransferSimulationTransferStatus string
 
@@ -4963,6 +6126,14 @@ const (
 	ACHTransferSimulationTransferStatusReturned ACHTransferSimulationTransferStatus = "returned"
 )
 
+func (r ACHTransferSimulationTransferStatus) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferStatusPending, ACHTransferSimulationTransferStatusDeclined, ACHTransferSimulationTransferStatusAccepted, ACHTransferSimulationTransferStatusReturned:
+		return true
+	}
+	return false
+}
+
 // If your transfer is returned, this will contain details of the return.
 type ACHTransferSimulationTransferTransferReturn struct {
 	// The reason for the transfer return.
@@ -4988,6 +6159,14 @@ func (r *ACHTransferSimulationTransferTransferReturn) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r ACHTransferSimulationTransferTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r achTransferSimulationTransferTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason for the transfer return.
 type ACHTransferSimulationTransferTransferReturnReason string
 
@@ -5019,6 +6198,14 @@ const (
 	ACHTransferSimulationTransferTransferReturnReasonCorporateCustomerAdvisedNotAuthorized ACHTransferSimulationTransferTransferReturnReason = "corporate_customer_advised_not_authorized"
 )
 
+func (r ACHTransferSimulationTransferTransferReturnReason) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferTransferReturnReasonReturnedPerOdfiRequest, ACHTransferSimulationTransferTransferReturnReasonAuthorizationRevokedByCustomer, ACHTransferSimulationTransferTransferReturnReasonPaymentStopped, ACHTransferSimulationTransferTransferReturnReasonCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, ACHTransferSimulationTransferTransferReturnReasonRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, ACHTransferSimulationTransferTransferReturnReasonBeneficiaryOrAccountHolderDeceased, ACHTransferSimulationTransferTransferReturnReasonCreditEntryRefusedByReceiver, ACHTransferSimulationTransferTransferReturnReasonDuplicateEntry, ACHTransferSimulationTransferTransferReturnReasonCorporateCustomerAdvisedNotAuthorized:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_ach_transfer`.
 type ACHTransferSimulationTransferType string
@@ -5027,6 +6214,14 @@ const (
 	ACHTransferSimulationTransferTypeInboundACHTransfer ACHTransferSimulationTransferType = "inbound_ach_transfer"
 )
 
+func (r ACHTransferSimulationTransferType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTransferTypeInboundACHTransfer:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_ach_transfer_simulation_result`.
 type ACHTransferSimulationType string
@@ -5035,6 +6230,14 @@ const (
 	ACHTransferSimulationTypeInboundACHTransferSimulationResult ACHTransferSimulationType = "inbound_ach_transfer_simulation_result"
 )
 
+func (r ACHTransferSimulationType) IsKnown() bool {
+	switch r {
+	case ACHTransferSimulationTypeInboundACHTransferSimulationResult:
+		return true
+	}
+	return false
+}
+
 type SimulationACHTransferNewInboundParams struct {
 	// The identifier of the Account Number the inbound ACH Transfer is for.
 	AccountNumberID param.Field[string] `json:"account_number_id,required"`
@@ -5262,3 +6465,11 @@ const (
 	// Code R68. A rare return reason. The return was sent too late.
 	SimulationACHTransferReturnParamsReasonUntimelyReturn SimulationACHTransferReturnParamsReason = "untimely_return"
 )
+
+func (r SimulationACHTransferReturnParamsReason) IsKnown() bool {
+	switch r {
+	case SimulationACHTransferReturnParamsReasonInsufficientFund, SimulationACHTransferReturnParamsReasonNoAccount, SimulationACHTransferReturnParamsReasonAccountClosed, SimulationACHTransferReturnParamsReasonInvalidAccountNumberStructure, SimulationACHTransferReturnParamsReasonAccountFrozenEntryReturnedPerOfacInstruction, SimulationACHTransferReturnParamsReasonCreditEntryRefusedByReceiver, SimulationACHTransferReturnParamsReasonUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, SimulationACHTransferReturnParamsReasonCorporateCustomerAdvisedNotAuthorized, SimulationACHTransferReturnParamsReasonPaymentStopped, SimulationACHTransferReturnParamsReasonNonTransactionAccount, SimulationACHTransferReturnParamsReasonUncollectedFunds, SimulationACHTransferReturnParamsReasonRoutingNumberCheckDigitError, SimulationACHTransferReturnParamsReasonCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, SimulationACHTransferReturnParamsReasonAmountFieldError, SimulationACHTransferReturnParamsReasonAuthorizationRevokedByCustomer, SimulationACHTransferReturnParamsReasonInvalidACHRoutingNumber, SimulationACHTransferReturnParamsReasonFileRecordEditCriteria, SimulationACHTransferReturnParamsReasonEnrInvalidIndividualName, SimulationACHTransferReturnParamsReasonReturnedPerOdfiRequest, SimulationACHTransferReturnParamsReasonLimitedParticipationDfi, SimulationACHTransferReturnParamsReasonIncorrectlyCodedOutboundInternationalPayment, SimulationACHTransferReturnParamsReasonAccountSoldToAnotherDfi, SimulationACHTransferReturnParamsReasonAddendaError, SimulationACHTransferReturnParamsReasonBeneficiaryOrAccountHolderDeceased, SimulationACHTransferReturnParamsReasonCustomerAdvisedNotWithinAuthorizationTerms, SimulationACHTransferReturnParamsReasonCorrectedReturn, SimulationACHTransferReturnParamsReasonDuplicateEntry, SimulationACHTransferReturnParamsReasonDuplicateReturn, SimulationACHTransferReturnParamsReasonEnrDuplicateEnrollment, SimulationACHTransferReturnParamsReasonEnrInvalidDfiAccountNumber, SimulationACHTransferReturnParamsReasonEnrInvalidIndividualIDNumber, SimulationACHTransferReturnParamsReasonEnrInvalidRepresentativePayeeIndicator, SimulationACHTransferReturnParamsReasonEnrInvalidTransactionCode, SimulationACHTransferReturnParamsReasonEnrReturnOfEnrEntry, SimulationACHTransferReturnParamsReasonEnrRoutingNumberCheckDigitError, SimulationACHTransferReturnParamsReasonEntryNotProcessedByGateway, SimulationACHTransferReturnParamsReasonFieldError, SimulationACHTransferReturnParamsReasonForeignReceivingDfiUnableToSettle, SimulationACHTransferReturnParamsReasonIatEntryCodingError, SimulationACHTransferReturnParamsReasonImproperEffectiveEntryDate, SimulationACHTransferReturnParamsReasonImproperSourceDocumentSourceDocumentPresented, SimulationACHTransferReturnParamsReasonInvalidCompanyID, SimulationACHTransferReturnParamsReasonInvalidForeignReceivingDfiIdentification, SimulationACHTransferReturnParamsReasonInvalidIndividualIDNumber, SimulationACHTransferReturnParamsReasonItemAndRckEntryPresentedForPayment, SimulationACHTransferReturnParamsReasonItemRelatedToRckEntryIsIneligible, SimulationACHTransferReturnParamsReasonMandatoryFieldError, SimulationACHTransferReturnParamsReasonMisroutedDishonoredReturn, SimulationACHTransferReturnParamsReasonMisroutedReturn, SimulationACHTransferReturnParamsReasonNoErrorsFound, SimulationACHTransferReturnParamsReasonNonAcceptanceOfR62DishonoredReturn, SimulationACHTransferReturnParamsReasonNonParticipantInIatProgram, SimulationACHTransferReturnParamsReasonPermissibleReturnEntry, SimulationACHTransferReturnParamsReasonPermissibleReturnEntryNotAccepted, SimulationACHTransferReturnParamsReasonRdfiNonSettlement, SimulationACHTransferReturnParamsReasonRdfiParticipantInCheckTruncationProgram, SimulationACHTransferReturnParamsReasonRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, SimulationACHTransferReturnParamsReasonReturnNotADuplicate, SimulationACHTransferReturnParamsReasonReturnOfErroneousOrReversingDebit, SimulationACHTransferReturnParamsReasonReturnOfImproperCreditEntry, SimulationACHTransferReturnParamsReasonReturnOfImproperDebitEntry, SimulationACHTransferReturnParamsReasonReturnOfXckEntry, SimulationACHTransferReturnParamsReasonSourceDocumentPresentedForPayment, SimulationACHTransferReturnParamsReasonStateLawAffectingRckAcceptance, SimulationACHTransferReturnParamsReasonStopPaymentOnItemRelatedToRckEntry, SimulationACHTransferReturnParamsReasonStopPaymentOnSourceDocument, SimulationACHTransferReturnParamsReasonTimelyOriginalReturn, SimulationACHTransferReturnParamsReasonTraceNumberError, SimulationACHTransferReturnParamsReasonUntimelyDishonoredReturn, SimulationACHTransferReturnParamsReasonUntimelyReturn:
+		return true
+	}
+	return false
+}
@@ -40,6 +40,7 @@ func NewSimulationCardService(opts ...option.RequestOption) (r *SimulationCardSe
 // ways purchases can be made.
 func (r *SimulationCardService) Authorize(ctx context.Context, body SimulationCardAuthorizeParams, opts ...option.RequestOption) (res *CardAuthorizationSimulation, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.Cards.Authorize")}, opts...)
 	path := "simulations/card_authorizations"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -52,6 +53,7 @@ func (r *SimulationCardService) Authorize(ctx context.Context, body SimulationCa
 // authorized, for example, when adding a tip to a restaurant bill.
 func (r *SimulationCardService) Settlement(ctx context.Context, body SimulationCardSettlementParams, opts ...option.RequestOption) (res *Transaction, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.Cards.Settlement")}, opts...)
 	path := "simulations/card_settlements"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -87,6 +89,14 @@ func (r *CardAuthorizationSimulation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the authorization attempt fails, this will contain the resulting
 // [Declined Transaction](#declined-transactions) object. The Declined
 // Transaction's `source` will be of `category: card_decline`.
@@ -145,6 +155,14 @@ func (r *CardAuthorizationSimulationDeclinedTransaction) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Declined
 // Transaction's currency. This will match the currency on the Declined
 // Transaction's Account.
@@ -165,6 +183,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionCurrencyUsd CardAuthorizationSimulationDeclinedTransactionCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionCurrencyCad, CardAuthorizationSimulationDeclinedTransactionCurrencyChf, CardAuthorizationSimulationDeclinedTransactionCurrencyEur, CardAuthorizationSimulationDeclinedTransactionCurrencyGbp, CardAuthorizationSimulationDeclinedTransactionCurrencyJpy, CardAuthorizationSimulationDeclinedTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Declined Transaction came through.
 type CardAuthorizationSimulationDeclinedTransactionRouteType string
 
@@ -175,6 +201,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionRouteTypeCard CardAuthorizationSimulationDeclinedTransactionRouteType = "card"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionRouteType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionRouteTypeAccountNumber, CardAuthorizationSimulationDeclinedTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Declined Transaction. For example, for a card transaction this lists the
 // merchant's industry and location. Note that for backwards compatibility reasons,
@@ -224,6 +258,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSource) UnmarshalJSON(dat
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `ach_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceACHDecline struct {
@@ -277,6 +319,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceACHDecline) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH transfer was declined.
 type CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReason string
 
@@ -313,6 +363,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonUserInitiated CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReason = "user_initiated"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReason) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonACHRouteCanceled, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonACHRouteDisabled, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonBreachesLimit, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonCreditEntryRefusedByReceiver, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonDuplicateReturn, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonEntityNotActive, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonGroupLocked, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonInsufficientFunds, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonMisroutedReturn, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonReturnOfErroneousOrReversingDebit, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonNoACHRoute, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonOriginatorRequest, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonTransactionNotAllowed, CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineReasonUserInitiated:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `ach_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineType string
@@ -321,6 +379,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineTypeACHDecline CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineType = "ach_decline"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceACHDeclineTypeACHDecline:
+		return true
+	}
+	return false
+}
+
 // A Card Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDecline struct {
@@ -401,6 +467,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDecline) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrency string
@@ -420,6 +494,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyUsd CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyCad, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyChf, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyEur, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyGbp, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyJpy, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -443,6 +525,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory string
 
@@ -451,6 +541,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory = "visa"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -477,6 +575,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -519,6 +625,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorRecurring, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorInstallment, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode string
@@ -546,6 +660,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeUnknown, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeManual, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactless, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -576,6 +698,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkI
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategory string
@@ -600,6 +730,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryRefund CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategory = "refund"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryAccountFunding, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryAutomaticFuelDispenser, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryBillPayment, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryPurchase, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryQuasiCash, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // Why the transaction was declined.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReason string
 
@@ -636,6 +774,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonSuspectedFraud CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReason = "suspected_fraud"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReason) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonCardNotActive, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonPhysicalCardNotActive, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonEntityNotActive, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonGroupLocked, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonInsufficientFunds, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonCvv2Mismatch, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonTransactionNotAllowed, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonBreachesLimit, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonWebhookDeclined, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonWebhookTimedOut, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonDeclinedByStandInProcessing, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonInvalidPhysicalCard, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonMissingOriginalAuthorization, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineReasonSuspectedFraud:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -661,6 +807,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerifica
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCode struct {
@@ -682,6 +836,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerifica
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult string
 
@@ -694,6 +856,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNotChecked, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultMatch, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddress struct {
@@ -728,6 +898,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerifica
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult string
 
@@ -746,6 +924,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult = "no_match"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNotChecked, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultMatch, CardAuthorizationSimulationDeclinedTransactionSourceCardDeclineVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type CardAuthorizationSimulationDeclinedTransactionSourceCategory string
@@ -769,6 +955,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCategoryOther CardAuthorizationSimulationDeclinedTransactionSourceCategory = "other"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCategoryACHDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryCardDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryCheckDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryInboundRealTimePaymentsTransferDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryInternationalACHDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryWireDecline, CardAuthorizationSimulationDeclinedTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `check_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceCheckDecline struct {
@@ -807,6 +1001,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceCheckDecline) Unmar
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCheckDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the check was declined.
 type CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReason string
 
@@ -838,6 +1040,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReason = "no_account_number_found"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReason) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonACHRouteDisabled, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonACHRouteCanceled, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonBreachesLimit, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonEntityNotActive, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonGroupLocked, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonInsufficientFunds, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonStopPaymentRequested, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonDuplicatePresentment, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonNotAuthorized, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonAmountMismatch, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonNotOurItem, CardAuthorizationSimulationDeclinedTransactionSourceCheckDeclineReasonNoAccountNumberFound:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Decline object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_decline`.
@@ -887,6 +1097,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaym
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the declined
 // transfer's currency. This will always be "USD" for a Real-Time Payments
 // transfer.
@@ -907,6 +1125,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyCad, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyChf, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyEur, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyGbp, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyJpy, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why the transfer was declined.
 type CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason string
 
@@ -925,6 +1151,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason = "real_time_payments_not_enabled"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReason) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberCanceled, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountNumberDisabled, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonAccountRestricted, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonGroupLocked, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonEntityNotActive, CardAuthorizationSimulationDeclinedTransactionSourceInboundRealTimePaymentsTransferDeclineReasonRealTimePaymentsNotEnabled:
+		return true
+	}
+	return false
+}
+
 // An International ACH Decline object. This field will be present in the JSON
 // response if and only if `category` is equal to `international_ach_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDecline struct {
@@ -1076,6 +1310,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDec
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator string
 
@@ -1091,6 +1333,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToVariable, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorVariableToFixed, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator string
@@ -1105,6 +1355,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeRate, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode string
 
@@ -1151,6 +1409,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAnnuity, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBusinessOrCommercial, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeDeposit, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeLoan, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMiscellaneous, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMortgage, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePension, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRemittance, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRentOrLease, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSalaryOrPayroll, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTax, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeAccountsReceivable, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeBackOfficeConversion, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeMachineTransfer, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfPurchase, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodePointOfSale, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeRepresentedCheck, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeSharedNetworkTransaction, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeTelphoneInitiated, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -1165,6 +1431,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -1179,6 +1453,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierBicCode, CardAuthorizationSimulationDeclinedTransactionSourceInternationalACHDeclineReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // A Wire Decline object. This field will be present in the JSON response if and
 // only if `category` is equal to `wire_decline`.
 type CardAuthorizationSimulationDeclinedTransactionSourceWireDecline struct {
@@ -1255,6 +1537,14 @@ func (r *CardAuthorizationSimulationDeclinedTransactionSourceWireDecline) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceWireDecline) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationDeclinedTransactionSourceWireDeclineJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the wire transfer was declined.
 type CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReason string
 
@@ -1273,6 +1563,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReason = "transaction_not_allowed"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReason) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonAccountNumberCanceled, CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonAccountNumberDisabled, CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonEntityNotActive, CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonGroupLocked, CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonNoAccountNumber, CardAuthorizationSimulationDeclinedTransactionSourceWireDeclineReasonTransactionNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `declined_transaction`.
 type CardAuthorizationSimulationDeclinedTransactionType string
@@ -1281,6 +1579,14 @@ const (
 	CardAuthorizationSimulationDeclinedTransactionTypeDeclinedTransaction CardAuthorizationSimulationDeclinedTransactionType = "declined_transaction"
 )
 
+func (r CardAuthorizationSimulationDeclinedTransactionType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationDeclinedTransactionTypeDeclinedTransaction:
+		return true
+	}
+	return false
+}
+
 // If the authorization attempt succeeds, this will contain the resulting Pending
 // Transaction object. The Pending Transaction's `source` will be of
 // `category: card_authorization`.
@@ -1347,6 +1653,14 @@ func (r *CardAuthorizationSimulationPendingTransaction) UnmarshalJSON(data []byt
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the Pending
 // Transaction's currency. This will match the currency on the Pending
 // Transaction's Account.
@@ -1367,6 +1681,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionCurrencyUsd CardAuthorizationSimulationPendingTransactionCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionCurrencyCad, CardAuthorizationSimulationPendingTransactionCurrencyChf, CardAuthorizationSimulationPendingTransactionCurrencyEur, CardAuthorizationSimulationPendingTransactionCurrencyGbp, CardAuthorizationSimulationPendingTransactionCurrencyJpy, CardAuthorizationSimulationPendingTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Pending Transaction came through.
 type CardAuthorizationSimulationPendingTransactionRouteType string
 
@@ -1377,6 +1699,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionRouteTypeCard CardAuthorizationSimulationPendingTransactionRouteType = "card"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionRouteType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionRouteTypeAccountNumber, CardAuthorizationSimulationPendingTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Pending Transaction. For example, for a card transaction this lists the
 // merchant's industry and location.
@@ -1432,6 +1762,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSource) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_instruction`.
 type CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstruction struct {
@@ -1461,6 +1799,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstr
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrency string
@@ -1480,6 +1826,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyUsd CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyCad, CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyChf, CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyEur, CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyGbp, CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyJpy, CardAuthorizationSimulationPendingTransactionSourceAccountTransferInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_instruction`.
 type CardAuthorizationSimulationPendingTransactionSourceACHTransferInstruction struct {
@@ -1505,6 +1859,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceACHTransferInstructi
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceACHTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceACHTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Authorization object. This field will be present in the JSON response if
 // and only if `category` is equal to `card_authorization`.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorization struct {
@@ -1594,6 +1956,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorization) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorization) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrency string
@@ -1613,6 +1983,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyUsd CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyCad, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyChf, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyEur, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyGbp, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyJpy, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The direction descibes the direction the funds will move, either from the
 // cardholder to the merchant or from the merchant to the cardholder.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirection string
@@ -1625,6 +2003,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirectionRefund CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirection = "refund"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirection) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirectionSettlement, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationDirectionRefund:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `network`.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetails struct {
 	// The payment network used to process this card authorization.
@@ -1648,6 +2034,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNet
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // The payment network used to process this card authorization.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsCategory string
 
@@ -1656,6 +2050,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsCategoryVisa CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsCategory = "visa"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsCategoryVisa:
+		return true
+	}
+	return false
+}
+
 // Fields specific to the `visa` network.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisa struct {
 	// For electronic commerce transactions, this identifies the level of security used
@@ -1682,6 +2084,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNet
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisa) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaJSON) RawJSON() string {
+	return r.raw
+}
+
 // For electronic commerce transactions, this identifies the level of security used
 // in obtaining the customer's payment credential. For mail or telephone order
 // transactions, identifies the type of mail or telephone order.
@@ -1724,6 +2134,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator = "non_secure_transaction"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicator) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorMailPhoneOrder, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorRecurring, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorInstallment, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorUnknownMailPhoneOrder, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorSecureElectronicCommerce, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransactionAt3DSCapableMerchant, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonAuthenticatedSecurityTransaction, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaElectronicCommerceIndicatorNonSecureTransaction:
+		return true
+	}
+	return false
+}
+
 // The method used to enter the cardholder's primary account number and card
 // expiration date.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode string
@@ -1751,6 +2169,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode = "integrated_circuit_card_no_cvv"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryMode) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeUnknown, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeManual, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripeNoCvv, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeOpticalCode, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCard, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactless, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeCredentialOnFile, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeMagneticStripe, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeContactlessMagneticStripe, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkDetailsVisaPointOfServiceEntryModeIntegratedCircuitCardNoCvv:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for a specific request or transaction.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkIdentifiers struct {
 	// A life-cycle identifier used across e.g., an authorization and a reversal.
@@ -1781,6 +2207,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNet
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // The processing category describes the intent behind the authorization, such as
 // whether it was used for bill payments or an automatic fuel dispenser.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategory string
@@ -1805,6 +2239,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryRefund CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategory = "refund"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryAccountFunding, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryAutomaticFuelDispenser, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryBillPayment, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryPurchase, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryQuasiCash, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationProcessingCategoryRefund:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_authorization`.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationType string
@@ -1813,6 +2255,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationTypeCardAuthorization CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationType = "card_authorization"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationTypeCardAuthorization:
+		return true
+	}
+	return false
+}
+
 // Fields related to verification of cardholder-provided values.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerification struct {
 	// Fields related to verification of the Card Verification Code, a 3-digit code on
@@ -1838,6 +2288,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVer
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerification) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields related to verification of the Card Verification Code, a 3-digit code on
 // the back of the card.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCode struct {
@@ -1859,6 +2317,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVer
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCode) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeJSON) RawJSON() string {
+	return r.raw
+}
+
 // The result of verifying the Card Verification Code.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult string
 
@@ -1871,6 +2337,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNoMatch CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult = "no_match"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResult) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNotChecked, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultMatch, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardVerificationCodeResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // Cardholder address provided in the authorization request and the address on file
 // we verified it against.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddress struct {
@@ -1905,6 +2379,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVer
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddress) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressJSON) RawJSON() string {
+	return r.raw
+}
+
 // The address verification result returned to the card network.
 type CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult string
 
@@ -1923,6 +2405,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNoMatch CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult = "no_match"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResult) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNotChecked, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNotChecked, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeMatchAddressNoMatch, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultPostalCodeNoMatchAddressMatch, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultMatch, CardAuthorizationSimulationPendingTransactionSourceCardAuthorizationVerificationCardholderAddressResultNoMatch:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type CardAuthorizationSimulationPendingTransactionSourceCategory string
@@ -1954,6 +2444,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCategoryOther CardAuthorizationSimulationPendingTransactionSourceCategory = "other"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCategoryAccountTransferInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryACHTransferInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryCardAuthorization, CardAuthorizationSimulationPendingTransactionSourceCategoryCheckDepositInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryCheckTransferInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryInboundFundsHold, CardAuthorizationSimulationPendingTransactionSourceCategoryRealTimePaymentsTransferInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryWireTransferInstruction, CardAuthorizationSimulationPendingTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_instruction`.
 type CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstruction struct {
@@ -1991,6 +2489,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstruct
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrency string
@@ -2010,6 +2516,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyUsd CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyCad, CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyChf, CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyEur, CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyGbp, CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyJpy, CardAuthorizationSimulationPendingTransactionSourceCheckDepositInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_instruction`.
 type CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstruction struct {
@@ -2039,6 +2553,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstruc
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrency string
@@ -2058,6 +2580,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyUsd CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyCad, CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyChf, CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyEur, CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyGbp, CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyJpy, CardAuthorizationSimulationPendingTransactionSourceCheckTransferInstructionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Funds Hold object. This field will be present in the JSON response if
 // and only if `category` is equal to `inbound_funds_hold`.
 type CardAuthorizationSimulationPendingTransactionSourceInboundFundsHold struct {
@@ -2111,6 +2641,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceInboundFundsHold) Un
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceInboundFundsHold) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the hold's
 // currency.
 type CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrency string
@@ -2130,6 +2668,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyUsd CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrency = "USD"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrency) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyCad, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyChf, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyEur, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyGbp, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyJpy, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The status of the hold.
 type CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatus string
 
@@ -2140,6 +2686,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatusComplete CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatus = "complete"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatus) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatusHeld, CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_funds_hold`.
 type CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldType string
@@ -2148,6 +2702,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldTypeInboundFundsHold CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldType = "inbound_funds_hold"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionSourceInboundFundsHoldTypeInboundFundsHold:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Instruction object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_instruction`.
@@ -2175,6 +2737,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceRealTimePaymentsTran
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceRealTimePaymentsTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceRealTimePaymentsTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Instruction object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_instruction`.
 type CardAuthorizationSimulationPendingTransactionSourceWireTransferInstruction struct {
@@ -2210,6 +2780,14 @@ func (r *CardAuthorizationSimulationPendingTransactionSourceWireTransferInstruct
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r CardAuthorizationSimulationPendingTransactionSourceWireTransferInstruction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r cardAuthorizationSimulationPendingTransactionSourceWireTransferInstructionJSON) RawJSON() string {
+	return r.raw
+}
+
 // Whether the Pending Transaction has been confirmed and has an associated
 // Transaction.
 type CardAuthorizationSimulationPendingTransactionStatus string
@@ -2223,6 +2801,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionStatusComplete CardAuthorizationSimulationPendingTransactionStatus = "complete"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionStatus) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionStatusPending, CardAuthorizationSimulationPendingTransactionStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `pending_transaction`.
 type CardAuthorizationSimulationPendingTransactionType string
@@ -2231,6 +2817,14 @@ const (
 	CardAuthorizationSimulationPendingTransactionTypePendingTransaction CardAuthorizationSimulationPendingTransactionType = "pending_transaction"
 )
 
+func (r CardAuthorizationSimulationPendingTransactionType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationPendingTransactionTypePendingTransaction:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_card_authorization_simulation_result`.
 type CardAuthorizationSimulationType string
@@ -2239,6 +2833,14 @@ const (
 	CardAuthorizationSimulationTypeInboundCardAuthorizationSimulationResult CardAuthorizationSimulationType = "inbound_card_authorization_simulation_result"
 )
 
+func (r CardAuthorizationSimulationType) IsKnown() bool {
+	switch r {
+	case CardAuthorizationSimulationTypeInboundCardAuthorizationSimulationResult:
+		return true
+	}
+	return false
+}
+
 type SimulationCardAuthorizeParams struct {
 	// The authorization amount in cents.
 	Amount param.Field[int64] `json:"amount,required"`
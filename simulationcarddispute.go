@@ -37,6 +37,7 @@ func NewSimulationCardDisputeService(opts ...option.RequestOption) (r *Simulatio
 // be actioned one time and must have a status of `pending_reviewing`.
 func (r *SimulationCardDisputeService) Action(ctx context.Context, cardDisputeID string, body SimulationCardDisputeActionParams, opts ...option.RequestOption) (res *CardDispute, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CardDisputes.Action")}, opts...)
 	path := fmt.Sprintf("simulations/card_disputes/%s/action", cardDisputeID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -62,3 +63,11 @@ const (
 	// The Card Dispute has been rejected.
 	SimulationCardDisputeActionParamsStatusRejected SimulationCardDisputeActionParamsStatus = "rejected"
 )
+
+func (r SimulationCardDisputeActionParamsStatus) IsKnown() bool {
+	switch r {
+	case SimulationCardDisputeActionParamsStatusAccepted, SimulationCardDisputeActionParamsStatusRejected:
+		return true
+	}
+	return false
+}
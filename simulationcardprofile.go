@@ -36,6 +36,7 @@ func NewSimulationCardProfileService(opts ...option.RequestOption) (r *Simulatio
 // status of the Card Profile.
 func (r *SimulationCardProfileService) Approve(ctx context.Context, cardProfileID string, opts ...option.RequestOption) (res *CardProfile, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CardProfiles.Approve")}, opts...)
 	path := fmt.Sprintf("simulations/card_profiles/%s/approve", cardProfileID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
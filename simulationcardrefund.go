@@ -34,6 +34,7 @@ func NewSimulationCardRefundService(opts ...option.RequestOption) (r *Simulation
 // transaction is refunded.
 func (r *SimulationCardRefundService) New(ctx context.Context, body SimulationCardRefundNewParams, opts ...option.RequestOption) (res *Transaction, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CardRefunds.New")}, opts...)
 	path := "simulations/card_refunds"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -34,6 +34,7 @@ func NewSimulationCheckDepositService(opts ...option.RequestOption) (r *Simulati
 // of `pending`.
 func (r *SimulationCheckDepositService) Reject(ctx context.Context, checkDepositID string, opts ...option.RequestOption) (res *CheckDeposit, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CheckDeposits.Reject")}, opts...)
 	path := fmt.Sprintf("simulations/check_deposits/%s/reject", checkDepositID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -43,6 +44,7 @@ func (r *SimulationCheckDepositService) Reject(ctx context.Context, checkDeposit
 // must first have a `status` of `submitted`.
 func (r *SimulationCheckDepositService) Return(ctx context.Context, checkDepositID string, opts ...option.RequestOption) (res *CheckDeposit, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CheckDeposits.Return")}, opts...)
 	path := fmt.Sprintf("simulations/check_deposits/%s/return", checkDepositID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -52,6 +54,7 @@ func (r *SimulationCheckDepositService) Return(ctx context.Context, checkDeposit
 // Reserve. This Check Deposit must first have a `status` of `pending`.
 func (r *SimulationCheckDepositService) Submit(ctx context.Context, checkDepositID string, opts ...option.RequestOption) (res *CheckDeposit, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CheckDeposits.Submit")}, opts...)
 	path := fmt.Sprintf("simulations/check_deposits/%s/submit", checkDepositID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -33,6 +33,7 @@ func NewSimulationCheckTransferService(opts ...option.RequestOption) (r *Simulat
 // transfer must first have a `status` of `mailed`.
 func (r *SimulationCheckTransferService) Deposit(ctx context.Context, checkTransferID string, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CheckTransfers.Deposit")}, opts...)
 	path := fmt.Sprintf("simulations/check_transfers/%s/deposit", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -43,6 +44,7 @@ func (r *SimulationCheckTransferService) Deposit(ctx context.Context, checkTrans
 // first have a `status` of `pending_approval` or `pending_submission`.
 func (r *SimulationCheckTransferService) Mail(ctx context.Context, checkTransferID string, opts ...option.RequestOption) (res *CheckTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.CheckTransfers.Mail")}, opts...)
 	path := fmt.Sprintf("simulations/check_transfers/%s/mail", checkTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
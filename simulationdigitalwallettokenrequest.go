@@ -35,6 +35,7 @@ func NewSimulationDigitalWalletTokenRequestService(opts ...option.RequestOption)
 // Apple Pay.
 func (r *SimulationDigitalWalletTokenRequestService) New(ctx context.Context, body SimulationDigitalWalletTokenRequestNewParams, opts ...option.RequestOption) (res *SimulationDigitalWalletTokenRequestNewResponse, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.DigitalWalletTokenRequests.New")}, opts...)
 	path := "simulations/digital_wallet_token_requests"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -68,6 +69,14 @@ func (r *SimulationDigitalWalletTokenRequestNewResponse) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r SimulationDigitalWalletTokenRequestNewResponse) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r simulationDigitalWalletTokenRequestNewResponseJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the simulated tokenization attempt was declined, this field contains details
 // as to why.
 type SimulationDigitalWalletTokenRequestNewResponseDeclineReason string
@@ -83,6 +92,14 @@ const (
 	SimulationDigitalWalletTokenRequestNewResponseDeclineReasonWebhookDeclined SimulationDigitalWalletTokenRequestNewResponseDeclineReason = "webhook_declined"
 )
 
+func (r SimulationDigitalWalletTokenRequestNewResponseDeclineReason) IsKnown() bool {
+	switch r {
+	case SimulationDigitalWalletTokenRequestNewResponseDeclineReasonCardNotActive, SimulationDigitalWalletTokenRequestNewResponseDeclineReasonNoVerificationMethod, SimulationDigitalWalletTokenRequestNewResponseDeclineReasonWebhookTimedOut, SimulationDigitalWalletTokenRequestNewResponseDeclineReasonWebhookDeclined:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_digital_wallet_token_request_simulation_result`.
 type SimulationDigitalWalletTokenRequestNewResponseType string
@@ -91,6 +108,14 @@ const (
 	SimulationDigitalWalletTokenRequestNewResponseTypeInboundDigitalWalletTokenRequestSimulationResult SimulationDigitalWalletTokenRequestNewResponseType = "inbound_digital_wallet_token_request_simulation_result"
 )
 
+func (r SimulationDigitalWalletTokenRequestNewResponseType) IsKnown() bool {
+	switch r {
+	case SimulationDigitalWalletTokenRequestNewResponseTypeInboundDigitalWalletTokenRequestSimulationResult:
+		return true
+	}
+	return false
+}
+
 type SimulationDigitalWalletTokenRequestNewParams struct {
 	// The identifier of the Card to be authorized.
 	CardID param.Field[string] `json:"card_id,required"`
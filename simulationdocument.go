@@ -33,6 +33,7 @@ func NewSimulationDocumentService(opts ...option.RequestOption) (r *SimulationDo
 // Simulates an tax document being created for an account.
 func (r *SimulationDocumentService) New(ctx context.Context, body SimulationDocumentNewParams, opts ...option.RequestOption) (res *Document, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.Documents.New")}, opts...)
 	path := "simulations/documents"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -35,6 +35,7 @@ func NewSimulationInboundFundsHoldService(opts ...option.RequestOption) (r *Simu
 // be created as a result of e.g., an ACH debit.
 func (r *SimulationInboundFundsHoldService) Release(ctx context.Context, inboundFundsHoldID string, opts ...option.RequestOption) (res *SimulationInboundFundsHoldReleaseResponse, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.InboundFundsHolds.Release")}, opts...)
 	path := fmt.Sprintf("simulations/inbound_funds_holds/%s/release", inboundFundsHoldID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -92,6 +93,14 @@ func (r *SimulationInboundFundsHoldReleaseResponse) UnmarshalJSON(data []byte) (
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r SimulationInboundFundsHoldReleaseResponse) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r simulationInboundFundsHoldReleaseResponseJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the hold's
 // currency.
 type SimulationInboundFundsHoldReleaseResponseCurrency string
@@ -111,6 +120,14 @@ const (
 	SimulationInboundFundsHoldReleaseResponseCurrencyUsd SimulationInboundFundsHoldReleaseResponseCurrency = "USD"
 )
 
+func (r SimulationInboundFundsHoldReleaseResponseCurrency) IsKnown() bool {
+	switch r {
+	case SimulationInboundFundsHoldReleaseResponseCurrencyCad, SimulationInboundFundsHoldReleaseResponseCurrencyChf, SimulationInboundFundsHoldReleaseResponseCurrencyEur, SimulationInboundFundsHoldReleaseResponseCurrencyGbp, SimulationInboundFundsHoldReleaseResponseCurrencyJpy, SimulationInboundFundsHoldReleaseResponseCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The status of the hold.
 type SimulationInboundFundsHoldReleaseResponseStatus string
 
@@ -121,6 +138,14 @@ const (
 	SimulationInboundFundsHoldReleaseResponseStatusComplete SimulationInboundFundsHoldReleaseResponseStatus = "complete"
 )
 
+func (r SimulationInboundFundsHoldReleaseResponseStatus) IsKnown() bool {
+	switch r {
+	case SimulationInboundFundsHoldReleaseResponseStatusHeld, SimulationInboundFundsHoldReleaseResponseStatusComplete:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_funds_hold`.
 type SimulationInboundFundsHoldReleaseResponseType string
@@ -128,3 +153,11 @@ type SimulationInboundFundsHoldReleaseResponseType string
 const (
 	SimulationInboundFundsHoldReleaseResponseTypeInboundFundsHold SimulationInboundFundsHoldReleaseResponseType = "inbound_funds_hold"
 )
+
+func (r SimulationInboundFundsHoldReleaseResponseType) IsKnown() bool {
+	switch r {
+	case SimulationInboundFundsHoldReleaseResponseTypeInboundFundsHold:
+		return true
+	}
+	return false
+}
@@ -35,6 +35,7 @@ func NewSimulationInboundWireDrawdownRequestService(opts ...option.RequestOption
 // [Inbound Wire Drawdown Request](#inbound-wire-drawdown-requests).
 func (r *SimulationInboundWireDrawdownRequestService) New(ctx context.Context, body SimulationInboundWireDrawdownRequestNewParams, opts ...option.RequestOption) (res *InboundWireDrawdownRequest, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.InboundWireDrawdownRequests.New")}, opts...)
 	path := "simulations/inbound_wire_drawdown_requests"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
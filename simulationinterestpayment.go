@@ -35,6 +35,7 @@ func NewSimulationInterestPaymentService(opts ...option.RequestOption) (r *Simul
 // automatically on the first of each month.
 func (r *SimulationInterestPaymentService) New(ctx context.Context, body SimulationInterestPaymentNewParams, opts ...option.RequestOption) (res *InterestPaymentSimulationResult, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.InterestPayments.New")}, opts...)
 	path := "simulations/interest_payment"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -64,6 +65,14 @@ func (r *InterestPaymentSimulationResult) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResult) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultJSON) RawJSON() string {
+	return r.raw
+}
+
 // This will contain the resulting [Transaction](#transactions) object. The
 // Transaction's `source` will be of `category: interest_payment`.
 type InterestPaymentSimulationResultTransaction struct {
@@ -122,6 +131,14 @@ func (r *InterestPaymentSimulationResultTransaction) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // Transaction's currency. This will match the currency on the Transaction's
 // Account.
@@ -142,6 +159,14 @@ const (
 	InterestPaymentSimulationResultTransactionCurrencyUsd InterestPaymentSimulationResultTransactionCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionCurrencyCad, InterestPaymentSimulationResultTransactionCurrencyChf, InterestPaymentSimulationResultTransactionCurrencyEur, InterestPaymentSimulationResultTransactionCurrencyGbp, InterestPaymentSimulationResultTransactionCurrencyJpy, InterestPaymentSimulationResultTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Transaction came through.
 type InterestPaymentSimulationResultTransactionRouteType string
 
@@ -152,6 +177,14 @@ const (
 	InterestPaymentSimulationResultTransactionRouteTypeCard InterestPaymentSimulationResultTransactionRouteType = "card"
 )
 
+func (r InterestPaymentSimulationResultTransactionRouteType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionRouteTypeAccountNumber, InterestPaymentSimulationResultTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Transaction. Note that for backwards compatibility reasons, additional
 // undocumented keys may appear in this object. These should be treated as
@@ -292,6 +325,14 @@ func (r *InterestPaymentSimulationResultTransactionSource) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_intention`.
 type InterestPaymentSimulationResultTransactionSourceAccountTransferIntention struct {
@@ -330,6 +371,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceAccountTransferIntentio
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceAccountTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceAccountTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrency string
@@ -349,6 +398,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyUsd InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyCad, InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyChf, InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyEur, InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyGbp, InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyJpy, InterestPaymentSimulationResultTransactionSourceAccountTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_intention`.
 type InterestPaymentSimulationResultTransactionSourceACHTransferIntention struct {
@@ -384,6 +441,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceACHTransferIntention) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceACHTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceACHTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_rejection`.
 type InterestPaymentSimulationResultTransactionSourceACHTransferRejection struct {
@@ -405,6 +470,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceACHTransferRejection) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceACHTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceACHTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `ach_transfer_return`.
 type InterestPaymentSimulationResultTransactionSourceACHTransferReturn struct {
@@ -440,6 +513,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceACHTransferReturn) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceACHTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceACHTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH Transfer was returned. This reason code is sent by the receiving
 // bank back to Increase.
 type InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCode string
@@ -632,6 +713,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCode = "untimely_return"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInsufficientFund, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeNoAccount, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAccountClosed, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInvalidAccountNumberStructure, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeCreditEntryRefusedByReceiver, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodePaymentStopped, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeNonTransactionAccount, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeUncollectedFunds, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeRoutingNumberCheckDigitError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAmountFieldError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAuthorizationRevokedByCustomer, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInvalidACHRoutingNumber, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeFileRecordEditCriteria, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualName, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnedPerOdfiRequest, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeLimitedParticipationDfi, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAccountSoldToAnotherDfi, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeAddendaError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeCorrectedReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeDuplicateEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeDuplicateReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrDuplicateEnrollment, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidDfiAccountNumber, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualIDNumber, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidTransactionCode, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrReturnOfEnrEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeEntryNotProcessedByGateway, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeFieldError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeIatEntryCodingError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeImproperEffectiveEntryDate, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInvalidCompanyID, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeInvalidIndividualIDNumber, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeMandatoryFieldError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeMisroutedDishonoredReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeMisroutedReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeNoErrorsFound, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeNonParticipantInIatProgram, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntryNotAccepted, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeRdfiNonSettlement, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnNotADuplicate, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperCreditEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperDebitEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeReturnOfXckEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeSourceDocumentPresentedForPayment, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeStateLawAffectingRckAcceptance, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnSourceDocument, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeTimelyOriginalReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeTraceNumberError, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeUntimelyDishonoredReturn, InterestPaymentSimulationResultTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // A Card Dispute Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `card_dispute_acceptance`.
 type InterestPaymentSimulationResultTransactionSourceCardDisputeAcceptance struct {
@@ -661,6 +750,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardDisputeAcceptance)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardDisputeAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardDisputeAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Refund object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_refund`.
 type InterestPaymentSimulationResultTransactionSourceCardRefund struct {
@@ -726,6 +823,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefund) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefund) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type InterestPaymentSimulationResultTransactionSourceCardRefundCurrency string
@@ -745,6 +850,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyUsd InterestPaymentSimulationResultTransactionSourceCardRefundCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyCad, InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyChf, InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyEur, InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCardRefundCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type InterestPaymentSimulationResultTransactionSourceCardRefundNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -773,6 +886,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundNetworkIdenti
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetails struct {
@@ -823,6 +944,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -896,6 +1025,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges string
 
@@ -914,6 +1051,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesNoExtraCharge, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesGas, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesExtraMileage, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesLateReturn, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator string
@@ -925,6 +1070,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -997,6 +1150,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges string
 
@@ -1017,6 +1178,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesNoExtraCharge, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesRestaurant, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesGiftShop, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesMiniBar, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesTelephone, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesOther, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator string
@@ -1028,6 +1197,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNotApplicable, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1044,6 +1221,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatFreeText, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatOrderNumber, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1097,6 +1282,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1131,6 +1324,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1145,6 +1346,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1167,6 +1376,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1221,6 +1438,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryNone, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBundledService, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCargo, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryLounge, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMedical, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryOther, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPets, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategorySeatFees, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStandby, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStore, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryTravelService, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1239,6 +1464,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorNoCredit, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorOther, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1249,6 +1482,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1261,6 +1502,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNone, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -1295,6 +1544,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetai
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -1307,6 +1564,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeNone, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, InterestPaymentSimulationResultTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_refund`.
 type InterestPaymentSimulationResultTransactionSourceCardRefundType string
@@ -1315,6 +1580,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRefundTypeCardRefund InterestPaymentSimulationResultTransactionSourceCardRefundType = "card_refund"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRefundType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRefundTypeCardRefund:
+		return true
+	}
+	return false
+}
+
 // A Card Revenue Payment object. This field will be present in the JSON response
 // if and only if `category` is equal to `card_revenue_payment`.
 type InterestPaymentSimulationResultTransactionSourceCardRevenuePayment struct {
@@ -1350,6 +1623,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardRevenuePayment) Unm
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRevenuePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardRevenuePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrency string
@@ -1369,6 +1650,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyUsd InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyCad, InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyChf, InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyEur, InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCardRevenuePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Card Settlement object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_settlement`.
 type InterestPaymentSimulationResultTransactionSourceCardSettlement struct {
@@ -1448,6 +1737,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlement) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's settlement currency.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementCurrency string
@@ -1467,6 +1764,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyUsd InterestPaymentSimulationResultTransactionSourceCardSettlementCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyCad, InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyChf, InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyEur, InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCardSettlementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -1495,6 +1800,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementNetworkId
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetails struct {
@@ -1545,6 +1858,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -1618,6 +1939,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges string
 
@@ -1636,6 +1965,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesNoExtraCharge, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesGas, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesExtraMileage, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesLateReturn, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator string
@@ -1647,6 +1984,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -1719,6 +2064,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges string
 
@@ -1739,6 +2092,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesNoExtraCharge, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesRestaurant, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesGiftShop, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesMiniBar, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesTelephone, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesOther, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator string
@@ -1750,6 +2111,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNotApplicable, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1766,6 +2135,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatFreeText, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatOrderNumber, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1819,6 +2196,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1853,6 +2238,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1867,6 +2260,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1889,6 +2290,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1943,6 +2352,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryNone, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBundledService, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCargo, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryLounge, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMedical, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryOther, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPets, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategorySeatFees, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStandby, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStore, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryTravelService, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1961,6 +2378,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorNoCredit, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorOther, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1971,6 +2396,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1983,6 +2416,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNone, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -2017,6 +2458,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseD
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -2029,6 +2478,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeNone, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, InterestPaymentSimulationResultTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_settlement`.
 type InterestPaymentSimulationResultTransactionSourceCardSettlementType string
@@ -2037,6 +2494,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCardSettlementTypeCardSettlement InterestPaymentSimulationResultTransactionSourceCardSettlementType = "card_settlement"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCardSettlementType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCardSettlementTypeCardSettlement:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type InterestPaymentSimulationResultTransactionSourceCategory string
@@ -2121,6 +2586,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCategoryOther InterestPaymentSimulationResultTransactionSourceCategory = "other"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCategoryAccountTransferIntention, InterestPaymentSimulationResultTransactionSourceCategoryACHTransferIntention, InterestPaymentSimulationResultTransactionSourceCategoryACHTransferRejection, InterestPaymentSimulationResultTransactionSourceCategoryACHTransferReturn, InterestPaymentSimulationResultTransactionSourceCategoryCardDisputeAcceptance, InterestPaymentSimulationResultTransactionSourceCategoryCardRefund, InterestPaymentSimulationResultTransactionSourceCategoryCardSettlement, InterestPaymentSimulationResultTransactionSourceCategoryCardRevenuePayment, InterestPaymentSimulationResultTransactionSourceCategoryCheckDepositAcceptance, InterestPaymentSimulationResultTransactionSourceCategoryCheckDepositReturn, InterestPaymentSimulationResultTransactionSourceCategoryCheckTransferDeposit, InterestPaymentSimulationResultTransactionSourceCategoryCheckTransferIntention, InterestPaymentSimulationResultTransactionSourceCategoryCheckTransferStopPaymentRequest, InterestPaymentSimulationResultTransactionSourceCategoryFeePayment, InterestPaymentSimulationResultTransactionSourceCategoryInboundACHTransfer, InterestPaymentSimulationResultTransactionSourceCategoryInboundACHTransferReturnIntention, InterestPaymentSimulationResultTransactionSourceCategoryInboundCheck, InterestPaymentSimulationResultTransactionSourceCategoryInboundInternationalACHTransfer, InterestPaymentSimulationResultTransactionSourceCategoryInboundRealTimePaymentsTransferConfirmation, InterestPaymentSimulationResultTransactionSourceCategoryInboundWireDrawdownPaymentReversal, InterestPaymentSimulationResultTransactionSourceCategoryInboundWireDrawdownPayment, InterestPaymentSimulationResultTransactionSourceCategoryInboundWireReversal, InterestPaymentSimulationResultTransactionSourceCategoryInboundWireTransfer, InterestPaymentSimulationResultTransactionSourceCategoryInterestPayment, InterestPaymentSimulationResultTransactionSourceCategoryInternalSource, InterestPaymentSimulationResultTransactionSourceCategoryRealTimePaymentsTransferAcknowledgement, InterestPaymentSimulationResultTransactionSourceCategorySampleFunds, InterestPaymentSimulationResultTransactionSourceCategoryWireTransferIntention, InterestPaymentSimulationResultTransactionSourceCategoryWireTransferRejection, InterestPaymentSimulationResultTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_acceptance`.
 type InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptance struct {
@@ -2164,6 +2637,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptance)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrency string
@@ -2183,6 +2664,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyUsd InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyCad, InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyChf, InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyEur, InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCheckDepositAcceptanceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_deposit_return`.
 type InterestPaymentSimulationResultTransactionSourceCheckDepositReturn struct {
@@ -2224,6 +2713,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCheckDepositReturn) Unm
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckDepositReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCheckDepositReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrency string
@@ -2243,6 +2740,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyUsd InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyCad, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyChf, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyEur, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why this check was returned by the bank holding the account it was drawn
 // against.
 type InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReason string
@@ -2274,6 +2779,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReason = "endorsement_irregular"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReason) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonACHConversionNotSupported, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonClosedAccount, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonDuplicateSubmission, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonInsufficientFunds, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonNoAccount, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonNotAuthorized, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonStaleDated, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonStopPayment, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonUnknownReason, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonUnmatchedDetails, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonUnreadableImage, InterestPaymentSimulationResultTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Deposit object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_transfer_deposit`.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferDeposit struct {
@@ -2318,6 +2831,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCheckTransferDeposit) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCheckTransferDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_deposit`.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferDepositType string
@@ -2326,6 +2847,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckTransferDepositTypeCheckTransferDeposit InterestPaymentSimulationResultTransactionSourceCheckTransferDepositType = "check_transfer_deposit"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferDepositType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckTransferDepositTypeCheckTransferDeposit:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_intention`.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferIntention struct {
@@ -2372,6 +2901,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCheckTransferIntention)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCheckTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrency string
@@ -2391,6 +2928,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyUsd InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyCad, InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyChf, InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyEur, InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyGbp, InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyJpy, InterestPaymentSimulationResultTransactionSourceCheckTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Stop Payment Request object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `check_transfer_stop_payment_request`.
@@ -2423,6 +2968,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymen
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason why this transfer was stopped.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReason string
 
@@ -2436,6 +2989,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReasonUnknown InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReason = "unknown"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReason) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReasonMailDeliveryFailed, InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReasonRejectedByIncrease, InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_stop_payment_request`.
 type InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestType string
@@ -2444,6 +3005,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestType = "check_transfer_stop_payment_request"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest:
+		return true
+	}
+	return false
+}
+
 // A Fee Payment object. This field will be present in the JSON response if and
 // only if `category` is equal to `fee_payment`.
 type InterestPaymentSimulationResultTransactionSourceFeePayment struct {
@@ -2473,6 +3042,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceFeePayment) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceFeePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceFeePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type InterestPaymentSimulationResultTransactionSourceFeePaymentCurrency string
@@ -2492,6 +3069,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyUsd InterestPaymentSimulationResultTransactionSourceFeePaymentCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceFeePaymentCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyCad, InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyChf, InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyEur, InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyGbp, InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyJpy, InterestPaymentSimulationResultTransactionSourceFeePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_ach_transfer`.
 type InterestPaymentSimulationResultTransactionSourceInboundACHTransfer struct {
@@ -2547,6 +3132,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundACHTransfer) Unm
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Check object. This field will be present in the JSON response if and
 // only if `category` is equal to `inbound_check`.
 type InterestPaymentSimulationResultTransactionSourceInboundCheck struct {
@@ -2588,6 +3181,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundCheck) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundCheck) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundCheckJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type InterestPaymentSimulationResultTransactionSourceInboundCheckCurrency string
@@ -2607,6 +3208,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyUsd InterestPaymentSimulationResultTransactionSourceInboundCheckCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundCheckCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyCad, InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyChf, InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyEur, InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyGbp, InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyJpy, InterestPaymentSimulationResultTransactionSourceInboundCheckCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound International ACH Transfer object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `inbound_international_ach_transfer`.
@@ -2759,6 +3368,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundInternationalACH
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator string
 
@@ -2774,6 +3391,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToVariable, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorVariableToFixed, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator string
@@ -2788,6 +3413,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeRate, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode string
 
@@ -2834,6 +3467,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAnnuity, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBusinessOrCommercial, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeDeposit, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeLoan, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMiscellaneous, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMortgage, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePension, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRemittance, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRentOrLease, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSalaryOrPayroll, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTax, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAccountsReceivable, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBackOfficeConversion, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMachineTransfer, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfPurchase, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfSale, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRepresentedCheck, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSharedNetworkTransaction, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTelphoneInitiated, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -2848,6 +3489,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -2862,6 +3511,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierBicCode, InterestPaymentSimulationResultTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Confirmation object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_confirmation`.
@@ -2907,6 +3564,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundRealTimePayments
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the transfer's
 // currency. This will always be "USD" for a Real-Time Payments transfer.
 type InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency string
@@ -2926,6 +3591,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyCad, InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyChf, InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyEur, InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyGbp, InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyJpy, InterestPaymentSimulationResultTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Wire Drawdown Payment object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_wire_drawdown_payment`.
 type InterestPaymentSimulationResultTransactionSourceInboundWireDrawdownPayment struct {
@@ -3002,6 +3675,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundWireDrawdownPaym
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundWireDrawdownPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundWireDrawdownPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Drawdown Payment Reversal object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `inbound_wire_drawdown_payment_reversal`.
@@ -3055,6 +3736,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundWireDrawdownPaym
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundWireDrawdownPaymentReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundWireDrawdownPaymentReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Reversal object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_reversal`.
 type InterestPaymentSimulationResultTransactionSourceInboundWireReversal struct {
@@ -3126,6 +3815,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundWireReversal) Un
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundWireReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundWireReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Transfer object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_transfer`.
 type InterestPaymentSimulationResultTransactionSourceInboundWireTransfer struct {
@@ -3201,6 +3898,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInboundWireTransfer) Un
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInboundWireTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInboundWireTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Interest Payment object. This field will be present in the JSON response if
 // and only if `category` is equal to `interest_payment`.
 type InterestPaymentSimulationResultTransactionSourceInterestPayment struct {
@@ -3236,6 +3941,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInterestPayment) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInterestPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInterestPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrency string
@@ -3255,6 +3968,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyUsd InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyCad, InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyChf, InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyEur, InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyGbp, InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyJpy, InterestPaymentSimulationResultTransactionSourceInterestPaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source object. This field will be present in the JSON response if
 // and only if `category` is equal to `internal_source`.
 type InterestPaymentSimulationResultTransactionSourceInternalSource struct {
@@ -3285,6 +4006,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceInternalSource) Unmarsh
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceInternalSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceInternalSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type InterestPaymentSimulationResultTransactionSourceInternalSourceCurrency string
@@ -3304,6 +4033,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyUsd InterestPaymentSimulationResultTransactionSourceInternalSourceCurrency = "USD"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInternalSourceCurrency) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyCad, InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyChf, InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyEur, InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyGbp, InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyJpy, InterestPaymentSimulationResultTransactionSourceInternalSourceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source is a transaction between you and Increase. This describes the
 // reason for the transaction.
 type InterestPaymentSimulationResultTransactionSourceInternalSourceReason string
@@ -3337,6 +4074,14 @@ const (
 	InterestPaymentSimulationResultTransactionSourceInternalSourceReasonSampleFundsReturn InterestPaymentSimulationResultTransactionSourceInternalSourceReason = "sample_funds_return"
 )
 
+func (r InterestPaymentSimulationResultTransactionSourceInternalSourceReason) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionSourceInternalSourceReasonAccountClosure, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonBankMigration, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonCashback, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonCheckAdjustment, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonCollectionReceivable, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonEmpyrealAdjustment, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonError, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonErrorCorrection, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonFees, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonInterest, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonNegativeBalanceForgiveness, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonSampleFunds, InterestPaymentSimulationResultTransactionSourceInternalSourceReasonSampleFundsReturn:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Acknowledgement object. This field will be present
 // in the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_acknowledgement`.
@@ -3371,6 +4116,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceRealTimePaymentsTransfe
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceRealTimePaymentsTransferAcknowledgement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceRealTimePaymentsTransferAcknowledgementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Sample Funds object. This field will be present in the JSON response if and
 // only if `category` is equal to `sample_funds`.
 type InterestPaymentSimulationResultTransactionSourceSampleFunds struct {
@@ -3392,6 +4145,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceSampleFunds) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceSampleFunds) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceSampleFundsJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_intention`.
 type InterestPaymentSimulationResultTransactionSourceWireTransferIntention struct {
@@ -3425,6 +4186,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceWireTransferIntention)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceWireTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceWireTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_rejection`.
 type InterestPaymentSimulationResultTransactionSourceWireTransferRejection struct {
@@ -3446,6 +4215,14 @@ func (r *InterestPaymentSimulationResultTransactionSourceWireTransferRejection)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r InterestPaymentSimulationResultTransactionSourceWireTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r interestPaymentSimulationResultTransactionSourceWireTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `transaction`.
 type InterestPaymentSimulationResultTransactionType string
@@ -3454,6 +4231,14 @@ const (
 	InterestPaymentSimulationResultTransactionTypeTransaction InterestPaymentSimulationResultTransactionType = "transaction"
 )
 
+func (r InterestPaymentSimulationResultTransactionType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTransactionTypeTransaction:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `interest_payment_simulation_result`.
 type InterestPaymentSimulationResultType string
@@ -3462,6 +4247,14 @@ const (
 	InterestPaymentSimulationResultTypeInterestPaymentSimulationResult InterestPaymentSimulationResultType = "interest_payment_simulation_result"
 )
 
+func (r InterestPaymentSimulationResultType) IsKnown() bool {
+	switch r {
+	case InterestPaymentSimulationResultTypeInterestPaymentSimulationResult:
+		return true
+	}
+	return false
+}
+
 type SimulationInterestPaymentNewParams struct {
 	// The identifier of the Account Number the Interest Payment is for.
 	AccountID param.Field[string] `json:"account_id,required"`
@@ -36,6 +36,7 @@ func NewSimulationPhysicalCardService(opts ...option.RequestOption) (r *Simulati
 // failed delivery.
 func (r *SimulationPhysicalCardService) ShipmentAdvance(ctx context.Context, physicalCardID string, body SimulationPhysicalCardShipmentAdvanceParams, opts ...option.RequestOption) (res *PhysicalCard, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.PhysicalCards.ShipmentAdvance")}, opts...)
 	path := fmt.Sprintf("simulations/physical_cards/%s/shipment_advance", physicalCardID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -71,3 +72,11 @@ const (
 	// production facility.
 	SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusReturned SimulationPhysicalCardShipmentAdvanceParamsShipmentStatus = "returned"
 )
+
+func (r SimulationPhysicalCardShipmentAdvanceParamsShipmentStatus) IsKnown() bool {
+	switch r {
+	case SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusPending, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusCanceled, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusSubmitted, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusAcknowledged, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusRejected, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusShipped, SimulationPhysicalCardShipmentAdvanceParamsShipmentStatusReturned:
+		return true
+	}
+	return false
+}
@@ -35,6 +35,7 @@ func NewSimulationProgramService(opts ...option.RequestOption) (r *SimulationPro
 // one program, `program_id` is a required field when creating accounts.
 func (r *SimulationProgramService) New(ctx context.Context, body SimulationProgramNewParams, opts ...option.RequestOption) (res *Program, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.Programs.New")}, opts...)
 	path := "simulations/programs"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -34,6 +34,7 @@ func NewSimulationWireTransferService(opts ...option.RequestOption) (r *Simulati
 // Simulates an inbound Wire Transfer to your account.
 func (r *SimulationWireTransferService) NewInbound(ctx context.Context, body SimulationWireTransferNewInboundParams, opts ...option.RequestOption) (res *WireTransferSimulation, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Simulations.WireTransfers.NewInbound")}, opts...)
 	path := "simulations/inbound_wire_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -64,6 +65,14 @@ func (r *WireTransferSimulation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationJSON) RawJSON() string {
+	return r.raw
+}
+
 // If the Wire Transfer attempt succeeds, this will contain the resulting
 // [Transaction](#transactions) object. The Transaction's `source` will be of
 // `category: inbound_wire_transfer`.
@@ -123,6 +132,14 @@ func (r *WireTransferSimulationTransaction) UnmarshalJSON(data []byte) (err erro
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // Transaction's currency. This will match the currency on the Transaction's
 // Account.
@@ -143,6 +160,14 @@ const (
 	WireTransferSimulationTransactionCurrencyUsd WireTransferSimulationTransactionCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionCurrencyCad, WireTransferSimulationTransactionCurrencyChf, WireTransferSimulationTransactionCurrencyEur, WireTransferSimulationTransactionCurrencyGbp, WireTransferSimulationTransactionCurrencyJpy, WireTransferSimulationTransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Transaction came through.
 type WireTransferSimulationTransactionRouteType string
 
@@ -153,6 +178,14 @@ const (
 	WireTransferSimulationTransactionRouteTypeCard WireTransferSimulationTransactionRouteType = "card"
 )
 
+func (r WireTransferSimulationTransactionRouteType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionRouteTypeAccountNumber, WireTransferSimulationTransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Transaction. Note that for backwards compatibility reasons, additional
 // undocumented keys may appear in this object. These should be treated as
@@ -293,6 +326,14 @@ func (r *WireTransferSimulationTransactionSource) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_intention`.
 type WireTransferSimulationTransactionSourceAccountTransferIntention struct {
@@ -331,6 +372,14 @@ func (r *WireTransferSimulationTransactionSourceAccountTransferIntention) Unmars
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceAccountTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceAccountTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type WireTransferSimulationTransactionSourceAccountTransferIntentionCurrency string
@@ -350,6 +399,14 @@ const (
 	WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyUsd WireTransferSimulationTransactionSourceAccountTransferIntentionCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceAccountTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyCad, WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyChf, WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyEur, WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyGbp, WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyJpy, WireTransferSimulationTransactionSourceAccountTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_intention`.
 type WireTransferSimulationTransactionSourceACHTransferIntention struct {
@@ -385,6 +442,14 @@ func (r *WireTransferSimulationTransactionSourceACHTransferIntention) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceACHTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceACHTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_rejection`.
 type WireTransferSimulationTransactionSourceACHTransferRejection struct {
@@ -406,6 +471,14 @@ func (r *WireTransferSimulationTransactionSourceACHTransferRejection) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceACHTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceACHTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `ach_transfer_return`.
 type WireTransferSimulationTransactionSourceACHTransferReturn struct {
@@ -441,6 +514,14 @@ func (r *WireTransferSimulationTransactionSourceACHTransferReturn) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceACHTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceACHTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH Transfer was returned. This reason code is sent by the receiving
 // bank back to Increase.
 type WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode string
@@ -633,6 +714,14 @@ const (
 	WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode = "untimely_return"
 )
 
+func (r WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInsufficientFund, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNoAccount, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountClosed, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidAccountNumberStructure, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCreditEntryRefusedByReceiver, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePaymentStopped, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonTransactionAccount, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUncollectedFunds, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRoutingNumberCheckDigitError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAmountFieldError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAuthorizationRevokedByCustomer, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidACHRoutingNumber, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeFileRecordEditCriteria, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualName, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnedPerOdfiRequest, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeLimitedParticipationDfi, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAccountSoldToAnotherDfi, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeAddendaError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeCorrectedReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeDuplicateEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeDuplicateReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrDuplicateEnrollment, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidDfiAccountNumber, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualIDNumber, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidTransactionCode, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrReturnOfEnrEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeEntryNotProcessedByGateway, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeFieldError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeIatEntryCodingError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeImproperEffectiveEntryDate, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidCompanyID, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeInvalidIndividualIDNumber, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMandatoryFieldError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMisroutedDishonoredReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeMisroutedReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNoErrorsFound, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeNonParticipantInIatProgram, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntryNotAccepted, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRdfiNonSettlement, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnNotADuplicate, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperCreditEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperDebitEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeReturnOfXckEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeSourceDocumentPresentedForPayment, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStateLawAffectingRckAcceptance, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnSourceDocument, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeTimelyOriginalReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeTraceNumberError, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyDishonoredReturn, WireTransferSimulationTransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // A Card Dispute Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `card_dispute_acceptance`.
 type WireTransferSimulationTransactionSourceCardDisputeAcceptance struct {
@@ -662,6 +751,14 @@ func (r *WireTransferSimulationTransactionSourceCardDisputeAcceptance) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardDisputeAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardDisputeAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Refund object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_refund`.
 type WireTransferSimulationTransactionSourceCardRefund struct {
@@ -726,6 +823,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefund) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefund) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type WireTransferSimulationTransactionSourceCardRefundCurrency string
@@ -745,6 +850,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundCurrencyUsd WireTransferSimulationTransactionSourceCardRefundCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundCurrencyCad, WireTransferSimulationTransactionSourceCardRefundCurrencyChf, WireTransferSimulationTransactionSourceCardRefundCurrencyEur, WireTransferSimulationTransactionSourceCardRefundCurrencyGbp, WireTransferSimulationTransactionSourceCardRefundCurrencyJpy, WireTransferSimulationTransactionSourceCardRefundCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type WireTransferSimulationTransactionSourceCardRefundNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -773,6 +886,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundNetworkIdentifiers) Un
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetails struct {
@@ -823,6 +944,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetails) Unmar
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -896,6 +1025,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRent
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges string
 
@@ -914,6 +1051,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesNoExtraCharge, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesGas, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesExtraMileage, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesLateReturn, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator string
@@ -925,6 +1070,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -997,6 +1150,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges string
 
@@ -1017,6 +1178,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesNoExtraCharge, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesRestaurant, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesGiftShop, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesMiniBar, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesTelephone, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesOther, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator string
@@ -1028,6 +1197,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNotApplicable, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1044,6 +1221,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatFreeText, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatOrderNumber, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1097,6 +1282,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1131,6 +1324,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelA
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1145,6 +1346,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1167,6 +1376,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelA
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1221,6 +1438,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryNone, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBundledService, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCargo, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryLounge, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMedical, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryOther, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPets, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategorySeatFees, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStandby, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStore, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryTravelService, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1239,6 +1464,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorNoCredit, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorOther, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1249,6 +1482,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1261,6 +1502,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNone, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -1295,6 +1544,14 @@ func (r *WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelT
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -1307,6 +1564,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeNone, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, WireTransferSimulationTransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_refund`.
 type WireTransferSimulationTransactionSourceCardRefundType string
@@ -1315,6 +1580,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRefundTypeCardRefund WireTransferSimulationTransactionSourceCardRefundType = "card_refund"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRefundType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRefundTypeCardRefund:
+		return true
+	}
+	return false
+}
+
 // A Card Revenue Payment object. This field will be present in the JSON response
 // if and only if `category` is equal to `card_revenue_payment`.
 type WireTransferSimulationTransactionSourceCardRevenuePayment struct {
@@ -1350,6 +1623,14 @@ func (r *WireTransferSimulationTransactionSourceCardRevenuePayment) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardRevenuePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardRevenuePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type WireTransferSimulationTransactionSourceCardRevenuePaymentCurrency string
@@ -1369,6 +1650,14 @@ const (
 	WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyUsd WireTransferSimulationTransactionSourceCardRevenuePaymentCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCardRevenuePaymentCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyCad, WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyChf, WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyEur, WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyGbp, WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyJpy, WireTransferSimulationTransactionSourceCardRevenuePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Card Settlement object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_settlement`.
 type WireTransferSimulationTransactionSourceCardSettlement struct {
@@ -1447,6 +1736,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlement) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's settlement currency.
 type WireTransferSimulationTransactionSourceCardSettlementCurrency string
@@ -1466,6 +1763,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementCurrencyUsd WireTransferSimulationTransactionSourceCardSettlementCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementCurrencyCad, WireTransferSimulationTransactionSourceCardSettlementCurrencyChf, WireTransferSimulationTransactionSourceCardSettlementCurrencyEur, WireTransferSimulationTransactionSourceCardSettlementCurrencyGbp, WireTransferSimulationTransactionSourceCardSettlementCurrencyJpy, WireTransferSimulationTransactionSourceCardSettlementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type WireTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -1494,6 +1799,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetails struct {
@@ -1544,6 +1857,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetails) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -1617,6 +1938,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCar
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges string
 
@@ -1635,6 +1964,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesNoExtraCharge, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesGas, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesExtraMileage, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesLateReturn, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator string
@@ -1646,6 +1983,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -1718,6 +2063,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLod
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges string
 
@@ -1738,6 +2091,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesNoExtraCharge, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesRestaurant, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesGiftShop, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesMiniBar, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesTelephone, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesOther, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator string
@@ -1749,6 +2110,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNotApplicable, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1765,6 +2134,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatFreeText, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatOrderNumber, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1818,6 +2195,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTra
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1852,6 +2237,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTra
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1866,6 +2259,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1888,6 +2289,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTra
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1942,6 +2351,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryNone, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBundledService, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCargo, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryLounge, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMedical, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryOther, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPets, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategorySeatFees, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStandby, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStore, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryTravelService, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1960,6 +2377,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorNoCredit, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorOther, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1970,6 +2395,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1982,6 +2415,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNone, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -2016,6 +2457,14 @@ func (r *WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTra
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -2028,6 +2477,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeNone, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, WireTransferSimulationTransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_settlement`.
 type WireTransferSimulationTransactionSourceCardSettlementType string
@@ -2036,6 +2493,14 @@ const (
 	WireTransferSimulationTransactionSourceCardSettlementTypeCardSettlement WireTransferSimulationTransactionSourceCardSettlementType = "card_settlement"
 )
 
+func (r WireTransferSimulationTransactionSourceCardSettlementType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCardSettlementTypeCardSettlement:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type WireTransferSimulationTransactionSourceCategory string
@@ -2120,6 +2585,14 @@ const (
 	WireTransferSimulationTransactionSourceCategoryOther WireTransferSimulationTransactionSourceCategory = "other"
 )
 
+func (r WireTransferSimulationTransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCategoryAccountTransferIntention, WireTransferSimulationTransactionSourceCategoryACHTransferIntention, WireTransferSimulationTransactionSourceCategoryACHTransferRejection, WireTransferSimulationTransactionSourceCategoryACHTransferReturn, WireTransferSimulationTransactionSourceCategoryCardDisputeAcceptance, WireTransferSimulationTransactionSourceCategoryCardRefund, WireTransferSimulationTransactionSourceCategoryCardSettlement, WireTransferSimulationTransactionSourceCategoryCardRevenuePayment, WireTransferSimulationTransactionSourceCategoryCheckDepositAcceptance, WireTransferSimulationTransactionSourceCategoryCheckDepositReturn, WireTransferSimulationTransactionSourceCategoryCheckTransferDeposit, WireTransferSimulationTransactionSourceCategoryCheckTransferIntention, WireTransferSimulationTransactionSourceCategoryCheckTransferStopPaymentRequest, WireTransferSimulationTransactionSourceCategoryFeePayment, WireTransferSimulationTransactionSourceCategoryInboundACHTransfer, WireTransferSimulationTransactionSourceCategoryInboundACHTransferReturnIntention, WireTransferSimulationTransactionSourceCategoryInboundCheck, WireTransferSimulationTransactionSourceCategoryInboundInternationalACHTransfer, WireTransferSimulationTransactionSourceCategoryInboundRealTimePaymentsTransferConfirmation, WireTransferSimulationTransactionSourceCategoryInboundWireDrawdownPaymentReversal, WireTransferSimulationTransactionSourceCategoryInboundWireDrawdownPayment, WireTransferSimulationTransactionSourceCategoryInboundWireReversal, WireTransferSimulationTransactionSourceCategoryInboundWireTransfer, WireTransferSimulationTransactionSourceCategoryInterestPayment, WireTransferSimulationTransactionSourceCategoryInternalSource, WireTransferSimulationTransactionSourceCategoryRealTimePaymentsTransferAcknowledgement, WireTransferSimulationTransactionSourceCategorySampleFunds, WireTransferSimulationTransactionSourceCategoryWireTransferIntention, WireTransferSimulationTransactionSourceCategoryWireTransferRejection, WireTransferSimulationTransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_acceptance`.
 type WireTransferSimulationTransactionSourceCheckDepositAcceptance struct {
@@ -2163,6 +2636,14 @@ func (r *WireTransferSimulationTransactionSourceCheckDepositAcceptance) Unmarsha
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCheckDepositAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCheckDepositAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency string
@@ -2182,6 +2663,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyUsd WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyCad, WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyChf, WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyEur, WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyGbp, WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyJpy, WireTransferSimulationTransactionSourceCheckDepositAcceptanceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_deposit_return`.
 type WireTransferSimulationTransactionSourceCheckDepositReturn struct {
@@ -2223,6 +2712,14 @@ func (r *WireTransferSimulationTransactionSourceCheckDepositReturn) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCheckDepositReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCheckDepositReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type WireTransferSimulationTransactionSourceCheckDepositReturnCurrency string
@@ -2242,6 +2739,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyUsd WireTransferSimulationTransactionSourceCheckDepositReturnCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckDepositReturnCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyCad, WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyChf, WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyEur, WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyGbp, WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyJpy, WireTransferSimulationTransactionSourceCheckDepositReturnCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why this check was returned by the bank holding the account it was drawn
 // against.
 type WireTransferSimulationTransactionSourceCheckDepositReturnReturnReason string
@@ -2273,6 +2778,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular WireTransferSimulationTransactionSourceCheckDepositReturnReturnReason = "endorsement_irregular"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckDepositReturnReturnReason) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonACHConversionNotSupported, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonClosedAccount, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonDuplicateSubmission, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonInsufficientFunds, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonNoAccount, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonNotAuthorized, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonStaleDated, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonStopPayment, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnknownReason, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnmatchedDetails, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonUnreadableImage, WireTransferSimulationTransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Deposit object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_transfer_deposit`.
 type WireTransferSimulationTransactionSourceCheckTransferDeposit struct {
@@ -2317,6 +2830,14 @@ func (r *WireTransferSimulationTransactionSourceCheckTransferDeposit) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCheckTransferDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCheckTransferDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_deposit`.
 type WireTransferSimulationTransactionSourceCheckTransferDepositType string
@@ -2325,6 +2846,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckTransferDepositTypeCheckTransferDeposit WireTransferSimulationTransactionSourceCheckTransferDepositType = "check_transfer_deposit"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckTransferDepositType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckTransferDepositTypeCheckTransferDeposit:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_intention`.
 type WireTransferSimulationTransactionSourceCheckTransferIntention struct {
@@ -2371,6 +2900,14 @@ func (r *WireTransferSimulationTransactionSourceCheckTransferIntention) Unmarsha
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCheckTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCheckTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type WireTransferSimulationTransactionSourceCheckTransferIntentionCurrency string
@@ -2390,6 +2927,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyUsd WireTransferSimulationTransactionSourceCheckTransferIntentionCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyCad, WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyChf, WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyEur, WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyGbp, WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyJpy, WireTransferSimulationTransactionSourceCheckTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Stop Payment Request object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `check_transfer_stop_payment_request`.
@@ -2422,6 +2967,14 @@ func (r *WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequest)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason why this transfer was stopped.
 type WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason string
 
@@ -2435,6 +2988,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonUnknown WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason = "unknown"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReason) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonMailDeliveryFailed, WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonRejectedByIncrease, WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_stop_payment_request`.
 type WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType string
@@ -2443,6 +3004,14 @@ const (
 	WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType = "check_transfer_stop_payment_request"
 )
 
+func (r WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest:
+		return true
+	}
+	return false
+}
+
 // A Fee Payment object. This field will be present in the JSON response if and
 // only if `category` is equal to `fee_payment`.
 type WireTransferSimulationTransactionSourceFeePayment struct {
@@ -2471,6 +3040,14 @@ func (r *WireTransferSimulationTransactionSourceFeePayment) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceFeePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceFeePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type WireTransferSimulationTransactionSourceFeePaymentCurrency string
@@ -2490,6 +3067,14 @@ const (
 	WireTransferSimulationTransactionSourceFeePaymentCurrencyUsd WireTransferSimulationTransactionSourceFeePaymentCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceFeePaymentCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceFeePaymentCurrencyCad, WireTransferSimulationTransactionSourceFeePaymentCurrencyChf, WireTransferSimulationTransactionSourceFeePaymentCurrencyEur, WireTransferSimulationTransactionSourceFeePaymentCurrencyGbp, WireTransferSimulationTransactionSourceFeePaymentCurrencyJpy, WireTransferSimulationTransactionSourceFeePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_ach_transfer`.
 type WireTransferSimulationTransactionSourceInboundACHTransfer struct {
@@ -2545,6 +3130,14 @@ func (r *WireTransferSimulationTransactionSourceInboundACHTransfer) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Check object. This field will be present in the JSON response if and
 // only if `category` is equal to `inbound_check`.
 type WireTransferSimulationTransactionSourceInboundCheck struct {
@@ -2585,6 +3178,14 @@ func (r *WireTransferSimulationTransactionSourceInboundCheck) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundCheck) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundCheckJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type WireTransferSimulationTransactionSourceInboundCheckCurrency string
@@ -2604,6 +3205,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundCheckCurrencyUsd WireTransferSimulationTransactionSourceInboundCheckCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundCheckCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundCheckCurrencyCad, WireTransferSimulationTransactionSourceInboundCheckCurrencyChf, WireTransferSimulationTransactionSourceInboundCheckCurrencyEur, WireTransferSimulationTransactionSourceInboundCheckCurrencyGbp, WireTransferSimulationTransactionSourceInboundCheckCurrencyJpy, WireTransferSimulationTransactionSourceInboundCheckCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound International ACH Transfer object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `inbound_international_ach_transfer`.
@@ -2756,6 +3365,14 @@ func (r *WireTransferSimulationTransactionSourceInboundInternationalACHTransfer)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundInternationalACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator string
 
@@ -2771,6 +3388,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToVariable, WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorVariableToFixed, WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator string
@@ -2785,6 +3410,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeRate, WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, WireTransferSimulationTransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode string
 
@@ -2831,6 +3464,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAnnuity, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBusinessOrCommercial, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeDeposit, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeLoan, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMiscellaneous, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMortgage, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePension, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRemittance, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRentOrLease, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSalaryOrPayroll, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTax, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAccountsReceivable, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBackOfficeConversion, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMachineTransfer, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfPurchase, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfSale, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRepresentedCheck, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSharedNetworkTransaction, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTelphoneInitiated, WireTransferSimulationTransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -2845,6 +3486,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, WireTransferSimulationTransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -2859,6 +3508,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierBicCode, WireTransferSimulationTransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Confirmation object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_confirmation`.
@@ -2904,6 +3561,14 @@ func (r *WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferC
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the transfer's
 // currency. This will always be "USD" for a Real-Time Payments transfer.
 type WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency string
@@ -2923,6 +3588,14 @@ const (
 	WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyCad, WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyChf, WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyEur, WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyGbp, WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyJpy, WireTransferSimulationTransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Wire Drawdown Payment object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_wire_drawdown_payment`.
 type WireTransferSimulationTransactionSourceInboundWireDrawdownPayment struct {
@@ -2999,6 +3672,14 @@ func (r *WireTransferSimulationTransactionSourceInboundWireDrawdownPayment) Unma
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundWireDrawdownPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundWireDrawdownPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Drawdown Payment Reversal object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `inbound_wire_drawdown_payment_reversal`.
@@ -3052,6 +3733,14 @@ func (r *WireTransferSimulationTransactionSourceInboundWireDrawdownPaymentRevers
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundWireDrawdownPaymentReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundWireDrawdownPaymentReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Reversal object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_reversal`.
 type WireTransferSimulationTransactionSourceInboundWireReversal struct {
@@ -3123,6 +3812,14 @@ func (r *WireTransferSimulationTransactionSourceInboundWireReversal) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundWireReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundWireReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Transfer object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_transfer`.
 type WireTransferSimulationTransactionSourceInboundWireTransfer struct {
@@ -3198,6 +3895,14 @@ func (r *WireTransferSimulationTransactionSourceInboundWireTransfer) UnmarshalJS
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInboundWireTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInboundWireTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Interest Payment object. This field will be present in the JSON response if
 // and only if `category` is equal to `interest_payment`.
 type WireTransferSimulationTransactionSourceInterestPayment struct {
@@ -3232,6 +3937,14 @@ func (r *WireTransferSimulationTransactionSourceInterestPayment) UnmarshalJSON(d
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInterestPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInterestPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type WireTransferSimulationTransactionSourceInterestPaymentCurrency string
@@ -3251,6 +3964,14 @@ const (
 	WireTransferSimulationTransactionSourceInterestPaymentCurrencyUsd WireTransferSimulationTransactionSourceInterestPaymentCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceInterestPaymentCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInterestPaymentCurrencyCad, WireTransferSimulationTransactionSourceInterestPaymentCurrencyChf, WireTransferSimulationTransactionSourceInterestPaymentCurrencyEur, WireTransferSimulationTransactionSourceInterestPaymentCurrencyGbp, WireTransferSimulationTransactionSourceInterestPaymentCurrencyJpy, WireTransferSimulationTransactionSourceInterestPaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source object. This field will be present in the JSON response if
 // and only if `category` is equal to `internal_source`.
 type WireTransferSimulationTransactionSourceInternalSource struct {
@@ -3280,6 +4001,14 @@ func (r *WireTransferSimulationTransactionSourceInternalSource) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceInternalSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceInternalSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type WireTransferSimulationTransactionSourceInternalSourceCurrency string
@@ -3299,6 +4028,14 @@ const (
 	WireTransferSimulationTransactionSourceInternalSourceCurrencyUsd WireTransferSimulationTransactionSourceInternalSourceCurrency = "USD"
 )
 
+func (r WireTransferSimulationTransactionSourceInternalSourceCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInternalSourceCurrencyCad, WireTransferSimulationTransactionSourceInternalSourceCurrencyChf, WireTransferSimulationTransactionSourceInternalSourceCurrencyEur, WireTransferSimulationTransactionSourceInternalSourceCurrencyGbp, WireTransferSimulationTransactionSourceInternalSourceCurrencyJpy, WireTransferSimulationTransactionSourceInternalSourceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source is a transaction between you and Increase. This describes the
 // reason for the transaction.
 type WireTransferSimulationTransactionSourceInternalSourceReason string
@@ -3332,6 +4069,14 @@ const (
 	WireTransferSimulationTransactionSourceInternalSourceReasonSampleFundsReturn WireTransferSimulationTransactionSourceInternalSourceReason = "sample_funds_return"
 )
 
+func (r WireTransferSimulationTransactionSourceInternalSourceReason) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionSourceInternalSourceReasonAccountClosure, WireTransferSimulationTransactionSourceInternalSourceReasonBankMigration, WireTransferSimulationTransactionSourceInternalSourceReasonCashback, WireTransferSimulationTransactionSourceInternalSourceReasonCheckAdjustment, WireTransferSimulationTransactionSourceInternalSourceReasonCollectionReceivable, WireTransferSimulationTransactionSourceInternalSourceReasonEmpyrealAdjustment, WireTransferSimulationTransactionSourceInternalSourceReasonError, WireTransferSimulationTransactionSourceInternalSourceReasonErrorCorrection, WireTransferSimulationTransactionSourceInternalSourceReasonFees, WireTransferSimulationTransactionSourceInternalSourceReasonInterest, WireTransferSimulationTransactionSourceInternalSourceReasonNegativeBalanceForgiveness, WireTransferSimulationTransactionSourceInternalSourceReasonSampleFunds, WireTransferSimulationTransactionSourceInternalSourceReasonSampleFundsReturn:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Acknowledgement object. This field will be present
 // in the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_acknowledgement`.
@@ -3366,6 +4111,14 @@ func (r *WireTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowle
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowledgement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceRealTimePaymentsTransferAcknowledgementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Sample Funds object. This field will be present in the JSON response if and
 // only if `category` is equal to `sample_funds`.
 type WireTransferSimulationTransactionSourceSampleFunds struct {
@@ -3386,6 +4139,14 @@ func (r *WireTransferSimulationTransactionSourceSampleFunds) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceSampleFunds) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceSampleFundsJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_intention`.
 type WireTransferSimulationTransactionSourceWireTransferIntention struct {
@@ -3419,6 +4180,14 @@ func (r *WireTransferSimulationTransactionSourceWireTransferIntention) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceWireTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceWireTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_rejection`.
 type WireTransferSimulationTransactionSourceWireTransferRejection struct {
@@ -3440,6 +4209,14 @@ func (r *WireTransferSimulationTransactionSourceWireTransferRejection) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSimulationTransactionSourceWireTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSimulationTransactionSourceWireTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `transaction`.
 type WireTransferSimulationTransactionType string
@@ -3448,6 +4225,14 @@ const (
 	WireTransferSimulationTransactionTypeTransaction WireTransferSimulationTransactionType = "transaction"
 )
 
+func (r WireTransferSimulationTransactionType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTransactionTypeTransaction:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `inbound_wire_transfer_simulation_result`.
 type WireTransferSimulationType string
@@ -3456,6 +4241,14 @@ const (
 	WireTransferSimulationTypeInboundWireTransferSimulationResult WireTransferSimulationType = "inbound_wire_transfer_simulation_result"
 )
 
+func (r WireTransferSimulationType) IsKnown() bool {
+	switch r {
+	case WireTransferSimulationTypeInboundWireTransferSimulationResult:
+		return true
+	}
+	return false
+}
+
 type SimulationWireTransferNewInboundParams struct {
 	// The identifier of the Account Number the inbound Wire Transfer is for.
 	AccountNumberID param.Field[string] `json:"account_number_id,required"`
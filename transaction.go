@@ -38,6 +38,7 @@ func NewTransactionService(opts ...option.RequestOption) (r *TransactionService)
 // Retrieve a Transaction
 func (r *TransactionService) Get(ctx context.Context, transactionID string, opts ...option.RequestOption) (res *Transaction, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("Transactions.Get")}, opts...)
 	path := fmt.Sprintf("transactions/%s", transactionID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -47,7 +48,7 @@ func (r *TransactionService) Get(ctx context.Context, transactionID string, opts
 func (r *TransactionService) List(ctx context.Context, query TransactionListParams, opts ...option.RequestOption) (res *shared.Page[Transaction], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("Transactions.List")}, opts...)
 	path := "transactions"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -123,6 +124,14 @@ func (r *Transaction) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r Transaction) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // Transaction's currency. This will match the currency on the Transaction's
 // Account.
@@ -143,6 +152,14 @@ const (
 	TransactionCurrencyUsd TransactionCurrency = "USD"
 )
 
+func (r TransactionCurrency) IsKnown() bool {
+	switch r {
+	case TransactionCurrencyCad, TransactionCurrencyChf, TransactionCurrencyEur, TransactionCurrencyGbp, TransactionCurrencyJpy, TransactionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The type of the route this Transaction came through.
 type TransactionRouteType string
 
@@ -153,6 +170,14 @@ const (
 	TransactionRouteTypeCard TransactionRouteType = "card"
 )
 
+func (r TransactionRouteType) IsKnown() bool {
+	switch r {
+	case TransactionRouteTypeAccountNumber, TransactionRouteTypeCard:
+		return true
+	}
+	return false
+}
+
 // This is an object giving more details on the network-level event that caused the
 // Transaction. Note that for backwards compatibility reasons, additional
 // undocumented keys may appear in this object. These should be treated as
@@ -293,6 +318,14 @@ func (r *TransactionSource) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Account Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `account_transfer_intention`.
 type TransactionSourceAccountTransferIntention struct {
@@ -330,6 +363,14 @@ func (r *TransactionSourceAccountTransferIntention) UnmarshalJSON(data []byte) (
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceAccountTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceAccountTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the destination
 // account currency.
 type TransactionSourceAccountTransferIntentionCurrency string
@@ -349,6 +390,14 @@ const (
 	TransactionSourceAccountTransferIntentionCurrencyUsd TransactionSourceAccountTransferIntentionCurrency = "USD"
 )
 
+func (r TransactionSourceAccountTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceAccountTransferIntentionCurrencyCad, TransactionSourceAccountTransferIntentionCurrencyChf, TransactionSourceAccountTransferIntentionCurrencyEur, TransactionSourceAccountTransferIntentionCurrencyGbp, TransactionSourceAccountTransferIntentionCurrencyJpy, TransactionSourceAccountTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_intention`.
 type TransactionSourceACHTransferIntention struct {
@@ -383,6 +432,14 @@ func (r *TransactionSourceACHTransferIntention) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceACHTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceACHTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `ach_transfer_rejection`.
 type TransactionSourceACHTransferRejection struct {
@@ -403,6 +460,14 @@ func (r *TransactionSourceACHTransferRejection) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceACHTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceACHTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // An ACH Transfer Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `ach_transfer_return`.
 type TransactionSourceACHTransferReturn struct {
@@ -437,6 +502,14 @@ func (r *TransactionSourceACHTransferReturn) UnmarshalJSON(data []byte) (err err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceACHTransferReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceACHTransferReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // Why the ACH Transfer was returned. This reason code is sent by the receiving
 // bank back to Increase.
 type TransactionSourceACHTransferReturnReturnReasonCode string
@@ -629,6 +702,14 @@ const (
 	TransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn TransactionSourceACHTransferReturnReturnReasonCode = "untimely_return"
 )
 
+func (r TransactionSourceACHTransferReturnReturnReasonCode) IsKnown() bool {
+	switch r {
+	case TransactionSourceACHTransferReturnReturnReasonCodeInsufficientFund, TransactionSourceACHTransferReturnReturnReasonCodeNoAccount, TransactionSourceACHTransferReturnReturnReasonCodeAccountClosed, TransactionSourceACHTransferReturnReturnReasonCodeInvalidAccountNumberStructure, TransactionSourceACHTransferReturnReturnReasonCodeAccountFrozenEntryReturnedPerOfacInstruction, TransactionSourceACHTransferReturnReturnReasonCodeCreditEntryRefusedByReceiver, TransactionSourceACHTransferReturnReturnReasonCodeUnauthorizedDebitToConsumerAccountUsingCorporateSecCode, TransactionSourceACHTransferReturnReturnReasonCodeCorporateCustomerAdvisedNotAuthorized, TransactionSourceACHTransferReturnReturnReasonCodePaymentStopped, TransactionSourceACHTransferReturnReturnReasonCodeNonTransactionAccount, TransactionSourceACHTransferReturnReturnReasonCodeUncollectedFunds, TransactionSourceACHTransferReturnReturnReasonCodeRoutingNumberCheckDigitError, TransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedUnauthorizedImproperIneligibleOrIncomplete, TransactionSourceACHTransferReturnReturnReasonCodeAmountFieldError, TransactionSourceACHTransferReturnReturnReasonCodeAuthorizationRevokedByCustomer, TransactionSourceACHTransferReturnReturnReasonCodeInvalidACHRoutingNumber, TransactionSourceACHTransferReturnReturnReasonCodeFileRecordEditCriteria, TransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualName, TransactionSourceACHTransferReturnReturnReasonCodeReturnedPerOdfiRequest, TransactionSourceACHTransferReturnReturnReasonCodeLimitedParticipationDfi, TransactionSourceACHTransferReturnReturnReasonCodeIncorrectlyCodedOutboundInternationalPayment, TransactionSourceACHTransferReturnReturnReasonCodeAccountSoldToAnotherDfi, TransactionSourceACHTransferReturnReturnReasonCodeAddendaError, TransactionSourceACHTransferReturnReturnReasonCodeBeneficiaryOrAccountHolderDeceased, TransactionSourceACHTransferReturnReturnReasonCodeCustomerAdvisedNotWithinAuthorizationTerms, TransactionSourceACHTransferReturnReturnReasonCodeCorrectedReturn, TransactionSourceACHTransferReturnReturnReasonCodeDuplicateEntry, TransactionSourceACHTransferReturnReturnReasonCodeDuplicateReturn, TransactionSourceACHTransferReturnReturnReasonCodeEnrDuplicateEnrollment, TransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidDfiAccountNumber, TransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidIndividualIDNumber, TransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidRepresentativePayeeIndicator, TransactionSourceACHTransferReturnReturnReasonCodeEnrInvalidTransactionCode, TransactionSourceACHTransferReturnReturnReasonCodeEnrReturnOfEnrEntry, TransactionSourceACHTransferReturnReturnReasonCodeEnrRoutingNumberCheckDigitError, TransactionSourceACHTransferReturnReturnReasonCodeEntryNotProcessedByGateway, TransactionSourceACHTransferReturnReturnReasonCodeFieldError, TransactionSourceACHTransferReturnReturnReasonCodeForeignReceivingDfiUnableToSettle, TransactionSourceACHTransferReturnReturnReasonCodeIatEntryCodingError, TransactionSourceACHTransferReturnReturnReasonCodeImproperEffectiveEntryDate, TransactionSourceACHTransferReturnReturnReasonCodeImproperSourceDocumentSourceDocumentPresented, TransactionSourceACHTransferReturnReturnReasonCodeInvalidCompanyID, TransactionSourceACHTransferReturnReturnReasonCodeInvalidForeignReceivingDfiIdentification, TransactionSourceACHTransferReturnReturnReasonCodeInvalidIndividualIDNumber, TransactionSourceACHTransferReturnReturnReasonCodeItemAndRckEntryPresentedForPayment, TransactionSourceACHTransferReturnReturnReasonCodeItemRelatedToRckEntryIsIneligible, TransactionSourceACHTransferReturnReturnReasonCodeMandatoryFieldError, TransactionSourceACHTransferReturnReturnReasonCodeMisroutedDishonoredReturn, TransactionSourceACHTransferReturnReturnReasonCodeMisroutedReturn, TransactionSourceACHTransferReturnReturnReasonCodeNoErrorsFound, TransactionSourceACHTransferReturnReturnReasonCodeNonAcceptanceOfR62DishonoredReturn, TransactionSourceACHTransferReturnReturnReasonCodeNonParticipantInIatProgram, TransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntry, TransactionSourceACHTransferReturnReturnReasonCodePermissibleReturnEntryNotAccepted, TransactionSourceACHTransferReturnReturnReasonCodeRdfiNonSettlement, TransactionSourceACHTransferReturnReturnReasonCodeRdfiParticipantInCheckTruncationProgram, TransactionSourceACHTransferReturnReturnReasonCodeRepresentativePayeeDeceasedOrUnableToContinueInThatCapacity, TransactionSourceACHTransferReturnReturnReasonCodeReturnNotADuplicate, TransactionSourceACHTransferReturnReturnReasonCodeReturnOfErroneousOrReversingDebit, TransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperCreditEntry, TransactionSourceACHTransferReturnReturnReasonCodeReturnOfImproperDebitEntry, TransactionSourceACHTransferReturnReturnReasonCodeReturnOfXckEntry, TransactionSourceACHTransferReturnReturnReasonCodeSourceDocumentPresentedForPayment, TransactionSourceACHTransferReturnReturnReasonCodeStateLawAffectingRckAcceptance, TransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnItemRelatedToRckEntry, TransactionSourceACHTransferReturnReturnReasonCodeStopPaymentOnSourceDocument, TransactionSourceACHTransferReturnReturnReasonCodeTimelyOriginalReturn, TransactionSourceACHTransferReturnReturnReasonCodeTraceNumberError, TransactionSourceACHTransferReturnReturnReasonCodeUntimelyDishonoredReturn, TransactionSourceACHTransferReturnReturnReasonCodeUntimelyReturn:
+		return true
+	}
+	return false
+}
+
 // A Card Dispute Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `card_dispute_acceptance`.
 type TransactionSourceCardDisputeAcceptance struct {
@@ -657,6 +738,14 @@ func (r *TransactionSourceCardDisputeAcceptance) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardDisputeAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardDisputeAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Card Refund object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_refund`.
 type TransactionSourceCardRefund struct {
@@ -721,6 +810,14 @@ func (r *TransactionSourceCardRefund) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefund) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type TransactionSourceCardRefundCurrency string
@@ -740,6 +837,14 @@ const (
 	TransactionSourceCardRefundCurrencyUsd TransactionSourceCardRefundCurrency = "USD"
 )
 
+func (r TransactionSourceCardRefundCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundCurrencyCad, TransactionSourceCardRefundCurrencyChf, TransactionSourceCardRefundCurrencyEur, TransactionSourceCardRefundCurrencyGbp, TransactionSourceCardRefundCurrencyJpy, TransactionSourceCardRefundCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type TransactionSourceCardRefundNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -767,6 +872,14 @@ func (r *TransactionSourceCardRefundNetworkIdentifiers) UnmarshalJSON(data []byt
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type TransactionSourceCardRefundPurchaseDetails struct {
@@ -816,6 +929,14 @@ func (r *TransactionSourceCardRefundPurchaseDetails) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type TransactionSourceCardRefundPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -888,6 +1009,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsCarRental) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type TransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges string
 
@@ -906,6 +1035,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation TransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesNoExtraCharge, TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesGas, TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesExtraMileage, TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesLateReturn, TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, TransactionSourceCardRefundPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator string
@@ -917,6 +1054,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, TransactionSourceCardRefundPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type TransactionSourceCardRefundPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -988,6 +1133,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsLodging) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type TransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges string
 
@@ -1008,6 +1161,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry TransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesNoExtraCharge, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesRestaurant, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesGiftShop, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesMiniBar, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesTelephone, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesOther, TransactionSourceCardRefundPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator string
@@ -1019,6 +1180,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNotApplicable, TransactionSourceCardRefundPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1035,6 +1204,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatFreeText, TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatOrderNumber, TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, TransactionSourceCardRefundPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type TransactionSourceCardRefundPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1087,6 +1264,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsTravel) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type TransactionSourceCardRefundPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1121,6 +1306,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsTravelAncillary) UnmarshalJSO
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1135,6 +1328,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type TransactionSourceCardRefundPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1157,6 +1358,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsTravelAncillaryService) Unmar
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1211,6 +1420,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryNone, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBundledService, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCargo, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryLounge, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMedical, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryOther, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryPets, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategorySeatFees, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStandby, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryStore, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryTravelService, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, TransactionSourceCardRefundPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1229,6 +1446,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorNoCredit, TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorOther, TransactionSourceCardRefundPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1239,6 +1464,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, TransactionSourceCardRefundPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1251,6 +1484,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNone, TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, TransactionSourceCardRefundPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type TransactionSourceCardRefundPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -1285,6 +1526,14 @@ func (r *TransactionSourceCardRefundPurchaseDetailsTravelTripLeg) UnmarshalJSON(
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRefundPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -1297,6 +1546,14 @@ const (
 	TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeNone, TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, TransactionSourceCardRefundPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_refund`.
 type TransactionSourceCardRefundType string
@@ -1305,6 +1562,14 @@ const (
 	TransactionSourceCardRefundTypeCardRefund TransactionSourceCardRefundType = "card_refund"
 )
 
+func (r TransactionSourceCardRefundType) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRefundTypeCardRefund:
+		return true
+	}
+	return false
+}
+
 // A Card Revenue Payment object. This field will be present in the JSON response
 // if and only if `category` is equal to `card_revenue_payment`.
 type TransactionSourceCardRevenuePayment struct {
@@ -1339,6 +1604,14 @@ func (r *TransactionSourceCardRevenuePayment) UnmarshalJSON(data []byte) (err er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardRevenuePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardRevenuePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type TransactionSourceCardRevenuePaymentCurrency string
@@ -1358,6 +1631,14 @@ const (
 	TransactionSourceCardRevenuePaymentCurrencyUsd TransactionSourceCardRevenuePaymentCurrency = "USD"
 )
 
+func (r TransactionSourceCardRevenuePaymentCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardRevenuePaymentCurrencyCad, TransactionSourceCardRevenuePaymentCurrencyChf, TransactionSourceCardRevenuePaymentCurrencyEur, TransactionSourceCardRevenuePaymentCurrencyGbp, TransactionSourceCardRevenuePaymentCurrencyJpy, TransactionSourceCardRevenuePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Card Settlement object. This field will be present in the JSON response if and
 // only if `category` is equal to `card_settlement`.
 type TransactionSourceCardSettlement struct {
@@ -1436,6 +1717,14 @@ func (r *TransactionSourceCardSettlement) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's settlement currency.
 type TransactionSourceCardSettlementCurrency string
@@ -1455,6 +1744,14 @@ const (
 	TransactionSourceCardSettlementCurrencyUsd TransactionSourceCardSettlementCurrency = "USD"
 )
 
+func (r TransactionSourceCardSettlementCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementCurrencyCad, TransactionSourceCardSettlementCurrencyChf, TransactionSourceCardSettlementCurrencyEur, TransactionSourceCardSettlementCurrencyGbp, TransactionSourceCardSettlementCurrencyJpy, TransactionSourceCardSettlementCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Network-specific identifiers for this refund.
 type TransactionSourceCardSettlementNetworkIdentifiers struct {
 	// A network assigned business ID that identifies the acquirer that processed this
@@ -1482,6 +1779,14 @@ func (r *TransactionSourceCardSettlementNetworkIdentifiers) UnmarshalJSON(data [
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementNetworkIdentifiers) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementNetworkIdentifiersJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional details about the card purchase, such as tax and industry-specific
 // fields.
 type TransactionSourceCardSettlementPurchaseDetails struct {
@@ -1531,6 +1836,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetails) UnmarshalJSON(data []by
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetails) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsJSON) RawJSON() string {
+	return r.raw
+}
+
 // Fields specific to car rentals.
 type TransactionSourceCardSettlementPurchaseDetailsCarRental struct {
 	// Code indicating the vehicle's class.
@@ -1604,6 +1917,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsCarRental) UnmarshalJSON(
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsCarRental) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsCarRentalJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (gas, late fee, etc.) being billed.
 type TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges string
 
@@ -1622,6 +1943,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges = "parking_violation"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraCharges) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesNoExtraCharge, TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesGas, TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesExtraMileage, TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesLateReturn, TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesOneWayServiceFee, TransactionSourceCardSettlementPurchaseDetailsCarRentalExtraChargesParkingViolation:
+		return true
+	}
+	return false
+}
+
 // An indicator that the cardholder is being billed for a reserved vehicle that was
 // not actually rented (that is, a "no-show" charge).
 type TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator string
@@ -1633,6 +1962,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator = "no_show_for_specialized_vehicle"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNotApplicable, TransactionSourceCardSettlementPurchaseDetailsCarRentalNoShowIndicatorNoShowForSpecializedVehicle:
+		return true
+	}
+	return false
+}
+
 // Fields specific to lodging.
 type TransactionSourceCardSettlementPurchaseDetailsLodging struct {
 	// Date the customer checked in.
@@ -1704,6 +2041,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsLodging) UnmarshalJSON(da
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsLodging) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsLodgingJSON) RawJSON() string {
+	return r.raw
+}
+
 // Additional charges (phone, late check-out, etc.) being billed.
 type TransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges string
 
@@ -1724,6 +2069,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry TransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges = "laundry"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsLodgingExtraCharges) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesNoExtraCharge, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesRestaurant, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesGiftShop, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesMiniBar, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesTelephone, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesOther, TransactionSourceCardSettlementPurchaseDetailsLodgingExtraChargesLaundry:
+		return true
+	}
+	return false
+}
+
 // Indicator that the cardholder is being billed for a reserved room that was not
 // actually used.
 type TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator string
@@ -1735,6 +2088,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator = "no_show"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNotApplicable, TransactionSourceCardSettlementPurchaseDetailsLodgingNoShowIndicatorNoShow:
+		return true
+	}
+	return false
+}
+
 // The format of the purchase identifier.
 type TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat string
 
@@ -1751,6 +2112,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat = "invoice_number"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormat) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatFreeText, TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatOrderNumber, TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatRentalAgreementNumber, TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatHotelFolioNumber, TransactionSourceCardSettlementPurchaseDetailsPurchaseIdentifierFormatInvoiceNumber:
+		return true
+	}
+	return false
+}
+
 // Fields specific to travel.
 type TransactionSourceCardSettlementPurchaseDetailsTravel struct {
 	// Ancillary purchases in addition to the airfare.
@@ -1803,6 +2172,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsTravel) UnmarshalJSON(dat
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravel) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsTravelJSON) RawJSON() string {
+	return r.raw
+}
+
 // Ancillary purchases in addition to the airfare.
 type TransactionSourceCardSettlementPurchaseDetailsTravelAncillary struct {
 	// If this purchase has a connection or relationship to another purchase, such as a
@@ -1837,6 +2214,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsTravelAncillary) Unmarsha
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelAncillary) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsTravelAncillaryJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates the reason for a credit to the cardholder.
 type TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator string
 
@@ -1851,6 +2236,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator = "other"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorNoCredit, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryCreditReasonIndicatorOther:
+		return true
+	}
+	return false
+}
+
 type TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService struct {
 	// Category of the ancillary service.
 	Category TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory `json:"category,required,nullable"`
@@ -1873,6 +2266,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService) U
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryService) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsTravelAncillaryServiceJSON) RawJSON() string {
+	return r.raw
+}
+
 // Category of the ancillary service.
 type TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory string
 
@@ -1927,6 +2328,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory = "wifi"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategory) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryNone, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBundledService, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryBaggageFee, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryChangeFee, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCargo, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryCarbonOffset, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryFrequentFlyer, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGiftCard, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryGroundTransport, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryInFlightEntertainment, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryLounge, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMedical, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryMealBeverage, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryOther, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPassengerAssistFee, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryPets, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategorySeatFees, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStandby, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryServiceFee, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryStore, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryTravelService, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUnaccompaniedTravel, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryUpgrades, TransactionSourceCardSettlementPurchaseDetailsTravelAncillaryServicesCategoryWifi:
+		return true
+	}
+	return false
+}
+
 // Indicates the reason for a credit to the cardholder.
 type TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator string
 
@@ -1945,6 +2354,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator = "partial_refund_of_airline_ticket"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorNoCredit, TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketAndPassengerTransportAncillaryPurchaseCancellation, TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorAirlineTicketCancellation, TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorOther, TransactionSourceCardSettlementPurchaseDetailsTravelCreditReasonIndicatorPartialRefundOfAirlineTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates whether this ticket is non-refundable.
 type TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator string
 
@@ -1955,6 +2372,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator = "restricted_non_refundable_ticket"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorNoRestrictions, TransactionSourceCardSettlementPurchaseDetailsTravelRestrictedTicketIndicatorRestrictedNonRefundableTicket:
+		return true
+	}
+	return false
+}
+
 // Indicates why a ticket was changed.
 type TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator string
 
@@ -1967,6 +2392,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator = "new_ticket"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNone, TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorChangeToExistingTicket, TransactionSourceCardSettlementPurchaseDetailsTravelTicketChangeIndicatorNewTicket:
+		return true
+	}
+	return false
+}
+
 type TransactionSourceCardSettlementPurchaseDetailsTravelTripLeg struct {
 	// Carrier code (e.g., United Airlines, Jet Blue, etc.).
 	CarrierCode string `json:"carrier_code,required,nullable"`
@@ -2001,6 +2434,14 @@ func (r *TransactionSourceCardSettlementPurchaseDetailsTravelTripLeg) UnmarshalJ
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelTripLeg) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCardSettlementPurchaseDetailsTravelTripLegJSON) RawJSON() string {
+	return r.raw
+}
+
 // Indicates whether a stopover is allowed on this ticket.
 type TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode string
 
@@ -2013,6 +2454,14 @@ const (
 	TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode = "stop_over_not_allowed"
 )
 
+func (r TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCode) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeNone, TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverAllowed, TransactionSourceCardSettlementPurchaseDetailsTravelTripLegsStopOverCodeStopOverNotAllowed:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `card_settlement`.
 type TransactionSourceCardSettlementType string
@@ -2021,6 +2470,14 @@ const (
 	TransactionSourceCardSettlementTypeCardSettlement TransactionSourceCardSettlementType = "card_settlement"
 )
 
+func (r TransactionSourceCardSettlementType) IsKnown() bool {
+	switch r {
+	case TransactionSourceCardSettlementTypeCardSettlement:
+		return true
+	}
+	return false
+}
+
 // The type of the resource. We may add additional possible values for this enum
 // over time; your application should be able to handle such additions gracefully.
 type TransactionSourceCategory string
@@ -2105,6 +2562,14 @@ const (
 	TransactionSourceCategoryOther TransactionSourceCategory = "other"
 )
 
+func (r TransactionSourceCategory) IsKnown() bool {
+	switch r {
+	case TransactionSourceCategoryAccountTransferIntention, TransactionSourceCategoryACHTransferIntention, TransactionSourceCategoryACHTransferRejection, TransactionSourceCategoryACHTransferReturn, TransactionSourceCategoryCardDisputeAcceptance, TransactionSourceCategoryCardRefund, TransactionSourceCategoryCardSettlement, TransactionSourceCategoryCardRevenuePayment, TransactionSourceCategoryCheckDepositAcceptance, TransactionSourceCategoryCheckDepositReturn, TransactionSourceCategoryCheckTransferDeposit, TransactionSourceCategoryCheckTransferIntention, TransactionSourceCategoryCheckTransferStopPaymentRequest, TransactionSourceCategoryFeePayment, TransactionSourceCategoryInboundACHTransfer, TransactionSourceCategoryInboundACHTransferReturnIntention, TransactionSourceCategoryInboundCheck, TransactionSourceCategoryInboundInternationalACHTransfer, TransactionSourceCategoryInboundRealTimePaymentsTransferConfirmation, TransactionSourceCategoryInboundWireDrawdownPaymentReversal, TransactionSourceCategoryInboundWireDrawdownPayment, TransactionSourceCategoryInboundWireReversal, TransactionSourceCategoryInboundWireTransfer, TransactionSourceCategoryInterestPayment, TransactionSourceCategoryInternalSource, TransactionSourceCategoryRealTimePaymentsTransferAcknowledgement, TransactionSourceCategorySampleFunds, TransactionSourceCategoryWireTransferIntention, TransactionSourceCategoryWireTransferRejection, TransactionSourceCategoryOther:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Acceptance object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_deposit_acceptance`.
 type TransactionSourceCheckDepositAcceptance struct {
@@ -2147,6 +2612,14 @@ func (r *TransactionSourceCheckDepositAcceptance) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCheckDepositAcceptance) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCheckDepositAcceptanceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type TransactionSourceCheckDepositAcceptanceCurrency string
@@ -2166,6 +2639,14 @@ const (
 	TransactionSourceCheckDepositAcceptanceCurrencyUsd TransactionSourceCheckDepositAcceptanceCurrency = "USD"
 )
 
+func (r TransactionSourceCheckDepositAcceptanceCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckDepositAcceptanceCurrencyCad, TransactionSourceCheckDepositAcceptanceCurrencyChf, TransactionSourceCheckDepositAcceptanceCurrencyEur, TransactionSourceCheckDepositAcceptanceCurrencyGbp, TransactionSourceCheckDepositAcceptanceCurrencyJpy, TransactionSourceCheckDepositAcceptanceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Deposit Return object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_deposit_return`.
 type TransactionSourceCheckDepositReturn struct {
@@ -2206,6 +2687,14 @@ func (r *TransactionSourceCheckDepositReturn) UnmarshalJSON(data []byte) (err er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCheckDepositReturn) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCheckDepositReturnJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type TransactionSourceCheckDepositReturnCurrency string
@@ -2225,6 +2714,14 @@ const (
 	TransactionSourceCheckDepositReturnCurrencyUsd TransactionSourceCheckDepositReturnCurrency = "USD"
 )
 
+func (r TransactionSourceCheckDepositReturnCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckDepositReturnCurrencyCad, TransactionSourceCheckDepositReturnCurrencyChf, TransactionSourceCheckDepositReturnCurrencyEur, TransactionSourceCheckDepositReturnCurrencyGbp, TransactionSourceCheckDepositReturnCurrencyJpy, TransactionSourceCheckDepositReturnCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // Why this check was returned by the bank holding the account it was drawn
 // against.
 type TransactionSourceCheckDepositReturnReturnReason string
@@ -2256,6 +2753,14 @@ const (
 	TransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular TransactionSourceCheckDepositReturnReturnReason = "endorsement_irregular"
 )
 
+func (r TransactionSourceCheckDepositReturnReturnReason) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckDepositReturnReturnReasonACHConversionNotSupported, TransactionSourceCheckDepositReturnReturnReasonClosedAccount, TransactionSourceCheckDepositReturnReturnReasonDuplicateSubmission, TransactionSourceCheckDepositReturnReturnReasonInsufficientFunds, TransactionSourceCheckDepositReturnReturnReasonNoAccount, TransactionSourceCheckDepositReturnReturnReasonNotAuthorized, TransactionSourceCheckDepositReturnReturnReasonStaleDated, TransactionSourceCheckDepositReturnReturnReasonStopPayment, TransactionSourceCheckDepositReturnReturnReasonUnknownReason, TransactionSourceCheckDepositReturnReturnReasonUnmatchedDetails, TransactionSourceCheckDepositReturnReturnReasonUnreadableImage, TransactionSourceCheckDepositReturnReturnReasonEndorsementIrregular:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Deposit object. This field will be present in the JSON response
 // if and only if `category` is equal to `check_transfer_deposit`.
 type TransactionSourceCheckTransferDeposit struct {
@@ -2299,6 +2804,14 @@ func (r *TransactionSourceCheckTransferDeposit) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCheckTransferDeposit) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCheckTransferDepositJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_deposit`.
 type TransactionSourceCheckTransferDepositType string
@@ -2307,6 +2820,14 @@ const (
 	TransactionSourceCheckTransferDepositTypeCheckTransferDeposit TransactionSourceCheckTransferDepositType = "check_transfer_deposit"
 )
 
+func (r TransactionSourceCheckTransferDepositType) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckTransferDepositTypeCheckTransferDeposit:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `check_transfer_intention`.
 type TransactionSourceCheckTransferIntention struct {
@@ -2352,6 +2873,14 @@ func (r *TransactionSourceCheckTransferIntention) UnmarshalJSON(data []byte) (er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCheckTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCheckTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the check's
 // currency.
 type TransactionSourceCheckTransferIntentionCurrency string
@@ -2371,6 +2900,14 @@ const (
 	TransactionSourceCheckTransferIntentionCurrencyUsd TransactionSourceCheckTransferIntentionCurrency = "USD"
 )
 
+func (r TransactionSourceCheckTransferIntentionCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckTransferIntentionCurrencyCad, TransactionSourceCheckTransferIntentionCurrencyChf, TransactionSourceCheckTransferIntentionCurrencyEur, TransactionSourceCheckTransferIntentionCurrencyGbp, TransactionSourceCheckTransferIntentionCurrencyJpy, TransactionSourceCheckTransferIntentionCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // A Check Transfer Stop Payment Request object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `check_transfer_stop_payment_request`.
@@ -2402,6 +2939,14 @@ func (r *TransactionSourceCheckTransferStopPaymentRequest) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceCheckTransferStopPaymentRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceCheckTransferStopPaymentRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The reason why this transfer was stopped.
 type TransactionSourceCheckTransferStopPaymentRequestReason string
 
@@ -2415,6 +2960,14 @@ const (
 	TransactionSourceCheckTransferStopPaymentRequestReasonUnknown TransactionSourceCheckTransferStopPaymentRequestReason = "unknown"
 )
 
+func (r TransactionSourceCheckTransferStopPaymentRequestReason) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckTransferStopPaymentRequestReasonMailDeliveryFailed, TransactionSourceCheckTransferStopPaymentRequestReasonRejectedByIncrease, TransactionSourceCheckTransferStopPaymentRequestReasonUnknown:
+		return true
+	}
+	return false
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `check_transfer_stop_payment_request`.
 type TransactionSourceCheckTransferStopPaymentRequestType string
@@ -2423,6 +2976,14 @@ const (
 	TransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest TransactionSourceCheckTransferStopPaymentRequestType = "check_transfer_stop_payment_request"
 )
 
+func (r TransactionSourceCheckTransferStopPaymentRequestType) IsKnown() bool {
+	switch r {
+	case TransactionSourceCheckTransferStopPaymentRequestTypeCheckTransferStopPaymentRequest:
+		return true
+	}
+	return false
+}
+
 // A Fee Payment object. This field will be present in the JSON response if and
 // only if `category` is equal to `fee_payment`.
 type TransactionSourceFeePayment struct {
@@ -2451,6 +3012,14 @@ func (r *TransactionSourceFeePayment) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceFeePayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceFeePaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type TransactionSourceFeePaymentCurrency string
@@ -2470,6 +3039,14 @@ const (
 	TransactionSourceFeePaymentCurrencyUsd TransactionSourceFeePaymentCurrency = "USD"
 )
 
+func (r TransactionSourceFeePaymentCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceFeePaymentCurrencyCad, TransactionSourceFeePaymentCurrencyChf, TransactionSourceFeePaymentCurrencyEur, TransactionSourceFeePaymentCurrencyGbp, TransactionSourceFeePaymentCurrencyJpy, TransactionSourceFeePaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound ACH Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_ach_transfer`.
 type TransactionSourceInboundACHTransfer struct {
@@ -2524,6 +3101,14 @@ func (r *TransactionSourceInboundACHTransfer) UnmarshalJSON(data []byte) (err er
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Check object. This field will be present in the JSON response if and
 // only if `category` is equal to `inbound_check`.
 type TransactionSourceInboundCheck struct {
@@ -2564,6 +3149,14 @@ func (r *TransactionSourceInboundCheck) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundCheck) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundCheckJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the
 // transaction's currency.
 type TransactionSourceInboundCheckCurrency string
@@ -2583,6 +3176,14 @@ const (
 	TransactionSourceInboundCheckCurrencyUsd TransactionSourceInboundCheckCurrency = "USD"
 )
 
+func (r TransactionSourceInboundCheckCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundCheckCurrencyCad, TransactionSourceInboundCheckCurrencyChf, TransactionSourceInboundCheckCurrencyEur, TransactionSourceInboundCheckCurrencyGbp, TransactionSourceInboundCheckCurrencyJpy, TransactionSourceInboundCheckCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound International ACH Transfer object. This field will be present in the
 // JSON response if and only if `category` is equal to
 // `inbound_international_ach_transfer`.
@@ -2734,6 +3335,14 @@ func (r *TransactionSourceInboundInternationalACHTransfer) UnmarshalJSON(data []
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundInternationalACHTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundInternationalACHTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // A description of how the foreign exchange rate was calculated.
 type TransactionSourceInboundInternationalACHTransferForeignExchangeIndicator string
 
@@ -2749,6 +3358,14 @@ const (
 	TransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed TransactionSourceInboundInternationalACHTransferForeignExchangeIndicator = "fixed_to_fixed"
 )
 
+func (r TransactionSourceInboundInternationalACHTransferForeignExchangeIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToVariable, TransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorVariableToFixed, TransactionSourceInboundInternationalACHTransferForeignExchangeIndicatorFixedToFixed:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the `foreign_exchange_reference` field for
 // this Transaction.
 type TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator string
@@ -2763,6 +3380,14 @@ const (
 	TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator = "blank"
 )
 
+func (r TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicator) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeRate, TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorForeignExchangeReferenceNumber, TransactionSourceInboundInternationalACHTransferForeignExchangeReferenceIndicatorBlank:
+		return true
+	}
+	return false
+}
+
 // The type of transfer. Set by the originator.
 type TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode string
 
@@ -2809,6 +3434,14 @@ const (
 	TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode = "internet_initiated"
 )
 
+func (r TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCode) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAnnuity, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBusinessOrCommercial, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeDeposit, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeLoan, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMiscellaneous, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMortgage, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePension, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRemittance, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRentOrLease, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSalaryOrPayroll, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTax, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeAccountsReceivable, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeBackOfficeConversion, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeMachineTransfer, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfPurchase, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodePointOfSale, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeRepresentedCheck, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeSharedNetworkTransaction, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeTelphoneInitiated, TransactionSourceInboundInternationalACHTransferInternationalTransactionTypeCodeInternetInitiated:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `originating_depository_financial_institution_id` field for this Transaction.
 type TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier string
@@ -2823,6 +3456,14 @@ const (
 	TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierBicCode, TransactionSourceInboundInternationalACHTransferOriginatingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An instruction of how to interpret the
 // `receiving_depository_financial_institution_id` field for this Transaction.
 type TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier string
@@ -2837,6 +3478,14 @@ const (
 	TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier = "iban"
 )
 
+func (r TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifier) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierNationalClearingSystemNumber, TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierBicCode, TransactionSourceInboundInternationalACHTransferReceivingDepositoryFinancialInstitutionIDQualifierIban:
+		return true
+	}
+	return false
+}
+
 // An Inbound Real-Time Payments Transfer Confirmation object. This field will be
 // present in the JSON response if and only if `category` is equal to
 // `inbound_real_time_payments_transfer_confirmation`.
@@ -2882,6 +3531,14 @@ func (r *TransactionSourceInboundRealTimePaymentsTransferConfirmation) Unmarshal
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundRealTimePaymentsTransferConfirmation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundRealTimePaymentsTransferConfirmationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code of the transfer's
 // currency. This will always be "USD" for a Real-Time Payments transfer.
 type TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency string
@@ -2901,6 +3558,14 @@ const (
 	TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency = "USD"
 )
 
+func (r TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyCad, TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyChf, TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyEur, TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyGbp, TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyJpy, TransactionSourceInboundRealTimePaymentsTransferConfirmationCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Inbound Wire Drawdown Payment object. This field will be present in the JSON
 // response if and only if `category` is equal to `inbound_wire_drawdown_payment`.
 type TransactionSourceInboundWireDrawdownPayment struct {
@@ -2976,6 +3641,14 @@ func (r *TransactionSourceInboundWireDrawdownPayment) UnmarshalJSON(data []byte)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundWireDrawdownPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundWireDrawdownPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Drawdown Payment Reversal object. This field will be present in
 // the JSON response if and only if `category` is equal to
 // `inbound_wire_drawdown_payment_reversal`.
@@ -3028,6 +3701,14 @@ func (r *TransactionSourceInboundWireDrawdownPaymentReversal) UnmarshalJSON(data
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundWireDrawdownPaymentReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundWireDrawdownPaymentReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Reversal object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_reversal`.
 type TransactionSourceInboundWireReversal struct {
@@ -3098,6 +3779,14 @@ func (r *TransactionSourceInboundWireReversal) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundWireReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundWireReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Inbound Wire Transfer object. This field will be present in the JSON response
 // if and only if `category` is equal to `inbound_wire_transfer`.
 type TransactionSourceInboundWireTransfer struct {
@@ -3172,6 +3861,14 @@ func (r *TransactionSourceInboundWireTransfer) UnmarshalJSON(data []byte) (err e
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInboundWireTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInboundWireTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // An Interest Payment object. This field will be present in the JSON response if
 // and only if `category` is equal to `interest_payment`.
 type TransactionSourceInterestPayment struct {
@@ -3206,6 +3903,14 @@ func (r *TransactionSourceInterestPayment) UnmarshalJSON(data []byte) (err error
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInterestPayment) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInterestPaymentJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type TransactionSourceInterestPaymentCurrency string
@@ -3225,6 +3930,14 @@ const (
 	TransactionSourceInterestPaymentCurrencyUsd TransactionSourceInterestPaymentCurrency = "USD"
 )
 
+func (r TransactionSourceInterestPaymentCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceInterestPaymentCurrencyCad, TransactionSourceInterestPaymentCurrencyChf, TransactionSourceInterestPaymentCurrencyEur, TransactionSourceInterestPaymentCurrencyGbp, TransactionSourceInterestPaymentCurrencyJpy, TransactionSourceInterestPaymentCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source object. This field will be present in the JSON response if
 // and only if `category` is equal to `internal_source`.
 type TransactionSourceInternalSource struct {
@@ -3254,6 +3967,14 @@ func (r *TransactionSourceInternalSource) UnmarshalJSON(data []byte) (err error)
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceInternalSource) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceInternalSourceJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transaction
 // currency.
 type TransactionSourceInternalSourceCurrency string
@@ -3273,6 +3994,14 @@ const (
 	TransactionSourceInternalSourceCurrencyUsd TransactionSourceInternalSourceCurrency = "USD"
 )
 
+func (r TransactionSourceInternalSourceCurrency) IsKnown() bool {
+	switch r {
+	case TransactionSourceInternalSourceCurrencyCad, TransactionSourceInternalSourceCurrencyChf, TransactionSourceInternalSourceCurrencyEur, TransactionSourceInternalSourceCurrencyGbp, TransactionSourceInternalSourceCurrencyJpy, TransactionSourceInternalSourceCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // An Internal Source is a transaction between you and Increase. This describes the
 // reason for the transaction.
 type TransactionSourceInternalSourceReason string
@@ -3306,6 +4035,14 @@ const (
 	TransactionSourceInternalSourceReasonSampleFundsReturn TransactionSourceInternalSourceReason = "sample_funds_return"
 )
 
+func (r TransactionSourceInternalSourceReason) IsKnown() bool {
+	switch r {
+	case TransactionSourceInternalSourceReasonAccountClosure, TransactionSourceInternalSourceReasonBankMigration, TransactionSourceInternalSourceReasonCashback, TransactionSourceInternalSourceReasonCheckAdjustment, TransactionSourceInternalSourceReasonCollectionReceivable, TransactionSourceInternalSourceReasonEmpyrealAdjustment, TransactionSourceInternalSourceReasonError, TransactionSourceInternalSourceReasonErrorCorrection, TransactionSourceInternalSourceReasonFees, TransactionSourceInternalSourceReasonInterest, TransactionSourceInternalSourceReasonNegativeBalanceForgiveness, TransactionSourceInternalSourceReasonSampleFunds, TransactionSourceInternalSourceReasonSampleFundsReturn:
+		return true
+	}
+	return false
+}
+
 // A Real-Time Payments Transfer Acknowledgement object. This field will be present
 // in the JSON response if and only if `category` is equal to
 // `real_time_payments_transfer_acknowledgement`.
@@ -3340,6 +4077,14 @@ func (r *TransactionSourceRealTimePaymentsTransferAcknowledgement) UnmarshalJSON
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceRealTimePaymentsTransferAcknowledgement) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceRealTimePaymentsTransferAcknowledgementJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Sample Funds object. This field will be present in the JSON response if and
 // only if `category` is equal to `sample_funds`.
 type TransactionSourceSampleFunds struct {
@@ -3360,6 +4105,14 @@ func (r *TransactionSourceSampleFunds) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceSampleFunds) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceSampleFundsJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Intention object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_intention`.
 type TransactionSourceWireTransferIntention struct {
@@ -3392,6 +4145,14 @@ func (r *TransactionSourceWireTransferIntention) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceWireTransferIntention) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceWireTransferIntentionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A Wire Transfer Rejection object. This field will be present in the JSON
 // response if and only if `category` is equal to `wire_transfer_rejection`.
 type TransactionSourceWireTransferRejection struct {
@@ -3412,6 +4173,14 @@ func (r *TransactionSourceWireTransferRejection) UnmarshalJSON(data []byte) (err
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r TransactionSourceWireTransferRejection) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r transactionSourceWireTransferRejectionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `transaction`.
 type TransactionType string
@@ -3420,6 +4189,14 @@ const (
 	TransactionTypeTransaction TransactionType = "transaction"
 )
 
+func (r TransactionType) IsKnown() bool {
+	switch r {
+	case TransactionTypeTransaction:
+		return true
+	}
+	return false
+}
+
 type TransactionListParams struct {
 	// Filter Transactions for those belonging to the specified Account.
 	AccountID param.Field[string]                         `query:"account_id"`
@@ -3540,6 +4317,14 @@ const (
 	TransactionListParamsCategoryInOther TransactionListParamsCategoryIn = "other"
 )
 
+func (r TransactionListParamsCategoryIn) IsKnown() bool {
+	switch r {
+	case TransactionListParamsCategoryInAccountTransferIntention, TransactionListParamsCategoryInACHTransferIntention, TransactionListParamsCategoryInACHTransferRejection, TransactionListParamsCategoryInACHTransferReturn, TransactionListParamsCategoryInCardDisputeAcceptance, TransactionListParamsCategoryInCardRefund, TransactionListParamsCategoryInCardSettlement, TransactionListParamsCategoryInCardRevenuePayment, TransactionListParamsCategoryInCheckDepositAcceptance, TransactionListParamsCategoryInCheckDepositReturn, TransactionListParamsCategoryInCheckTransferDeposit, TransactionListParamsCategoryInCheckTransferIntention, TransactionListParamsCategoryInCheckTransferStopPaymentRequest, TransactionListParamsCategoryInFeePayment, TransactionListParamsCategoryInInboundACHTransfer, TransactionListParamsCategoryInInboundACHTransferReturnIntention, TransactionListParamsCategoryInInboundCheck, TransactionListParamsCategoryInInboundInternationalACHTransfer, TransactionListParamsCategoryInInboundRealTimePaymentsTransferConfirmation, TransactionListParamsCategoryInInboundWireDrawdownPaymentReversal, TransactionListParamsCategoryInInboundWireDrawdownPayment, TransactionListParamsCategoryInInboundWireReversal, TransactionListParamsCategoryInInboundWireTransfer, TransactionListParamsCategoryInInterestPayment, TransactionListParamsCategoryInInternalSource, TransactionListParamsCategoryInRealTimePaymentsTransferAcknowledgement, TransactionListParamsCategoryInSampleFunds, TransactionListParamsCategoryInWireTransferIntention, TransactionListParamsCategoryInWireTransferRejection, TransactionListParamsCategoryInOther:
+		return true
+	}
+	return false
+}
+
 type TransactionListParamsCreatedAt struct {
 	// Return results after this [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601)
 	// timestamp.
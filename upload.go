@@ -0,0 +1,47 @@
+package increase
+
+import (
+	"io"
+
+	"github.com/increase/increase-go/internal/apiform"
+)
+
+// ReopenableFile returns a file for upload params such as [FileNewParams.File],
+// whose contents are read from the file returned by open. The file is opened
+// again each time the upload is sent, including when it is retried, and closed
+// once it has been read.
+//
+// Uploads of files which can be read again like this, or which are an
+// [io.Seeker] such as an [*os.File], are streamed from the file as they are
+// sent, so their memory use does not depend on the size of the file. Other
+// readers are read into memory so that the upload can be retried.
+func ReopenableFile(filename string, open func() (io.ReadCloser, error)) io.Reader {
+	return apiform.NewReopener(filename, open)
+}
+
+// FileParamOption configures a file created by [FileParam].
+type FileParamOption func(*apiform.File)
+
+// UploadProgress returns a FileParamOption which calls progress as the file is
+// uploaded, with the number of bytes sent so far and the size of the file, or
+// -1 if its size cannot be found. The count starts again from zero if the
+// upload is retried.
+func UploadProgress(progress func(sent int64, total int64)) FileParamOption {
+	return func(file *apiform.File) {
+		file.Progress = progress
+	}
+}
+
+// FileParam returns a file for upload params such as [FileNewParams.File], which
+// is uploaded with the given filename and content type. If contentType is
+// empty, it is inferred from the extension of filename, or else from the first
+// 512 bytes of the file.
+//
+//	increase.F(increase.FileParam(image, "check_front.jpg", "image/jpeg"))
+func FileParam(reader io.Reader, filename string, contentType string, opts ...FileParamOption) io.Reader {
+	file := &apiform.File{Reader: reader, Filename: filename, ContentType: contentType}
+	for _, opt := range opts {
+		opt(file)
+	}
+	return file
+}
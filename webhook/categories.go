@@ -0,0 +1,343 @@
+package webhook
+
+import "github.com/increase/increase-go"
+
+// OnAccountCreated registers fn to handle `account.created` Events.
+func (h *Handler) OnAccountCreated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountCreated, fn)
+}
+
+// OnAccountUpdated registers fn to handle `account.updated` Events.
+func (h *Handler) OnAccountUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountUpdated, fn)
+}
+
+// OnAccountNumberCreated registers fn to handle `account_number.created` Events.
+func (h *Handler) OnAccountNumberCreated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountNumberCreated, fn)
+}
+
+// OnAccountNumberUpdated registers fn to handle `account_number.updated` Events.
+func (h *Handler) OnAccountNumberUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountNumberUpdated, fn)
+}
+
+// OnAccountStatementCreated registers fn to handle `account_statement.created` Events.
+func (h *Handler) OnAccountStatementCreated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountStatementCreated, fn)
+}
+
+// OnAccountTransferCreated registers fn to handle `account_transfer.created` Events.
+func (h *Handler) OnAccountTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountTransferCreated, fn)
+}
+
+// OnAccountTransferUpdated registers fn to handle `account_transfer.updated` Events.
+func (h *Handler) OnAccountTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryAccountTransferUpdated, fn)
+}
+
+// OnACHPrenotificationCreated registers fn to handle `ach_prenotification.created` Events.
+func (h *Handler) OnACHPrenotificationCreated(fn EventHandler) {
+	h.On(increase.EventCategoryACHPrenotificationCreated, fn)
+}
+
+// OnACHPrenotificationUpdated registers fn to handle `ach_prenotification.updated` Events.
+func (h *Handler) OnACHPrenotificationUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryACHPrenotificationUpdated, fn)
+}
+
+// OnACHTransferCreated registers fn to handle `ach_transfer.created` Events.
+func (h *Handler) OnACHTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryACHTransferCreated, fn)
+}
+
+// OnACHTransferUpdated registers fn to handle `ach_transfer.updated` Events.
+func (h *Handler) OnACHTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryACHTransferUpdated, fn)
+}
+
+// OnBookkeepingAccountCreated registers fn to handle `bookkeeping_account.created` Events.
+func (h *Handler) OnBookkeepingAccountCreated(fn EventHandler) {
+	h.On(increase.EventCategoryBookkeepingAccountCreated, fn)
+}
+
+// OnBookkeepingAccountUpdated registers fn to handle `bookkeeping_account.updated` Events.
+func (h *Handler) OnBookkeepingAccountUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryBookkeepingAccountUpdated, fn)
+}
+
+// OnBookkeepingEntrySetUpdated registers fn to handle `bookkeeping_entry_set.updated` Events.
+func (h *Handler) OnBookkeepingEntrySetUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryBookkeepingEntrySetUpdated, fn)
+}
+
+// OnCardCreated registers fn to handle `card.created` Events.
+func (h *Handler) OnCardCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCardCreated, fn)
+}
+
+// OnCardUpdated registers fn to handle `card.updated` Events.
+func (h *Handler) OnCardUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCardUpdated, fn)
+}
+
+// OnCardPaymentCreated registers fn to handle `card_payment.created` Events.
+func (h *Handler) OnCardPaymentCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCardPaymentCreated, fn)
+}
+
+// OnCardPaymentUpdated registers fn to handle `card_payment.updated` Events.
+func (h *Handler) OnCardPaymentUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCardPaymentUpdated, fn)
+}
+
+// OnCardProfileCreated registers fn to handle `card_profile.created` Events.
+func (h *Handler) OnCardProfileCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCardProfileCreated, fn)
+}
+
+// OnCardProfileUpdated registers fn to handle `card_profile.updated` Events.
+func (h *Handler) OnCardProfileUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCardProfileUpdated, fn)
+}
+
+// OnCardDisputeCreated registers fn to handle `card_dispute.created` Events.
+func (h *Handler) OnCardDisputeCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCardDisputeCreated, fn)
+}
+
+// OnCardDisputeUpdated registers fn to handle `card_dispute.updated` Events.
+func (h *Handler) OnCardDisputeUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCardDisputeUpdated, fn)
+}
+
+// OnCheckDepositCreated registers fn to handle `check_deposit.created` Events.
+func (h *Handler) OnCheckDepositCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCheckDepositCreated, fn)
+}
+
+// OnCheckDepositUpdated registers fn to handle `check_deposit.updated` Events.
+func (h *Handler) OnCheckDepositUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCheckDepositUpdated, fn)
+}
+
+// OnCheckTransferCreated registers fn to handle `check_transfer.created` Events.
+func (h *Handler) OnCheckTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryCheckTransferCreated, fn)
+}
+
+// OnCheckTransferUpdated registers fn to handle `check_transfer.updated` Events.
+func (h *Handler) OnCheckTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryCheckTransferUpdated, fn)
+}
+
+// OnDeclinedTransactionCreated registers fn to handle `declined_transaction.created` Events.
+func (h *Handler) OnDeclinedTransactionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryDeclinedTransactionCreated, fn)
+}
+
+// OnDigitalWalletTokenCreated registers fn to handle `digital_wallet_token.created` Events.
+func (h *Handler) OnDigitalWalletTokenCreated(fn EventHandler) {
+	h.On(increase.EventCategoryDigitalWalletTokenCreated, fn)
+}
+
+// OnDigitalWalletTokenUpdated registers fn to handle `digital_wallet_token.updated` Events.
+func (h *Handler) OnDigitalWalletTokenUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryDigitalWalletTokenUpdated, fn)
+}
+
+// OnDocumentCreated registers fn to handle `document.created` Events.
+func (h *Handler) OnDocumentCreated(fn EventHandler) {
+	h.On(increase.EventCategoryDocumentCreated, fn)
+}
+
+// OnEntityCreated registers fn to handle `entity.created` Events.
+func (h *Handler) OnEntityCreated(fn EventHandler) {
+	h.On(increase.EventCategoryEntityCreated, fn)
+}
+
+// OnEntityUpdated registers fn to handle `entity.updated` Events.
+func (h *Handler) OnEntityUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryEntityUpdated, fn)
+}
+
+// OnEventSubscriptionCreated registers fn to handle `event_subscription.created` Events.
+func (h *Handler) OnEventSubscriptionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryEventSubscriptionCreated, fn)
+}
+
+// OnEventSubscriptionUpdated registers fn to handle `event_subscription.updated` Events.
+func (h *Handler) OnEventSubscriptionUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryEventSubscriptionUpdated, fn)
+}
+
+// OnExportCreated registers fn to handle `export.created` Events.
+func (h *Handler) OnExportCreated(fn EventHandler) {
+	h.On(increase.EventCategoryExportCreated, fn)
+}
+
+// OnExportUpdated registers fn to handle `export.updated` Events.
+func (h *Handler) OnExportUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryExportUpdated, fn)
+}
+
+// OnExternalAccountCreated registers fn to handle `external_account.created` Events.
+func (h *Handler) OnExternalAccountCreated(fn EventHandler) {
+	h.On(increase.EventCategoryExternalAccountCreated, fn)
+}
+
+// OnExternalAccountUpdated registers fn to handle `external_account.updated` Events.
+func (h *Handler) OnExternalAccountUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryExternalAccountUpdated, fn)
+}
+
+// OnFileCreated registers fn to handle `file.created` Events.
+func (h *Handler) OnFileCreated(fn EventHandler) {
+	h.On(increase.EventCategoryFileCreated, fn)
+}
+
+// OnGroupUpdated registers fn to handle `group.updated` Events.
+func (h *Handler) OnGroupUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryGroupUpdated, fn)
+}
+
+// OnGroupHeartbeat registers fn to handle `group.heartbeat` Events.
+func (h *Handler) OnGroupHeartbeat(fn EventHandler) {
+	h.On(increase.EventCategoryGroupHeartbeat, fn)
+}
+
+// OnInboundACHTransferCreated registers fn to handle `inbound_ach_transfer.created` Events.
+func (h *Handler) OnInboundACHTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryInboundACHTransferCreated, fn)
+}
+
+// OnInboundACHTransferUpdated registers fn to handle `inbound_ach_transfer.updated` Events.
+func (h *Handler) OnInboundACHTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryInboundACHTransferUpdated, fn)
+}
+
+// OnInboundACHTransferReturnCreated registers fn to handle `inbound_ach_transfer_return.created` Events.
+func (h *Handler) OnInboundACHTransferReturnCreated(fn EventHandler) {
+	h.On(increase.EventCategoryInboundACHTransferReturnCreated, fn)
+}
+
+// OnInboundACHTransferReturnUpdated registers fn to handle `inbound_ach_transfer_return.updated` Events.
+func (h *Handler) OnInboundACHTransferReturnUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryInboundACHTransferReturnUpdated, fn)
+}
+
+// OnInboundWireDrawdownRequestCreated registers fn to handle `inbound_wire_drawdown_request.created` Events.
+func (h *Handler) OnInboundWireDrawdownRequestCreated(fn EventHandler) {
+	h.On(increase.EventCategoryInboundWireDrawdownRequestCreated, fn)
+}
+
+// OnIntrafiAccountEnrollmentCreated registers fn to handle `intrafi_account_enrollment.created` Events.
+func (h *Handler) OnIntrafiAccountEnrollmentCreated(fn EventHandler) {
+	h.On(increase.EventCategoryIntrafiAccountEnrollmentCreated, fn)
+}
+
+// OnIntrafiAccountEnrollmentUpdated registers fn to handle `intrafi_account_enrollment.updated` Events.
+func (h *Handler) OnIntrafiAccountEnrollmentUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryIntrafiAccountEnrollmentUpdated, fn)
+}
+
+// OnIntrafiExclusionCreated registers fn to handle `intrafi_exclusion.created` Events.
+func (h *Handler) OnIntrafiExclusionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryIntrafiExclusionCreated, fn)
+}
+
+// OnIntrafiExclusionUpdated registers fn to handle `intrafi_exclusion.updated` Events.
+func (h *Handler) OnIntrafiExclusionUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryIntrafiExclusionUpdated, fn)
+}
+
+// OnOauthConnectionCreated registers fn to handle `oauth_connection.created` Events.
+func (h *Handler) OnOauthConnectionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryOauthConnectionCreated, fn)
+}
+
+// OnOauthConnectionDeactivated registers fn to handle `oauth_connection.deactivated` Events.
+func (h *Handler) OnOauthConnectionDeactivated(fn EventHandler) {
+	h.On(increase.EventCategoryOauthConnectionDeactivated, fn)
+}
+
+// OnPendingTransactionCreated registers fn to handle `pending_transaction.created` Events.
+func (h *Handler) OnPendingTransactionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryPendingTransactionCreated, fn)
+}
+
+// OnPendingTransactionUpdated registers fn to handle `pending_transaction.updated` Events.
+func (h *Handler) OnPendingTransactionUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryPendingTransactionUpdated, fn)
+}
+
+// OnPhysicalCardCreated registers fn to handle `physical_card.created` Events.
+func (h *Handler) OnPhysicalCardCreated(fn EventHandler) {
+	h.On(increase.EventCategoryPhysicalCardCreated, fn)
+}
+
+// OnPhysicalCardUpdated registers fn to handle `physical_card.updated` Events.
+func (h *Handler) OnPhysicalCardUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryPhysicalCardUpdated, fn)
+}
+
+// OnRealTimeDecisionCardAuthorizationRequested registers fn to handle `real_time_decision.card_authorization_requested` Events.
+func (h *Handler) OnRealTimeDecisionCardAuthorizationRequested(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimeDecisionCardAuthorizationRequested, fn)
+}
+
+// OnRealTimeDecisionDigitalWalletTokenRequested registers fn to handle `real_time_decision.digital_wallet_token_requested` Events.
+func (h *Handler) OnRealTimeDecisionDigitalWalletTokenRequested(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimeDecisionDigitalWalletTokenRequested, fn)
+}
+
+// OnRealTimeDecisionDigitalWalletAuthenticationRequested registers fn to handle `real_time_decision.digital_wallet_authentication_requested` Events.
+func (h *Handler) OnRealTimeDecisionDigitalWalletAuthenticationRequested(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimeDecisionDigitalWalletAuthenticationRequested, fn)
+}
+
+// OnRealTimePaymentsTransferCreated registers fn to handle `real_time_payments_transfer.created` Events.
+func (h *Handler) OnRealTimePaymentsTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimePaymentsTransferCreated, fn)
+}
+
+// OnRealTimePaymentsTransferUpdated registers fn to handle `real_time_payments_transfer.updated` Events.
+func (h *Handler) OnRealTimePaymentsTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimePaymentsTransferUpdated, fn)
+}
+
+// OnRealTimePaymentsRequestForPaymentCreated registers fn to handle `real_time_payments_request_for_payment.created` Events.
+func (h *Handler) OnRealTimePaymentsRequestForPaymentCreated(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimePaymentsRequestForPaymentCreated, fn)
+}
+
+// OnRealTimePaymentsRequestForPaymentUpdated registers fn to handle `real_time_payments_request_for_payment.updated` Events.
+func (h *Handler) OnRealTimePaymentsRequestForPaymentUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryRealTimePaymentsRequestForPaymentUpdated, fn)
+}
+
+// OnTransactionCreated registers fn to handle `transaction.created` Events.
+func (h *Handler) OnTransactionCreated(fn EventHandler) {
+	h.On(increase.EventCategoryTransactionCreated, fn)
+}
+
+// OnWireDrawdownRequestCreated registers fn to handle `wire_drawdown_request.created` Events.
+func (h *Handler) OnWireDrawdownRequestCreated(fn EventHandler) {
+	h.On(increase.EventCategoryWireDrawdownRequestCreated, fn)
+}
+
+// OnWireDrawdownRequestUpdated registers fn to handle `wire_drawdown_request.updated` Events.
+func (h *Handler) OnWireDrawdownRequestUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryWireDrawdownRequestUpdated, fn)
+}
+
+// OnWireTransferCreated registers fn to handle `wire_transfer.created` Events.
+func (h *Handler) OnWireTransferCreated(fn EventHandler) {
+	h.On(increase.EventCategoryWireTransferCreated, fn)
+}
+
+// OnWireTransferUpdated registers fn to handle `wire_transfer.updated` Events.
+func (h *Handler) OnWireTransferUpdated(fn EventHandler) {
+	h.On(increase.EventCategoryWireTransferUpdated, fn)
+}
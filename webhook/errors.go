@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+)
+
+// SignatureError is returned when a webhook's signature header is missing or
+// malformed, or none of its signatures match the body.
+type SignatureError struct {
+	Reason string
+}
+
+func (e *SignatureError) Error() string {
+	return "webhook: invalid signature: " + e.Reason
+}
+
+// TimestampError is returned when the timestamp of a webhook's signature is
+// further from the current time than the verifier's tolerance.
+type TimestampError struct {
+	Timestamp time.Time
+	Tolerance time.Duration
+}
+
+func (e *TimestampError) Error() string {
+	return fmt.Sprintf("webhook: timestamp %s is not within %s of the current time", e.Timestamp.Format(time.RFC3339), e.Tolerance)
+}
+
+// ReplayError is returned when a webhook with the same signature has already
+// been verified.
+type ReplayError struct {
+	Signature string
+}
+
+func (e *ReplayError) Error() string {
+	return "webhook: the webhook has already been received"
+}
+
+// PayloadError is returned when the body of a correctly signed webhook is not a
+// valid Event.
+type PayloadError struct {
+	Err error
+}
+
+func (e *PayloadError) Error() string {
+	return "webhook: invalid event: " + e.Err.Error()
+}
+
+func (e *PayloadError) Unwrap() error {
+	return e.Err
+}
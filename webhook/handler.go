@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/increase/increase-go"
+)
+
+// DefaultDeduplicationWindow is how long a Handler remembers the Events it has
+// handled unless [WithDeduplication] is given.
+const DefaultDeduplicationWindow = 72 * time.Hour
+
+// EventHandler handles an Event. If it returns an error, the webhook is
+// answered with a 500 status, so Increase sends it again later.
+type EventHandler func(ctx context.Context, event *increase.Event) error
+
+// Handler is an [http.Handler] for an Event Subscription's webhooks, which
+// verifies each webhook and calls the EventHandler registered for its Event's
+// category.
+//
+// It answers webhooks with the following statuses:
+//   - 200 once the Event has been handled, or if it has already been handled,
+//     has no EventHandler, or is a `group.heartbeat`. A webhook which is sent
+//     again after its Event has been handled is answered with a 200, rather
+//     than rejected as a replay, unless deduplication is disabled.
+//   - 400 if the webhook cannot be verified or its body is not an Event, which
+//     sending it again would not change.
+//   - 405 for requests which are not POSTs.
+//   - 500 if the EventHandler returns an error, or the deduplication Store
+//     fails, so that Increase sends the webhook again later. An EventHandler
+//     which panics has the same effect.
+type Handler struct {
+	verifier *Verifier
+	handlers map[increase.EventCategory]EventHandler
+	fallback EventHandler
+	events   Store
+	window   time.Duration
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*Handler)
+
+// WithDeduplication sets the Store in which a Handler records the IDs of the
+// Events it has handled, and for how long, so that each Event is handled once
+// even if it is delivered more than once. By default they are recorded in a
+// [MemoryStore] for [DefaultDeduplicationWindow]. A nil Store disables
+// deduplication.
+func WithDeduplication(store Store, window time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.events = store
+		h.window = window
+	}
+}
+
+// NewHandler returns a Handler which verifies webhooks with verifier.
+func NewHandler(verifier *Verifier, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		verifier: verifier,
+		handlers: map[increase.EventCategory]EventHandler{},
+		events:   NewMemoryStore(),
+		window:   DefaultDeduplicationWindow,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// On registers fn to handle Events of the given category, replacing any
+// EventHandler already registered for it.
+func (h *Handler) On(category increase.EventCategory, fn EventHandler) {
+	h.handlers[category] = fn
+}
+
+// OnOther registers fn to handle Events whose category has no other
+// EventHandler, including categories added to the API after this SDK.
+// `group.heartbeat` Events are only passed to an EventHandler registered for
+// them.
+func (h *Handler) OnOther(fn EventHandler) {
+	h.fallback = fn
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	body, err := readBody(r)
+	var event *increase.Event
+	var signature verifiedSignature
+	if err == nil {
+		event, signature, err = h.verifier.verify(r.Header, body)
+	}
+	if err == nil && h.events == nil {
+		// Deduplicating Events also rejects replayed webhooks, and answers
+		// them with a 200 once the Event has been handled, so the Verifier's
+		// replay protection is only needed without it.
+		err = h.verifier.record(ctx, signature)
+	}
+	if err != nil {
+		var signatureErr *SignatureError
+		var timestampErr *TimestampError
+		var replayErr *ReplayError
+		var payloadErr *PayloadError
+		switch {
+		case errors.As(err, &signatureErr), errors.As(err, &timestampErr), errors.As(err, &replayErr), errors.As(err, &payloadErr):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, "failed to verify webhook", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	fn, ok := h.handlers[event.Category]
+	if !ok && event.Category != increase.EventCategoryGroupHeartbeat {
+		fn = h.fallback
+	}
+	if fn == nil {
+		// Heartbeats, and other Events without an EventHandler, only need to
+		// be acknowledged.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	key := "event:" + event.ID
+	if h.events != nil {
+		added, err := h.events.Add(ctx, key, time.Now().Add(h.window))
+		if err != nil {
+			http.Error(w, "failed to record event", http.StatusInternalServerError)
+			return
+		}
+		if !added {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	handled := false
+	defer func() {
+		if handled {
+			return
+		}
+		// Forget the Event, or the webhook's signature, so that the Event is
+		// handled when it is sent again.
+		if h.events != nil {
+			h.events.Remove(context.WithoutCancel(ctx), key)
+		} else {
+			h.verifier.Release(context.WithoutCancel(ctx), r.Header, body)
+		}
+	}()
+	if err := fn(ctx, event); err != nil {
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+	handled = true
+	w.WriteHeader(http.StatusOK)
+}
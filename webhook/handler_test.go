@@ -0,0 +1,146 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/webhook"
+)
+
+// deliver sends a webhook with the given body to h, signed at the current time
+// plus offset, and returns the response status.
+func deliver(h http.Handler, body string, offset time.Duration) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header = signedHeader(time.Now().Add(offset), body)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestHandlerRoutesByCategory(t *testing.T) {
+	var created, other []string
+	h := webhook.NewHandler(webhook.NewVerifier(secret))
+	h.OnAccountCreated(func(ctx context.Context, event *increase.Event) error {
+		created = append(created, event.ID)
+		return nil
+	})
+	h.OnOther(func(ctx context.Context, event *increase.Event) error {
+		other = append(other, string(event.Category))
+		return nil
+	})
+
+	if status := deliver(h, eventBody, 0); status != 200 {
+		t.Errorf("expected 200, got %d", status)
+	}
+	updated := strings.Replace(eventBody, "account.created", "account.updated", 1)
+	if status := deliver(h, updated, 0); status != 200 {
+		t.Errorf("expected 200, got %d", status)
+	}
+	unknown := strings.NewReplacer("account.created", "account.frozen", "event_001", "event_002").Replace(eventBody)
+	if status := deliver(h, unknown, 0); status != 200 {
+		t.Errorf("expected 200, got %d", status)
+	}
+	heartbeat := strings.NewReplacer("account.created", "group.heartbeat", "event_001", "event_003").Replace(eventBody)
+	if status := deliver(h, heartbeat, 0); status != 200 {
+		t.Errorf("expected 200 for a heartbeat, got %d", status)
+	}
+
+	if len(created) != 1 || created[0] != "event_001dzz0r20rzr4zrhrr1364hy80" {
+		t.Errorf("expected the account.created handler to be called once, got %v", created)
+	}
+	// account.updated has the same Event ID as account.created, so it is a
+	// duplicate.
+	if len(other) != 1 || other[0] != "account.frozen" {
+		t.Errorf("expected the fallback to be called for account.frozen only, got %v", other)
+	}
+}
+
+func TestHandlerDeduplicates(t *testing.T) {
+	calls := 0
+	h := webhook.NewHandler(webhook.NewVerifier(secret))
+	h.OnAccountCreated(func(ctx context.Context, event *increase.Event) error {
+		calls += 1
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	if status := deliver(h, eventBody, 0); status != 500 {
+		t.Errorf("expected a failed Event to return 500, got %d", status)
+	}
+	if status := deliver(h, eventBody, time.Second); status != 200 {
+		t.Errorf("expected a retried Event to return 200, got %d", status)
+	}
+	if status := deliver(h, eventBody, 2*time.Second); status != 200 {
+		t.Errorf("expected a duplicate Event to return 200, got %d", status)
+	}
+	if calls != 2 {
+		t.Errorf("expected the Event to be handled until it succeeded, got %d calls", calls)
+	}
+}
+
+func TestHandlerRedelivery(t *testing.T) {
+	for name, opts := range map[string][]webhook.HandlerOption{
+		"deduplicated": nil,
+		"replay store": {webhook.WithDeduplication(nil, 0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			h := webhook.NewHandler(webhook.NewVerifier(secret), opts...)
+			h.OnAccountCreated(func(ctx context.Context, event *increase.Event) error {
+				calls += 1
+				if calls == 1 {
+					return errors.New("database unavailable")
+				}
+				return nil
+			})
+
+			// Increase sends exactly the same request again.
+			header := signedHeader(time.Now(), eventBody)
+			var statuses []int
+			for i := 0; i < 3; i++ {
+				req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(eventBody))
+				req.Header = header.Clone()
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				statuses = append(statuses, rec.Code)
+			}
+			expected := "[500 200 200]"
+			if name == "replay store" {
+				expected = "[500 200 400]"
+			}
+			if fmt.Sprint(statuses) != expected {
+				t.Errorf("expected %s, got %v", expected, statuses)
+			}
+			if calls != 2 {
+				t.Errorf("expected the Event to be handled until it succeeded, got %d calls", calls)
+			}
+		})
+	}
+}
+
+func TestHandlerRejectsInvalidWebhooks(t *testing.T) {
+	h := webhook.NewHandler(webhook.NewVerifier(secret))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(eventBody))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign("whsec_other", time.Now(), []byte(eventBody)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != 400 {
+		t.Errorf("expected 400 for a wrong signature, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhooks", nil))
+	if rec.Code != 405 {
+		t.Errorf("expected 405 for a GET, got %d", rec.Code)
+	}
+}
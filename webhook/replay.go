@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/option"
+)
+
+// ReplayConfig configures [Replay].
+type ReplayConfig struct {
+	// URL is the webhook endpoint to send the Events to.
+	URL string
+	// Secret is the shared secret which the endpoint verifies webhooks with.
+	Secret string
+	// Categories, if not empty, limits the Events sent to those with one of the
+	// categories.
+	Categories []increase.EventCategory
+	// EventID, if not empty, limits the Events sent to the one with this ID.
+	EventID string
+	// HTTPClient sends the webhooks. It is [http.DefaultClient] by default.
+	HTTPClient *http.Client
+}
+
+// ReplayResult is the response of the webhook endpoint to an Event sent by
+// [Replay].
+type ReplayResult struct {
+	Event      increase.Event
+	StatusCode int
+}
+
+// Replay sends Events to a webhook endpoint, signed with a secret as Increase
+// signs them, in the order they were created. It lets webhook handlers be
+// tested with real Events, such as those listed by [ListEvents] from the
+// sandbox, or read by [ReadEvents] from a file. Each Event is sent once, and
+// responses with error statuses are reported in the results rather than
+// stopping the replay. Replay stops if an Event cannot be sent at all.
+func Replay(ctx context.Context, events []increase.Event, config ReplayConfig) (results []ReplayResult, err error) {
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	categories := map[increase.EventCategory]bool{}
+	for _, category := range config.Categories {
+		categories[category] = true
+	}
+
+	var selected []increase.Event
+	for _, event := range events {
+		if config.EventID != "" && event.ID != config.EventID {
+			continue
+		}
+		if len(categories) > 0 && !categories[event.Category] {
+			continue
+		}
+		selected = append(selected, event)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].CreatedAt.Before(selected[j].CreatedAt)
+	})
+
+	for _, event := range selected {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return results, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(body))
+		if err != nil {
+			return results, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(SignatureHeader, Sign(config.Secret, time.Now(), body))
+		res, err := client.Do(req)
+		if err != nil {
+			return results, fmt.Errorf("webhook: sending %s: %w", event.ID, err)
+		}
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		results = append(results, ReplayResult{Event: event, StatusCode: res.StatusCode})
+	}
+	return results, nil
+}
+
+// ListEvents returns every Event listed by [increase.EventService.List] with
+// the given query.
+func ListEvents(ctx context.Context, events *increase.EventService, query increase.EventListParams, opts ...option.RequestOption) ([]increase.Event, error) {
+	var list []increase.Event
+	iter := events.ListAutoPaging(ctx, query, opts...)
+	for iter.Next() {
+		list = append(list, iter.Current())
+	}
+	return list, iter.Err()
+}
+
+// ReadEvents reads Events from JSON Lines, with one Event object on each line.
+// Blank lines are skipped.
+func ReadEvents(r io.Reader) ([]increase.Event, error) {
+	var events []increase.Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxBodySize)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var event increase.Event
+		if err := json.Unmarshal([]byte(text), &event); err != nil {
+			return nil, fmt.Errorf("webhook: line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
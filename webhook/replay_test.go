@@ -0,0 +1,67 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/webhook"
+)
+
+const eventLines = `{"id":"event_3","associated_object_id":"account_in71c4amph0vgo2qllky","associated_object_type":"account","category":"account.updated","created_at":"2020-01-31T23:59:59Z","type":"event"}
+
+{"id":"event_1","associated_object_id":"account_in71c4amph0vgo2qllky","associated_object_type":"account","category":"account.created","created_at":"2020-01-31T23:59:57Z","type":"event"}
+{"id":"event_2","associated_object_id":"transaction_uyrp7fld2ium70oa7oi","associated_object_type":"transaction","category":"transaction.created","created_at":"2020-01-31T23:59:58Z","type":"event"}
+`
+
+func TestReplay(t *testing.T) {
+	events, err := webhook.ReadEvents(strings.NewReader(eventLines))
+	if err != nil {
+		t.Fatalf("expected the events to be read, got %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+
+	var received []string
+	handler := webhook.NewHandler(webhook.NewVerifier(secret))
+	handler.OnOther(func(ctx context.Context, event *increase.Event) error {
+		received = append(received, event.ID)
+		return nil
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	results, err := webhook.Replay(context.Background(), events, webhook.ReplayConfig{
+		URL:        server.URL,
+		Secret:     secret,
+		Categories: []increase.EventCategory{increase.EventCategoryAccountCreated, increase.EventCategoryAccountUpdated},
+	})
+	if err != nil {
+		t.Fatalf("expected the events to be replayed, got %v", err)
+	}
+	if len(results) != 2 || results[0].StatusCode != 200 || results[1].StatusCode != 200 {
+		t.Errorf("expected 2 events to be accepted, got %+v", results)
+	}
+	if strings.Join(received, ",") != "event_1,event_3" {
+		t.Errorf("expected the account events in order of creation, got %v", received)
+	}
+
+	results, err = webhook.Replay(context.Background(), events, webhook.ReplayConfig{
+		URL:     server.URL,
+		Secret:  "whsec_other",
+		EventID: "event_2",
+	})
+	if err != nil || len(results) != 1 || results[0].StatusCode != 400 {
+		t.Errorf("expected event_2 to be rejected with the wrong secret, got %+v and %v", results, err)
+	}
+}
+
+func TestReadEventsInvalid(t *testing.T) {
+	_, err := webhook.ReadEvents(strings.NewReader("{\"id\":\"event_1\"}\nnot json\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error for line 2, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store records keys until they expire. It is used to reject replayed webhooks
+// and to avoid handling an Event more than once. Implementations backed by a
+// shared database or cache let several servers share what they have seen.
+type Store interface {
+	// Add records key until expires, reporting false if it was already
+	// recorded and has not expired.
+	Add(ctx context.Context, key string, expires time.Time) (added bool, err error)
+	// Remove forgets key.
+	Remove(ctx context.Context, key string) error
+}
+
+// MemoryStore is a Store in memory, which is only shared within a process.
+type MemoryStore struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+	// The number of keys at which expired keys are next removed.
+	sweepAt int
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{keys: map[string]time.Time{}, sweepAt: 1024}
+}
+
+func (s *MemoryStore) Add(ctx context.Context, key string, expires time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if len(s.keys) >= s.sweepAt {
+		for k, exp := range s.keys {
+			if !exp.After(now) {
+				delete(s.keys, k)
+			}
+		}
+		s.sweepAt = max(1024, 2*len(s.keys))
+	}
+	if exp, ok := s.keys[key]; ok && exp.After(now) {
+		return false, nil
+	}
+	s.keys[key] = expires
+	return true, nil
+}
+
+func (s *MemoryStore) Remove(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+	return nil
+}
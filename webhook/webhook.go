@@ -0,0 +1,245 @@
+// Package webhook verifies and parses the webhooks which Increase sends to
+// Event Subscriptions.
+//
+// Each webhook is signed with the shared secret of its Event Subscription. The
+// signature is sent in the Increase-Webhook-Signature header, in the form
+// "t=2020-01-31T23:59:59Z,v1=<signature>", where the signature is the
+// hex-encoded HMAC-SHA256 of the timestamp, a period and the body.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/increase/increase-go"
+)
+
+// SignatureHeader is the header which holds the signature of a webhook.
+const SignatureHeader = "Increase-Webhook-Signature"
+
+// DefaultTolerance is how far the timestamp of a webhook's signature may be
+// from the current time unless [WithTolerance] is given.
+const DefaultTolerance = 5 * time.Minute
+
+// The largest webhook body which VerifyRequest reads.
+const maxBodySize = 1 << 20
+
+// Verifier verifies the signatures of webhooks and parses their Events.
+type Verifier struct {
+	secret    []byte
+	tolerance time.Duration
+	replays   Store
+	now       func() time.Time
+}
+
+// Option configures a Verifier.
+type Option func(*Verifier)
+
+// WithTolerance sets how far the timestamp of a webhook's signature may be from
+// the current time, which is [DefaultTolerance] by default.
+func WithTolerance(tolerance time.Duration) Option {
+	return func(v *Verifier) {
+		v.tolerance = tolerance
+	}
+}
+
+// WithReplayStore sets the Store which records the signatures of verified
+// webhooks, so that a webhook sent again is rejected. By default they are
+// recorded in a [MemoryStore]. A nil Store disables replay protection.
+func WithReplayStore(store Store) Option {
+	return func(v *Verifier) {
+		v.replays = store
+	}
+}
+
+// NewVerifier returns a Verifier for the webhooks of an Event Subscription with
+// the given shared secret.
+func NewVerifier(secret string, opts ...Option) *Verifier {
+	v := &Verifier{
+		secret:    []byte(secret),
+		tolerance: DefaultTolerance,
+		replays:   NewMemoryStore(),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify checks the signature of a webhook with the given headers and body,
+// and returns its Event. It returns a [*SignatureError] if the signature is
+// missing or wrong, a [*TimestampError] if it is too old or too far in the
+// future, a [*PayloadError] if the body is not an Event, and a [*ReplayError]
+// if the webhook has already been verified. The signature of a webhook is only
+// recorded once its body has been parsed; if the Event then cannot be handled,
+// [Verifier.Release] forgets it, so that the webhook can be verified again when
+// it is sent again.
+func (v *Verifier) Verify(ctx context.Context, header http.Header, body []byte) (*increase.Event, error) {
+	event, signature, err := v.verify(header, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.record(ctx, signature); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// VerifyRequest reads the body of a webhook request and verifies it as with
+// [Verifier.Verify].
+func (v *Verifier) VerifyRequest(r *http.Request) (*increase.Event, error) {
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	return v.Verify(r.Context(), r.Header, body)
+}
+
+// Release forgets the signature of a webhook which has been verified, so that
+// it is accepted by Verify again. It is called when the webhook's Event could
+// not be handled, so that Increase's next delivery of it is not rejected as a
+// replay.
+func (v *Verifier) Release(ctx context.Context, header http.Header, body []byte) error {
+	if v.replays == nil {
+		return nil
+	}
+	timestamp, _, err := parseSignatureHeader(header.Get(SignatureHeader))
+	if err != nil {
+		return err
+	}
+	return v.replays.Remove(ctx, "signature:"+sign(v.secret, timestamp.raw, body))
+}
+
+// verifiedSignature is the signature of a webhook which has been verified.
+type verifiedSignature struct {
+	value     string
+	timestamp time.Time
+}
+
+// verify checks the signature and timestamp of a webhook and parses its Event,
+// without checking whether it has already been verified.
+func (v *Verifier) verify(header http.Header, body []byte) (*increase.Event, verifiedSignature, error) {
+	value := header.Get(SignatureHeader)
+	if value == "" {
+		return nil, verifiedSignature{}, &SignatureError{Reason: "missing " + SignatureHeader + " header"}
+	}
+	timestamp, signatures, err := parseSignatureHeader(value)
+	if err != nil {
+		return nil, verifiedSignature{}, err
+	}
+	expected := sign(v.secret, timestamp.raw, body)
+	matched := false
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			matched = true
+		}
+	}
+	if !matched {
+		return nil, verifiedSignature{}, &SignatureError{Reason: "no signature matches the body"}
+	}
+
+	now := v.now()
+	if d := now.Sub(timestamp.time); d > v.tolerance || d < -v.tolerance {
+		return nil, verifiedSignature{}, &TimestampError{Timestamp: timestamp.time, Tolerance: v.tolerance}
+	}
+
+	event := &increase.Event{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, verifiedSignature{}, &PayloadError{Err: err}
+	}
+	if event.ID == "" || event.Category == "" {
+		return nil, verifiedSignature{}, &PayloadError{Err: errors.New("missing id or category")}
+	}
+	return event, verifiedSignature{value: expected, timestamp: timestamp.time}, nil
+}
+
+// record records a verified signature in the replay Store, returning a
+// [*ReplayError] if it was already recorded.
+func (v *Verifier) record(ctx context.Context, signature verifiedSignature) error {
+	if v.replays == nil {
+		return nil
+	}
+	added, err := v.replays.Add(ctx, "signature:"+signature.value, signature.timestamp.Add(v.tolerance))
+	if err != nil {
+		return err
+	}
+	if !added {
+		return &ReplayError{Signature: signature.value}
+	}
+	return nil
+}
+
+// readBody reads the body of a webhook request, up to maxBodySize bytes.
+func readBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxBodySize {
+		return nil, &PayloadError{Err: fmt.Errorf("body is larger than %d bytes", maxBodySize)}
+	}
+	return body, nil
+}
+
+// Sign returns the value of the signature header for a webhook with the given
+// body sent at time t, signed with an Event Subscription's shared secret. It
+// can be used to test webhook handlers.
+func Sign(secret string, t time.Time, body []byte) string {
+	timestamp := t.UTC().Format(time.RFC3339)
+	return "t=" + timestamp + ",v1=" + sign([]byte(secret), timestamp, body)
+}
+
+func sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type signatureTimestamp struct {
+	raw  string
+	time time.Time
+}
+
+// parseSignatureHeader parses the timestamp and the v1 signatures from a
+// signature header. Timestamps may be RFC 3339 times or Unix times in seconds.
+func parseSignatureHeader(value string) (timestamp signatureTimestamp, signatures []string, err error) {
+	for _, part := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp.raw = val
+		case "v1":
+			signatures = append(signatures, val)
+		}
+	}
+	if timestamp.raw == "" {
+		return timestamp, nil, &SignatureError{Reason: "missing timestamp"}
+	}
+	if len(signatures) == 0 {
+		return timestamp, nil, &SignatureError{Reason: "missing v1 signature"}
+	}
+	if timestamp.time, err = time.Parse(time.RFC3339, timestamp.raw); err != nil {
+		seconds, perr := strconv.ParseInt(timestamp.raw, 10, 64)
+		if perr != nil {
+			return timestamp, nil, &SignatureError{Reason: fmt.Sprintf("invalid timestamp %q", timestamp.raw)}
+		}
+		timestamp.time = time.Unix(seconds, 0)
+	}
+	return timestamp, signatures, nil
+}
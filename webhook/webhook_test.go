@@ -0,0 +1,142 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/increase/increase-go"
+	"github.com/increase/increase-go/webhook"
+)
+
+const secret = "whsec_Xa9tSx7cVUdEgvLJ"
+
+const eventBody = `{"id":"event_001dzz0r20rzr4zrhrr1364hy80","associated_object_id":"account_in71c4amph0vgo2qllky","associated_object_type":"account","category":"account.created","created_at":"2020-01-31T23:59:59Z","type":"event"}`
+
+func signedHeader(t time.Time, body string) http.Header {
+	return http.Header{webhook.SignatureHeader: {webhook.Sign(secret, t, []byte(body))}}
+}
+
+func TestVerify(t *testing.T) {
+	v := webhook.NewVerifier(secret)
+	event, err := v.Verify(context.Background(), signedHeader(time.Now(), eventBody), []byte(eventBody))
+	if err != nil {
+		t.Fatalf("expected the webhook to be verified, got %v", err)
+	}
+	if event.ID != "event_001dzz0r20rzr4zrhrr1364hy80" || event.Category != increase.EventCategoryAccountCreated {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	now := time.Now()
+	cases := map[string]struct {
+		header http.Header
+		body   string
+		check  func(error) bool
+	}{
+		"missing header": {
+			header: http.Header{},
+			body:   eventBody,
+			check:  func(err error) bool { var e *webhook.SignatureError; return errors.As(err, &e) },
+		},
+		"wrong secret": {
+			header: http.Header{webhook.SignatureHeader: {webhook.Sign("whsec_other", now, []byte(eventBody))}},
+			body:   eventBody,
+			check:  func(err error) bool { var e *webhook.SignatureError; return errors.As(err, &e) },
+		},
+		"modified body": {
+			header: signedHeader(now, eventBody),
+			body:   eventBody + " ",
+			check:  func(err error) bool { var e *webhook.SignatureError; return errors.As(err, &e) },
+		},
+		"stale timestamp": {
+			header: signedHeader(now.Add(-10*time.Minute), eventBody),
+			body:   eventBody,
+			check:  func(err error) bool { var e *webhook.TimestampError; return errors.As(err, &e) },
+		},
+		"future timestamp": {
+			header: signedHeader(now.Add(10*time.Minute), eventBody),
+			body:   eventBody,
+			check:  func(err error) bool { var e *webhook.TimestampError; return errors.As(err, &e) },
+		},
+		"malformed body": {
+			header: signedHeader(now, `{"id":`),
+			body:   `{"id":`,
+			check:  func(err error) bool { var e *webhook.PayloadError; return errors.As(err, &e) },
+		},
+		"not an event": {
+			header: signedHeader(now, `{"foo":"bar"}`),
+			body:   `{"foo":"bar"}`,
+			check:  func(err error) bool { var e *webhook.PayloadError; return errors.As(err, &e) },
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := webhook.NewVerifier(secret).Verify(context.Background(), c.header, []byte(c.body))
+			if !c.check(err) {
+				t.Errorf("expected a different error, got %T %v", err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsReplays(t *testing.T) {
+	v := webhook.NewVerifier(secret)
+	header := signedHeader(time.Now(), eventBody)
+	if _, err := v.Verify(context.Background(), header, []byte(eventBody)); err != nil {
+		t.Fatalf("expected the first delivery to be verified, got %v", err)
+	}
+	_, err := v.Verify(context.Background(), header, []byte(eventBody))
+	var replay *webhook.ReplayError
+	if !errors.As(err, &replay) {
+		t.Errorf("expected a ReplayError, got %v", err)
+	}
+
+	// A delivery signed at another time is not a replay.
+	if _, err := v.Verify(context.Background(), signedHeader(time.Now().Add(time.Second), eventBody), []byte(eventBody)); err != nil {
+		t.Errorf("expected a new delivery to be verified, got %v", err)
+	}
+}
+
+func TestVerifyRelease(t *testing.T) {
+	v := webhook.NewVerifier(secret)
+	header := signedHeader(time.Now(), eventBody)
+
+	// A body which is not an Event does not use up its signature.
+	malformed := `{"id":"event_001dzz0r20rzr4zrhrr1364hy80"`
+	malformedHeader := signedHeader(time.Now(), malformed)
+	for i := 0; i < 2; i++ {
+		_, err := v.Verify(context.Background(), malformedHeader, []byte(malformed))
+		var payload *webhook.PayloadError
+		if !errors.As(err, &payload) {
+			t.Fatalf("expected a PayloadError, got %v", err)
+		}
+	}
+
+	if _, err := v.Verify(context.Background(), header, []byte(eventBody)); err != nil {
+		t.Fatalf("expected the first delivery to be verified, got %v", err)
+	}
+	if err := v.Release(context.Background(), header, []byte(eventBody)); err != nil {
+		t.Fatalf("err should be nil: %s", err.Error())
+	}
+	if _, err := v.Verify(context.Background(), header, []byte(eventBody)); err != nil {
+		t.Errorf("expected the released webhook to be verified again, got %v", err)
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(eventBody))
+	req.Header = signedHeader(time.Now(), eventBody)
+	event, err := webhook.NewVerifier(secret, webhook.WithTolerance(time.Minute)).VerifyRequest(req)
+	if err != nil {
+		t.Fatalf("expected the request to be verified, got %v", err)
+	}
+	if event.AssociatedObjectID != "account_in71c4amph0vgo2qllky" {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
@@ -37,6 +37,7 @@ func NewWireDrawdownRequestService(opts ...option.RequestOption) (r *WireDrawdow
 // Create a Wire Drawdown Request
 func (r *WireDrawdownRequestService) New(ctx context.Context, body WireDrawdownRequestNewParams, opts ...option.RequestOption) (res *WireDrawdownRequest, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireDrawdownRequests.New")}, opts...)
 	path := "wire_drawdown_requests"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -45,6 +46,7 @@ func (r *WireDrawdownRequestService) New(ctx context.Context, body WireDrawdownR
 // Retrieve a Wire Drawdown Request
 func (r *WireDrawdownRequestService) Get(ctx context.Context, wireDrawdownRequestID string, opts ...option.RequestOption) (res *WireDrawdownRequest, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireDrawdownRequests.Get")}, opts...)
 	path := fmt.Sprintf("wire_drawdown_requests/%s", wireDrawdownRequestID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
@@ -54,7 +56,7 @@ func (r *WireDrawdownRequestService) Get(ctx context.Context, wireDrawdownReques
 func (r *WireDrawdownRequestService) List(ctx context.Context, query WireDrawdownRequestListParams, opts ...option.RequestOption) (res *shared.Page[WireDrawdownRequest], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("WireDrawdownRequests.List")}, opts...)
 	path := "wire_drawdown_requests"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -141,6 +143,14 @@ func (r *WireDrawdownRequest) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireDrawdownRequest) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireDrawdownRequestJSON) RawJSON() string {
+	return r.raw
+}
+
 // The lifecycle status of the drawdown request.
 type WireDrawdownRequestStatus string
 
@@ -155,6 +165,14 @@ const (
 	WireDrawdownRequestStatusRefused WireDrawdownRequestStatus = "refused"
 )
 
+func (r WireDrawdownRequestStatus) IsKnown() bool {
+	switch r {
+	case WireDrawdownRequestStatusPendingSubmission, WireDrawdownRequestStatusPendingResponse, WireDrawdownRequestStatusFulfilled, WireDrawdownRequestStatusRefused:
+		return true
+	}
+	return false
+}
+
 // After the drawdown request is submitted to Fedwire, this will contain
 // supplemental details.
 type WireDrawdownRequestSubmission struct {
@@ -176,6 +194,14 @@ func (r *WireDrawdownRequestSubmission) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireDrawdownRequestSubmission) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireDrawdownRequestSubmissionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `wire_drawdown_request`.
 type WireDrawdownRequestType string
@@ -184,6 +210,14 @@ const (
 	WireDrawdownRequestTypeWireDrawdownRequest WireDrawdownRequestType = "wire_drawdown_request"
 )
 
+func (r WireDrawdownRequestType) IsKnown() bool {
+	switch r {
+	case WireDrawdownRequestTypeWireDrawdownRequest:
+		return true
+	}
+	return false
+}
+
 type WireDrawdownRequestNewParams struct {
 	// The Account Number to which the recipient should send funds.
 	AccountNumberID param.Field[string] `json:"account_number_id,required"`
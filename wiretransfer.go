@@ -38,6 +38,7 @@ func NewWireTransferService(opts ...option.RequestOption) (r *WireTransferServic
 // Create a Wire Transfer
 func (r *WireTransferService) New(ctx context.Context, body WireTransferNewParams, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.New")}, opts...)
 	path := "wire_transfers"
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
@@ -46,16 +47,28 @@ func (r *WireTransferService) New(ctx context.Context, body WireTransferNewParam
 // Retrieve a Wire Transfer
 func (r *WireTransferService) Get(ctx context.Context, wireTransferID string, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.Get")}, opts...)
 	path := fmt.Sprintf("wire_transfers/%s", wireTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
 
+// Create a Wire Transfer, or if one was already created with the same
+// UniqueIdentifier, retrieve and return it with existed set to true.
+func (r *WireTransferService) NewOrGet(ctx context.Context, body WireTransferNewParams, opts ...option.RequestOption) (res *WireTransfer, existed bool, err error) {
+	res, err = r.New(ctx, body, opts...)
+	if id, ok := existingResourceID(err); ok {
+		res, err = r.Get(ctx, id, opts...)
+		return res, err == nil, err
+	}
+	return
+}
+
 // List Wire Transfers
 func (r *WireTransferService) List(ctx context.Context, query WireTransferListParams, opts ...option.RequestOption) (res *shared.Page[WireTransfer], err error) {
 	var raw *http.Response
 	opts = append(r.Options, opts...)
-	opts = append([]option.RequestOption{option.WithResponseInto(&raw)}, opts...)
+	opts = append([]option.RequestOption{option.WithResponseInto(&raw), requestconfig.WithOperation("WireTransfers.List")}, opts...)
 	path := "wire_transfers"
 	cfg, err := requestconfig.NewRequestConfig(ctx, http.MethodGet, path, query, &res, opts...)
 	if err != nil {
@@ -77,6 +90,7 @@ func (r *WireTransferService) ListAutoPaging(ctx context.Context, query WireTran
 // Approve a Wire Transfer
 func (r *WireTransferService) Approve(ctx context.Context, wireTransferID string, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.Approve")}, opts...)
 	path := fmt.Sprintf("wire_transfers/%s/approve", wireTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -85,6 +99,7 @@ func (r *WireTransferService) Approve(ctx context.Context, wireTransferID string
 // Cancel a pending Wire Transfer
 func (r *WireTransferService) Cancel(ctx context.Context, wireTransferID string, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.Cancel")}, opts...)
 	path := fmt.Sprintf("wire_transfers/%s/cancel", wireTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -96,6 +111,7 @@ func (r *WireTransferService) Cancel(ctx context.Context, wireTransferID string,
 // Transfer must first have a `status` of `complete`.
 func (r *WireTransferService) Reverse(ctx context.Context, wireTransferID string, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.Reverse")}, opts...)
 	path := fmt.Sprintf("simulations/wire_transfers/%s/reverse", wireTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -106,6 +122,7 @@ func (r *WireTransferService) Reverse(ctx context.Context, wireTransferID string
 // `pending_creating`.
 func (r *WireTransferService) Submit(ctx context.Context, wireTransferID string, opts ...option.RequestOption) (res *WireTransfer, err error) {
 	opts = append(r.Options[:], opts...)
+	opts = append([]option.RequestOption{requestconfig.WithOperation("WireTransfers.Submit")}, opts...)
 	path := fmt.Sprintf("simulations/wire_transfers/%s/submit", wireTransferID)
 	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, &res, opts...)
 	return
@@ -205,6 +222,14 @@ func (r *WireTransfer) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransfer) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was approved,
 // this will contain details of the approval.
 type WireTransferApproval struct {
@@ -230,6 +255,14 @@ func (r *WireTransferApproval) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferApproval) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferApprovalJSON) RawJSON() string {
+	return r.raw
+}
+
 // If your account requires approvals for transfers and the transfer was not
 // approved, this will contain details of the cancellation.
 type WireTransferCancellation struct {
@@ -255,6 +288,14 @@ func (r *WireTransferCancellation) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferCancellation) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferCancellationJSON) RawJSON() string {
+	return r.raw
+}
+
 // The [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217) code for the transfer's
 // currency. For wire transfers this is always equal to `usd`.
 type WireTransferCurrency string
@@ -274,6 +315,14 @@ const (
 	WireTransferCurrencyUsd WireTransferCurrency = "USD"
 )
 
+func (r WireTransferCurrency) IsKnown() bool {
+	switch r {
+	case WireTransferCurrencyCad, WireTransferCurrencyChf, WireTransferCurrencyEur, WireTransferCurrencyGbp, WireTransferCurrencyJpy, WireTransferCurrencyUsd:
+		return true
+	}
+	return false
+}
+
 // The transfer's network.
 type WireTransferNetwork string
 
@@ -281,6 +330,14 @@ const (
 	WireTransferNetworkWire WireTransferNetwork = "wire"
 )
 
+func (r WireTransferNetwork) IsKnown() bool {
+	switch r {
+	case WireTransferNetworkWire:
+		return true
+	}
+	return false
+}
+
 // If your transfer is reversed, this will contain details of the reversal.
 type WireTransferReversal struct {
 	// The amount that was reversed in USD cents.
@@ -350,6 +407,14 @@ func (r *WireTransferReversal) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferReversal) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferReversalJSON) RawJSON() string {
+	return r.raw
+}
+
 // The lifecycle status of the transfer.
 type WireTransferStatus string
 
@@ -370,6 +435,14 @@ const (
 	WireTransferStatusPendingCreating WireTransferStatus = "pending_creating"
 )
 
+func (r WireTransferStatus) IsKnown() bool {
+	switch r {
+	case WireTransferStatusCanceled, WireTransferStatusRequiresAttention, WireTransferStatusPendingApproval, WireTransferStatusRejected, WireTransferStatusReversed, WireTransferStatusComplete, WireTransferStatusPendingCreating:
+		return true
+	}
+	return false
+}
+
 // After the transfer is submitted to Fedwire, this will contain supplemental
 // details.
 type WireTransferSubmission struct {
@@ -393,6 +466,14 @@ func (r *WireTransferSubmission) UnmarshalJSON(data []byte) (err error) {
 	return apijson.UnmarshalRoot(data, r)
 }
 
+func (r WireTransferSubmission) MarshalJSON() (data []byte, err error) {
+	return apijson.MarshalRoot(r)
+}
+
+func (r wireTransferSubmissionJSON) RawJSON() string {
+	return r.raw
+}
+
 // A constant representing the object's type. For this resource it will always be
 // `wire_transfer`.
 type WireTransferType string
@@ -401,6 +482,14 @@ const (
 	WireTransferTypeWireTransfer WireTransferType = "wire_transfer"
 )
 
+func (r WireTransferType) IsKnown() bool {
+	switch r {
+	case WireTransferTypeWireTransfer:
+		return true
+	}
+	return false
+}
+
 type WireTransferNewParams struct {
 	// The identifier for the account that will send the transfer.
 	AccountID param.Field[string] `json:"account_id,required"`